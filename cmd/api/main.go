@@ -9,18 +9,86 @@ import (
 	"syscall"
 	"time"
 
+	addressHandler "github.com/haily-id/engine/internal/delivery/http/handler/address"
+	attendanceHandler "github.com/haily-id/engine/internal/delivery/http/handler/attendance"
+	auditHandler "github.com/haily-id/engine/internal/delivery/http/handler/audit"
 	authHandler "github.com/haily-id/engine/internal/delivery/http/handler/auth"
+	billingHandler "github.com/haily-id/engine/internal/delivery/http/handler/billing"
+	bulkHandler "github.com/haily-id/engine/internal/delivery/http/handler/bulk"
+	catalogHandler "github.com/haily-id/engine/internal/delivery/http/handler/catalog"
+	companyHandler "github.com/haily-id/engine/internal/delivery/http/handler/company"
+	documentHandler "github.com/haily-id/engine/internal/delivery/http/handler/document"
+	employeeHandler "github.com/haily-id/engine/internal/delivery/http/handler/employee"
+	entitlementHandler "github.com/haily-id/engine/internal/delivery/http/handler/entitlement"
+	invitationHandler "github.com/haily-id/engine/internal/delivery/http/handler/invitation"
+	leaveHandler "github.com/haily-id/engine/internal/delivery/http/handler/leave"
+	notificationHandler "github.com/haily-id/engine/internal/delivery/http/handler/notification"
+	organizationHandler "github.com/haily-id/engine/internal/delivery/http/handler/organization"
+	orgChartHandler "github.com/haily-id/engine/internal/delivery/http/handler/orgchart"
+	paymentHandler "github.com/haily-id/engine/internal/delivery/http/handler/payment"
+	quotaHandler "github.com/haily-id/engine/internal/delivery/http/handler/quota"
+	regionHandler "github.com/haily-id/engine/internal/delivery/http/handler/region"
+	settingHandler "github.com/haily-id/engine/internal/delivery/http/handler/setting"
+	subscriptionHandler "github.com/haily-id/engine/internal/delivery/http/handler/subscription"
 	"github.com/haily-id/engine/internal/delivery/http/route"
+	attendanceEntity "github.com/haily-id/engine/internal/domain/entity/attendance"
+	auditEntity "github.com/haily-id/engine/internal/domain/entity/audit"
+	billingEntity "github.com/haily-id/engine/internal/domain/entity/billing"
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	leaveEntity "github.com/haily-id/engine/internal/domain/entity/leave"
+	notificationEntity "github.com/haily-id/engine/internal/domain/entity/notification"
+	orgEntity "github.com/haily-id/engine/internal/domain/entity/organization"
+	rbacEntity "github.com/haily-id/engine/internal/domain/entity/rbac"
+	regionEntity "github.com/haily-id/engine/internal/domain/entity/region"
+	subscriptionEntity "github.com/haily-id/engine/internal/domain/entity/subscription"
 	userEntity "github.com/haily-id/engine/internal/domain/entity/user"
 	pkgAsynq "github.com/haily-id/engine/internal/pkg/asynq"
 	"github.com/haily-id/engine/internal/pkg/config"
 	"github.com/haily-id/engine/internal/pkg/database"
+	"github.com/haily-id/engine/internal/pkg/encryption"
+	"github.com/haily-id/engine/internal/pkg/holiday"
 	"github.com/haily-id/engine/internal/pkg/logger"
 	"github.com/haily-id/engine/internal/pkg/mailer"
+	"github.com/haily-id/engine/internal/pkg/payment"
 	"github.com/haily-id/engine/internal/pkg/snowflake"
+	"github.com/haily-id/engine/internal/pkg/storage"
 	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	attendanceRepo "github.com/haily-id/engine/internal/repository/postgres/attendance"
+	auditRepo "github.com/haily-id/engine/internal/repository/postgres/audit"
+	billingRepo "github.com/haily-id/engine/internal/repository/postgres/billing"
+	companyRepo "github.com/haily-id/engine/internal/repository/postgres/company"
+	employeeRepo "github.com/haily-id/engine/internal/repository/postgres/employee"
+	leaveRepo "github.com/haily-id/engine/internal/repository/postgres/leave"
+	notificationRepo "github.com/haily-id/engine/internal/repository/postgres/notification"
+	orgRepo "github.com/haily-id/engine/internal/repository/postgres/organization"
+	rbacRepo "github.com/haily-id/engine/internal/repository/postgres/rbac"
+	regionRepo "github.com/haily-id/engine/internal/repository/postgres/region"
+	subscriptionRepo "github.com/haily-id/engine/internal/repository/postgres/subscription"
 	userRepo "github.com/haily-id/engine/internal/repository/postgres/user"
+	redisCache "github.com/haily-id/engine/internal/repository/redis"
+	addressUC "github.com/haily-id/engine/internal/usecase/address"
+	attendanceUC "github.com/haily-id/engine/internal/usecase/attendance"
+	auditUC "github.com/haily-id/engine/internal/usecase/audit"
 	authUC "github.com/haily-id/engine/internal/usecase/auth"
+	billingUC "github.com/haily-id/engine/internal/usecase/billing"
+	bulkUC "github.com/haily-id/engine/internal/usecase/bulk"
+	catalogUC "github.com/haily-id/engine/internal/usecase/catalog"
+	companyUC "github.com/haily-id/engine/internal/usecase/company"
+	documentUC "github.com/haily-id/engine/internal/usecase/document"
+	employeeUC "github.com/haily-id/engine/internal/usecase/employee"
+	entitlementUC "github.com/haily-id/engine/internal/usecase/entitlement"
+	invitationUC "github.com/haily-id/engine/internal/usecase/invitation"
+	leaveUC "github.com/haily-id/engine/internal/usecase/leave"
+	notificationUC "github.com/haily-id/engine/internal/usecase/notification"
+	organizationUC "github.com/haily-id/engine/internal/usecase/organization"
+	orgChartUC "github.com/haily-id/engine/internal/usecase/orgchart"
+	paymentUC "github.com/haily-id/engine/internal/usecase/payment"
+	quotaUC "github.com/haily-id/engine/internal/usecase/quota"
+	regionUC "github.com/haily-id/engine/internal/usecase/region"
+	settingUC "github.com/haily-id/engine/internal/usecase/setting"
+	subscriptionUC "github.com/haily-id/engine/internal/usecase/subscription"
 	"github.com/labstack/echo/v4"
 	gormLogger "gorm.io/gorm/logger"
 )
@@ -39,6 +107,12 @@ func main() {
 
 	validator.Init()
 
+	keyring, err := encryption.LoadKeyring(cfg.Encryption.KeyringFile)
+	if err != nil {
+		log.Fatalf("Failed to load encryption keyring: %v", err)
+	}
+	encryption.Init(keyring)
+
 	db, err := database.NewPostgresDB(database.Config{
 		DSN:             cfg.Database.DSN(),
 		MaxOpenConns:    25,
@@ -54,15 +128,101 @@ func main() {
 	if err := db.AutoMigrate(
 		&userEntity.User{},
 		&userEntity.EmailVerification{},
+		&rbacEntity.Role{},
+		&companyEntity.Company{},
+		&companyEntity.UserCompany{},
+		&companyEntity.Industry{},
+		&companyEntity.CompanyType{},
+		&companyEntity.Address{},
+		&companyEntity.Setting{},
+		&employeeEntity.Employee{},
+		&employeeEntity.Invitation{},
+		&employeeEntity.WorkLocation{},
+		&employeeEntity.Position{},
+		&employeeEntity.NumberSequence{},
+		&employeeEntity.Document{},
+		&employeeEntity.BankAccount{},
+		&employeeEntity.EmergencyContact{},
+		&employeeEntity.Address{},
+		&employeeEntity.Import{},
+		&leaveEntity.Type{},
+		&leaveEntity.Balance{},
+		&leaveEntity.Request{},
+		&leaveEntity.Approval{},
+		&attendanceEntity.Shift{},
+		&attendanceEntity.ShiftAssignment{},
+		&attendanceEntity.Record{},
+		&orgEntity.Division{},
+		&orgEntity.Department{},
+		&orgEntity.Position{},
+		&subscriptionEntity.Plan{},
+		&subscriptionEntity.Module{},
+		&subscriptionEntity.PlanModule{},
+		&subscriptionEntity.CompanySubscription{},
+		&subscriptionEntity.SubscriptionChange{},
+		&subscriptionEntity.CompanyModule{},
+		&subscriptionEntity.CompanyUsage{},
+		&billingEntity.Invoice{},
+		&billingEntity.InvoiceItem{},
+		&billingEntity.InvoiceSequence{},
+		&billingEntity.PaymentEvent{},
+		&regionEntity.Province{},
+		&regionEntity.City{},
+		&regionEntity.District{},
+		&regionEntity.Village{},
+		&auditEntity.AuditLog{},
+		&notificationEntity.Notification{},
 	); err != nil {
 		log.Fatalf("Failed to auto migrate: %v", err)
 	}
 
 	logger.Info("Database migration completed")
 
+	roleRepository := rbacRepo.NewRoleRepository(db)
+	if err := seedSystemRoles(context.Background(), roleRepository); err != nil {
+		log.Fatalf("Failed to seed system roles: %v", err)
+	}
+
+	planRepository := subscriptionRepo.NewPlanRepository(db)
+	moduleRepository := subscriptionRepo.NewModuleRepository(db)
+	if err := seedPlans(context.Background(), moduleRepository, planRepository); err != nil {
+		log.Fatalf("Failed to seed plans: %v", err)
+	}
+
+	industryRepository := companyRepo.NewIndustryRepository(db)
+	companyTypeRepository := companyRepo.NewCompanyTypeRepository(db)
+	if err := seedCatalog(context.Background(), industryRepository, companyTypeRepository); err != nil {
+		log.Fatalf("Failed to seed industries and company types: %v", err)
+	}
+
+	cache, err := redisCache.NewCache(cfg.Redis.Addr(), cfg.Redis.Password, cfg.Redis.DB)
+	if err != nil {
+		log.Fatalf("Failed to connect to Redis: %v", err)
+	}
+	defer cache.Close()
+
 	asynqClient := pkgAsynq.NewClient(cfg.Asynq.RedisAddr)
 	defer asynqClient.Close()
 
+	store, err := storage.New(storage.Config{
+		Driver:   cfg.Storage.Driver,
+		LocalDir: cfg.Storage.LocalDir,
+	})
+	if err != nil {
+		log.Fatalf("Failed to initialize storage: %v", err)
+	}
+
+	if cfg.App.Env == "production" && cfg.Payment.Provider == "fake" {
+		log.Fatalf("The fake payment provider cannot be used in production")
+	}
+	paymentProvider, err := payment.New(payment.Config{
+		Provider:      cfg.Payment.Provider,
+		WebhookSecret: cfg.Payment.WebhookSecret,
+	})
+	if err != nil {
+		log.Fatalf("Failed to initialize payment provider: %v", err)
+	}
+
 	m := mailer.New(mailer.Config{
 		Driver:   cfg.Mailer.Driver,
 		FromName: cfg.Mailer.FromName,
@@ -75,28 +235,344 @@ func main() {
 
 	userRepository := userRepo.NewUserRepository(db)
 	evRepository := userRepo.NewEmailVerificationRepository(db)
+	companyRepository := companyRepo.NewCompanyRepository(db)
+	memberRepository := companyRepo.NewUserCompanyRepository(db)
+	employeeRepository := employeeRepo.NewEmployeeRepository(db)
+	invitationRepository := employeeRepo.NewInvitationRepository(db)
+	workLocationRepository := employeeRepo.NewWorkLocationRepository(db)
+	employeePositionRepository := employeeRepo.NewEmployeePositionRepository(db)
+	documentRepository := employeeRepo.NewEmployeeDocumentRepository(db)
+	bankAccountRepository := employeeRepo.NewEmployeeBankAccountRepository(db)
+	emergencyContactRepository := employeeRepo.NewEmergencyContactRepository(db)
+	employeeAddressRepository := employeeRepo.NewEmployeeAddressRepository(db)
+	importRepository := employeeRepo.NewImportRepository(db)
+	leaveTypeRepository := leaveRepo.NewTypeRepository(db)
+	leaveBalanceRepository := leaveRepo.NewBalanceRepository(db)
+	leaveRequestRepository := leaveRepo.NewRequestRepository(db)
+	shiftRepository := attendanceRepo.NewShiftRepository(db)
+	shiftAssignmentRepository := attendanceRepo.NewAssignmentRepository(db)
+	attendanceRecordRepository := attendanceRepo.NewRecordRepository(db)
+	divisionRepository := orgRepo.NewDivisionRepository(db)
+	departmentRepository := orgRepo.NewDepartmentRepository(db)
+	positionRepository := orgRepo.NewPositionRepository(db)
+	addressRepository := companyRepo.NewCompanyAddressRepository(db)
+	settingRepository := companyRepo.NewCompanySettingRepository(db)
+	subRepository := subscriptionRepo.NewCompanySubscriptionRepository(db)
+	subChangeRepository := subscriptionRepo.NewSubscriptionChangeRepository(db)
+	companyModuleRepository := subscriptionRepo.NewCompanyModuleRepository(db)
+	usageRepository := subscriptionRepo.NewCompanyUsageRepository(db)
+	invoiceRepository := billingRepo.NewInvoiceRepository(db)
+	paymentEventRepository := billingRepo.NewPaymentEventRepository(db)
+	regionRepository := regionRepo.NewRegionRepository(db)
+	auditLogRepository := auditRepo.NewAuditLogRepository(db)
+	notificationRepository := notificationRepo.NewNotificationRepository(db)
+	transactor := postgres.NewTransactor(db)
+
+	auditUseCase := auditUC.NewUseCase(
+		auditLogRepository,
+		asynqClient,
+	)
+
+	notificationUseCase := notificationUC.NewUseCase(
+		notificationRepository,
+		cache,
+	)
 
 	authUseCase := authUC.NewUseCase(
 		userRepository,
 		evRepository,
 		m,
 		asynqClient,
+		auditUseCase,
 		authUC.Config{
 			JWTSecret:      cfg.JWT.Secret,
 			JWTExpiryHours: cfg.JWT.ExpirationHour,
 		},
 	)
 
+	entitlementUseCase := entitlementUC.NewUseCase(
+		moduleRepository,
+		companyModuleRepository,
+		subRepository,
+		companyRepository,
+		cache,
+	)
+
+	billingUseCase := billingUC.NewUseCase(
+		invoiceRepository,
+		subRepository,
+		planRepository,
+		companyRepository,
+		transactor,
+		store,
+		asynqClient,
+		billingUC.Config{
+			TaxRate: cfg.Billing.TaxRate,
+			DueDays: cfg.Billing.InvoiceDueDays,
+			Issuer: billingUC.Issuer{
+				Name:    cfg.Billing.IssuerName,
+				Address: cfg.Billing.IssuerAddress,
+				TaxID:   cfg.Billing.IssuerTaxID,
+			},
+		},
+	)
+
+	paymentUseCase := paymentUC.NewUseCase(
+		invoiceRepository,
+		paymentEventRepository,
+		subRepository,
+		companyRepository,
+		transactor,
+		paymentProvider,
+	)
+
+	subscriptionUseCase := subscriptionUC.NewUseCase(
+		planRepository,
+		moduleRepository,
+		subRepository,
+		subChangeRepository,
+		companyRepository,
+		userRepository,
+		transactor,
+		asynqClient,
+		entitlementUseCase,
+		billingUseCase,
+		notificationUseCase,
+		subscriptionUC.Config{
+			TrialDays:     cfg.Billing.TrialDays,
+			TrialPlanCode: cfg.Billing.TrialPlanCode,
+		},
+	)
+
+	companyUseCase := companyUC.NewUseCase(
+		companyRepository,
+		memberRepository,
+		roleRepository,
+		industryRepository,
+		companyTypeRepository,
+		transactor,
+		subscriptionUseCase,
+		auditUseCase,
+	)
+
+	quotaUseCase := quotaUC.NewUseCase(
+		companyRepository,
+		memberRepository,
+		employeeRepository,
+		usageRepository,
+		subRepository,
+		planRepository,
+	)
+
+	invitationUseCase := invitationUC.NewUseCase(
+		invitationRepository,
+		employeeRepository,
+		memberRepository,
+		roleRepository,
+		companyRepository,
+		userRepository,
+		transactor,
+		asynqClient,
+		quotaUseCase,
+		auditUseCase,
+		notificationUseCase,
+		invitationUC.Config{
+			AcceptURL: cfg.App.FrontendURL + "/invitations/accept",
+		},
+	)
+
+	regionUseCase := regionUC.NewUseCase(
+		regionRepository,
+		transactor,
+		cache,
+	)
+
+	catalogUseCase := catalogUC.NewUseCase(
+		industryRepository,
+		companyTypeRepository,
+	)
+
+	addressUseCase := addressUC.NewUseCase(
+		addressRepository,
+		companyRepository,
+		regionRepository,
+		workLocationRepository,
+		transactor,
+		auditUseCase,
+	)
+
+	organizationUseCase := organizationUC.NewUseCase(
+		divisionRepository,
+		departmentRepository,
+		positionRepository,
+		employeeRepository,
+		employeePositionRepository,
+		auditUseCase,
+	)
+
+	orgChartUseCase := orgChartUC.NewUseCase(
+		divisionRepository,
+		departmentRepository,
+		positionRepository,
+		employeeRepository,
+		employeePositionRepository,
+	)
+
+	settingRegistry := settingUC.NewRegistry()
+	settingRegistry.MustRegister(settingUC.GeneralDefinitions()...)
+	settingRegistry.MustRegister(settingUC.HRDefinitions()...)
+
+	settingUseCase := settingUC.NewUseCase(
+		settingRepository,
+		settingRegistry,
+		cache,
+		auditUseCase,
+	)
+
+	employeeUseCase := employeeUC.NewUseCase(
+		employeeRepository,
+		invitationRepository,
+		memberRepository,
+		companyRepository,
+		divisionRepository,
+		departmentRepository,
+		positionRepository,
+		employeePositionRepository,
+		bankAccountRepository,
+		emergencyContactRepository,
+		employeeAddressRepository,
+		addressRepository,
+		workLocationRepository,
+		regionRepository,
+		transactor,
+		quotaUseCase,
+		settingUseCase,
+		auditUseCase,
+	)
+
+	bulkUseCase := bulkUC.NewUseCase(
+		importRepository,
+		employeeRepository,
+		companyRepository,
+		divisionRepository,
+		departmentRepository,
+		positionRepository,
+		employeePositionRepository,
+		roleRepository,
+		transactor,
+		employeeUseCase,
+		invitationUseCase,
+		asynqClient,
+		auditUseCase,
+	)
+
+	documentUseCase := documentUC.NewUseCase(
+		documentRepository,
+		employeeRepository,
+		companyRepository,
+		memberRepository,
+		userRepository,
+		store,
+		quotaUseCase,
+		settingUseCase,
+		asynqClient,
+		notificationUseCase,
+		auditUseCase,
+	)
+
+	holidays, err := holiday.Indonesia()
+	if err != nil {
+		log.Fatalf("Failed to load holiday calendar: %v", err)
+	}
+
+	leaveUseCase := leaveUC.NewUseCase(
+		leaveTypeRepository,
+		leaveBalanceRepository,
+		leaveRequestRepository,
+		employeeRepository,
+		companyRepository,
+		divisionRepository,
+		departmentRepository,
+		memberRepository,
+		transactor,
+		holidays,
+		employeeUseCase,
+		settingUseCase,
+		notificationUseCase,
+		auditUseCase,
+	)
+
+	attendanceUseCase := attendanceUC.NewUseCase(
+		shiftRepository,
+		shiftAssignmentRepository,
+		attendanceRecordRepository,
+		employeeRepository,
+		companyRepository,
+		addressRepository,
+		workLocationRepository,
+		leaveRequestRepository,
+		transactor,
+		holidays,
+		settingUseCase,
+		auditUseCase,
+	)
+
 	authH := authHandler.NewHandler(authUseCase)
+	companyH := companyHandler.NewHandler(companyUseCase)
+	invitationH := invitationHandler.NewHandler(invitationUseCase)
+	subscriptionH := subscriptionHandler.NewHandler(subscriptionUseCase)
+	entitlementH := entitlementHandler.NewHandler(entitlementUseCase)
+	quotaH := quotaHandler.NewHandler(quotaUseCase)
+	billingH := billingHandler.NewHandler(billingUseCase)
+	paymentH := paymentHandler.NewHandler(paymentUseCase)
+	regionH := regionHandler.NewHandler(regionUseCase)
+	catalogH := catalogHandler.NewHandler(catalogUseCase)
+	addressH := addressHandler.NewHandler(addressUseCase)
+	settingH := settingHandler.NewHandler(settingUseCase)
+	auditH := auditHandler.NewHandler(auditUseCase)
+	notificationH := notificationHandler.NewHandler(notificationUseCase)
+	organizationH := organizationHandler.NewHandler(organizationUseCase)
+	orgChartH := orgChartHandler.NewHandler(orgChartUseCase)
+	employeeH := employeeHandler.NewHandler(employeeUseCase)
+	bulkH := bulkHandler.NewHandler(bulkUseCase)
+	documentH := documentHandler.NewHandler(documentUseCase)
+	leaveH := leaveHandler.NewHandler(leaveUseCase)
+	attendanceH := attendanceHandler.NewHandler(attendanceUseCase)
 
 	e := echo.New()
 	e.HideBanner = true
 
 	route.Setup(e, route.RouteConfig{
-		AuthHandler: authH,
-		JWTSecret:   cfg.JWT.Secret,
+		AuthHandler:         authH,
+		CompanyHandler:      companyH,
+		InvitationHandler:   invitationH,
+		SubscriptionHandler: subscriptionH,
+		EntitlementHandler:  entitlementH,
+		QuotaHandler:        quotaH,
+		BillingHandler:      billingH,
+		PaymentHandler:      paymentH,
+		RegionHandler:       regionH,
+		CatalogHandler:      catalogH,
+		AddressHandler:      addressH,
+		SettingHandler:      settingH,
+		AuditHandler:        auditH,
+		NotificationHandler: notificationH,
+		OrganizationHandler: organizationH,
+		OrgChartHandler:     orgChartH,
+		EmployeeHandler:     employeeH,
+		BulkHandler:         bulkH,
+		DocumentHandler:     documentH,
+		LeaveHandler:        leaveH,
+		AttendanceHandler:   attendanceH,
+		MemberRepo:          memberRepository,
+		Entitlements:        entitlementUseCase,
+		JWTSecret:           cfg.JWT.Secret,
+		AdminEmails:         cfg.Admin.Emails,
 	})
 
+	streamCtx, stopStreams := context.WithCancel(context.Background())
+	go notificationUseCase.Run(streamCtx)
+
 	go func() {
 		addr := fmt.Sprintf(":%s", cfg.App.Port)
 		logger.Infof("Starting API server on %s", addr)
@@ -111,6 +587,9 @@ func main() {
 
 	logger.Info("Shutting down server...")
 
+	// Open notification streams never finish on their own.
+	stopStreams()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
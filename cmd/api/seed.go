@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/haily-id/engine/internal/domain/entity/company"
+	"github.com/haily-id/engine/internal/domain/entity/rbac"
+	"github.com/haily-id/engine/internal/domain/entity/subscription"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+)
+
+func seedSystemRoles(ctx context.Context, roleRepo repository.RoleRepository) error {
+	for _, role := range rbac.SystemRoles() {
+		id, err := snowflake.Generate()
+		if err != nil {
+			return fmt.Errorf("failed to generate ID: %w", err)
+		}
+		role.ID = id
+		if err := roleRepo.EnsureSystem(ctx, &role); err != nil {
+			return fmt.Errorf("failed to seed role %s: %w", role.Code, err)
+		}
+	}
+	return nil
+}
+
+// seedPlans inserts the default modules and plans. Existing rows, including
+// admin edits to them, are left untouched.
+func seedPlans(ctx context.Context, moduleRepo repository.ModuleRepository, planRepo repository.SubscriptionPlanRepository) error {
+	moduleIDs := make(map[string]int64)
+	for _, m := range subscription.DefaultModules() {
+		id, err := snowflake.Generate()
+		if err != nil {
+			return fmt.Errorf("failed to generate ID: %w", err)
+		}
+		m.ID = id
+		if err := moduleRepo.Ensure(ctx, &m); err != nil {
+			return fmt.Errorf("failed to seed module %s: %w", m.Code, err)
+		}
+		moduleIDs[m.Code] = m.ID
+	}
+
+	for _, seed := range subscription.DefaultPlans() {
+		if _, err := planRepo.FindByCode(ctx, seed.Plan.Code); err == nil {
+			continue
+		}
+
+		id, err := snowflake.Generate()
+		if err != nil {
+			return fmt.Errorf("failed to generate ID: %w", err)
+		}
+		p := seed.Plan
+		p.ID = id
+		if err := planRepo.Ensure(ctx, &p); err != nil {
+			return fmt.Errorf("failed to seed plan %s: %w", p.Code, err)
+		}
+
+		pms := make([]subscription.PlanModule, 0, len(seed.Modules))
+		for _, code := range seed.Modules {
+			pmID, err := snowflake.Generate()
+			if err != nil {
+				return fmt.Errorf("failed to generate ID: %w", err)
+			}
+			pms = append(pms, subscription.PlanModule{ID: pmID, PlanID: p.ID, ModuleID: moduleIDs[code]})
+		}
+		if err := moduleRepo.ReplacePlanModules(ctx, p.ID, pms); err != nil {
+			return fmt.Errorf("failed to seed modules for plan %s: %w", p.Code, err)
+		}
+	}
+	return nil
+}
+
+// seedCatalog inserts the default industries and company types. Existing
+// rows, including deactivated ones, are left untouched.
+func seedCatalog(ctx context.Context, industryRepo repository.IndustryRepository, companyTypeRepo repository.CompanyTypeRepository) error {
+	for _, i := range company.DefaultIndustries() {
+		id, err := snowflake.Generate()
+		if err != nil {
+			return fmt.Errorf("failed to generate ID: %w", err)
+		}
+		i.ID = id
+		if err := industryRepo.Ensure(ctx, &i); err != nil {
+			return fmt.Errorf("failed to seed industry %s: %w", i.Code, err)
+		}
+	}
+
+	for _, t := range company.DefaultCompanyTypes() {
+		id, err := snowflake.Generate()
+		if err != nil {
+			return fmt.Errorf("failed to generate ID: %w", err)
+		}
+		t.ID = id
+		if err := companyTypeRepo.Ensure(ctx, &t); err != nil {
+			return fmt.Errorf("failed to seed company type %s: %w", t.Code, err)
+		}
+	}
+	return nil
+}
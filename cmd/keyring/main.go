@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"log"
+
+	pkgAsynq "github.com/haily-id/engine/internal/pkg/asynq"
+	"github.com/haily-id/engine/internal/pkg/asynq/tasks"
+	"github.com/haily-id/engine/internal/pkg/config"
+	"github.com/haily-id/engine/internal/pkg/encryption"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	asynqLib "github.com/hibiken/asynq"
+)
+
+// Manages the keyring sealing sensitive columns:
+//
+//	keyring init       writes a new keyring file
+//	keyring rotate     adds a key and makes it active
+//	keyring reencrypt  queues re-encryption under the active key
+//
+// After rotating, deploy the file to every API and worker process and
+// restart them before running reencrypt.
+func main() {
+	file := flag.String("file", "", "keyring file (default ENCRYPTION_KEYRING_FILE)")
+	flag.Parse()
+
+	logger.Init("KEYRING")
+
+	cfg, err := config.Load(".env")
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	path := *file
+	if path == "" {
+		path = cfg.Encryption.KeyringFile
+	}
+
+	switch flag.Arg(0) {
+	case "init":
+		if err := encryption.CreateKeyring(path); err != nil {
+			log.Fatalf("Failed to create keyring: %v", err)
+		}
+		logger.Infof("Created keyring %s", path)
+	case "rotate":
+		id, err := encryption.RotateKey(path)
+		if err != nil {
+			log.Fatalf("Failed to rotate key: %v", err)
+		}
+		logger.Infof("Added key %s to %s and made it active", id, path)
+	case "reencrypt":
+		client := pkgAsynq.NewClient(cfg.Asynq.RedisAddr)
+		defer client.Close()
+		if err := client.Enqueue(tasks.NewReencryptFieldsTask(), asynqLib.Queue("low")); err != nil {
+			log.Fatalf("Failed to queue re-encryption: %v", err)
+		}
+		logger.Info("Queued re-encryption")
+	default:
+		log.Fatalf("Usage: keyring [-file path] init|rotate|reencrypt")
+	}
+}
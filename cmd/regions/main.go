@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	regionEntity "github.com/haily-id/engine/internal/domain/entity/region"
+	"github.com/haily-id/engine/internal/pkg/config"
+	"github.com/haily-id/engine/internal/pkg/database"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	regionRepo "github.com/haily-id/engine/internal/repository/postgres/region"
+	redisCache "github.com/haily-id/engine/internal/repository/redis"
+	regionUC "github.com/haily-id/engine/internal/usecase/region"
+	gormLogger "gorm.io/gorm/logger"
+)
+
+// Imports the Kemendagri region dataset. See data/regions/README.md.
+func main() {
+	dir := flag.String("dir", "data/regions", "directory holding the region dataset")
+	flag.Parse()
+
+	logger.Init("REGIONS")
+
+	cfg, err := config.Load(".env")
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	ds, err := regionUC.LoadDataset(*dir)
+	if err != nil {
+		log.Fatalf("Failed to load dataset: %v", err)
+	}
+
+	db, err := database.NewPostgresDB(database.Config{
+		DSN:             cfg.Database.DSN(),
+		MaxOpenConns:    5,
+		MaxIdleConns:    1,
+		ConnMaxLifetime: 30 * time.Minute,
+		LogLevel:        gormLogger.Warn,
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer database.Close(db)
+
+	if err := db.AutoMigrate(
+		&regionEntity.Province{},
+		&regionEntity.City{},
+		&regionEntity.District{},
+		&regionEntity.Village{},
+	); err != nil {
+		log.Fatalf("Failed to auto migrate: %v", err)
+	}
+
+	cache, err := redisCache.NewCache(cfg.Redis.Addr(), cfg.Redis.Password, cfg.Redis.DB)
+	if err != nil {
+		log.Fatalf("Failed to connect to Redis: %v", err)
+	}
+	defer cache.Close()
+
+	regionUseCase := regionUC.NewUseCase(
+		regionRepo.NewRegionRepository(db),
+		postgres.NewTransactor(db),
+		cache,
+	)
+
+	result, err := regionUseCase.Import(context.Background(), ds)
+	if err != nil {
+		log.Fatalf("Failed to import regions: %v", err)
+	}
+
+	logger.Infof("Imported %d provinces, %d cities, %d districts, %d villages",
+		result.Provinces, result.Cities, result.Districts, result.Villages)
+}
@@ -9,13 +9,43 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/haily-id/engine/internal/domain/repository"
 	pkgAsynq "github.com/haily-id/engine/internal/pkg/asynq"
 	"github.com/haily-id/engine/internal/pkg/asynq/tasks"
 	"github.com/haily-id/engine/internal/pkg/config"
 	"github.com/haily-id/engine/internal/pkg/database"
+	"github.com/haily-id/engine/internal/pkg/encryption"
+	"github.com/haily-id/engine/internal/pkg/holiday"
 	"github.com/haily-id/engine/internal/pkg/logger"
 	"github.com/haily-id/engine/internal/pkg/mailer"
 	"github.com/haily-id/engine/internal/pkg/snowflake"
+	"github.com/haily-id/engine/internal/pkg/storage"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	auditRepo "github.com/haily-id/engine/internal/repository/postgres/audit"
+	billingRepo "github.com/haily-id/engine/internal/repository/postgres/billing"
+	companyRepo "github.com/haily-id/engine/internal/repository/postgres/company"
+	employeeRepo "github.com/haily-id/engine/internal/repository/postgres/employee"
+	leaveRepo "github.com/haily-id/engine/internal/repository/postgres/leave"
+	notificationRepo "github.com/haily-id/engine/internal/repository/postgres/notification"
+	orgRepo "github.com/haily-id/engine/internal/repository/postgres/organization"
+	rbacRepo "github.com/haily-id/engine/internal/repository/postgres/rbac"
+	regionRepo "github.com/haily-id/engine/internal/repository/postgres/region"
+	subscriptionRepo "github.com/haily-id/engine/internal/repository/postgres/subscription"
+	userRepo "github.com/haily-id/engine/internal/repository/postgres/user"
+	redisCache "github.com/haily-id/engine/internal/repository/redis"
+	auditUC "github.com/haily-id/engine/internal/usecase/audit"
+	billingUC "github.com/haily-id/engine/internal/usecase/billing"
+	bulkUC "github.com/haily-id/engine/internal/usecase/bulk"
+	documentUC "github.com/haily-id/engine/internal/usecase/document"
+	employeeUC "github.com/haily-id/engine/internal/usecase/employee"
+	encryptionUC "github.com/haily-id/engine/internal/usecase/encryption"
+	entitlementUC "github.com/haily-id/engine/internal/usecase/entitlement"
+	invitationUC "github.com/haily-id/engine/internal/usecase/invitation"
+	leaveUC "github.com/haily-id/engine/internal/usecase/leave"
+	notificationUC "github.com/haily-id/engine/internal/usecase/notification"
+	quotaUC "github.com/haily-id/engine/internal/usecase/quota"
+	settingUC "github.com/haily-id/engine/internal/usecase/setting"
+	subscriptionUC "github.com/haily-id/engine/internal/usecase/subscription"
 	asynqLib "github.com/hibiken/asynq"
 	gormLogger "gorm.io/gorm/logger"
 )
@@ -32,7 +62,13 @@ func main() {
 		log.Fatalf("Failed to initialize Snowflake: %v", err)
 	}
 
-	_, err = database.NewPostgresDB(database.Config{
+	keyring, err := encryption.LoadKeyring(cfg.Encryption.KeyringFile)
+	if err != nil {
+		log.Fatalf("Failed to load encryption keyring: %v", err)
+	}
+	encryption.Init(keyring)
+
+	db, err := database.NewPostgresDB(database.Config{
 		DSN:             cfg.Database.DSN(),
 		MaxOpenConns:    10,
 		MaxIdleConns:    2,
@@ -53,10 +89,247 @@ func main() {
 		Password: cfg.Mailer.Password,
 	})
 
+	defer database.Close(db)
+
+	cache, err := redisCache.NewCache(cfg.Redis.Addr(), cfg.Redis.Password, cfg.Redis.DB)
+	if err != nil {
+		log.Fatalf("Failed to connect to Redis: %v", err)
+	}
+	defer cache.Close()
+
+	asynqClient := pkgAsynq.NewClient(cfg.Asynq.RedisAddr)
+	defer asynqClient.Close()
+
+	store, err := storage.New(storage.Config{
+		Driver:   cfg.Storage.Driver,
+		LocalDir: cfg.Storage.LocalDir,
+	})
+	if err != nil {
+		log.Fatalf("Failed to initialize storage: %v", err)
+	}
+
+	invitationRepository := employeeRepo.NewInvitationRepository(db)
+	moduleRepository := subscriptionRepo.NewModuleRepository(db)
+	planRepository := subscriptionRepo.NewPlanRepository(db)
+	subRepository := subscriptionRepo.NewCompanySubscriptionRepository(db)
+	companyRepository := companyRepo.NewCompanyRepository(db)
+	memberRepository := companyRepo.NewUserCompanyRepository(db)
+	employeeRepository := employeeRepo.NewEmployeeRepository(db)
+	userRepository := userRepo.NewUserRepository(db)
+	roleRepository := rbacRepo.NewRoleRepository(db)
+	addressRepository := companyRepo.NewCompanyAddressRepository(db)
+	employeePositionRepository := employeeRepo.NewEmployeePositionRepository(db)
+	divisionRepository := orgRepo.NewDivisionRepository(db)
+	departmentRepository := orgRepo.NewDepartmentRepository(db)
+	positionRepository := orgRepo.NewPositionRepository(db)
+	transactor := postgres.NewTransactor(db)
+
+	entitlementUseCase := entitlementUC.NewUseCase(
+		moduleRepository,
+		subscriptionRepo.NewCompanyModuleRepository(db),
+		subRepository,
+		companyRepository,
+		cache,
+	)
+
+	billingUseCase := billingUC.NewUseCase(
+		billingRepo.NewInvoiceRepository(db),
+		subRepository,
+		planRepository,
+		companyRepository,
+		transactor,
+		store,
+		asynqClient,
+		billingUC.Config{
+			TaxRate: cfg.Billing.TaxRate,
+			DueDays: cfg.Billing.InvoiceDueDays,
+			Issuer: billingUC.Issuer{
+				Name:    cfg.Billing.IssuerName,
+				Address: cfg.Billing.IssuerAddress,
+				TaxID:   cfg.Billing.IssuerTaxID,
+			},
+		},
+	)
+
+	notificationUseCase := notificationUC.NewUseCase(
+		notificationRepo.NewNotificationRepository(db),
+		cache,
+	)
+
+	subscriptionUseCase := subscriptionUC.NewUseCase(
+		planRepository,
+		moduleRepository,
+		subRepository,
+		subscriptionRepo.NewSubscriptionChangeRepository(db),
+		companyRepository,
+		userRepository,
+		transactor,
+		asynqClient,
+		entitlementUseCase,
+		billingUseCase,
+		notificationUseCase,
+		subscriptionUC.Config{
+			TrialDays:     cfg.Billing.TrialDays,
+			TrialPlanCode: cfg.Billing.TrialPlanCode,
+		},
+	)
+
+	auditUseCase := auditUC.NewUseCase(
+		auditRepo.NewAuditLogRepository(db),
+		asynqClient,
+	)
+
+	quotaUseCase := quotaUC.NewUseCase(
+		companyRepository,
+		memberRepository,
+		employeeRepository,
+		subscriptionRepo.NewCompanyUsageRepository(db),
+		subRepository,
+		planRepository,
+	)
+
+	settingRegistry := settingUC.NewRegistry()
+	settingRegistry.MustRegister(settingUC.GeneralDefinitions()...)
+	settingRegistry.MustRegister(settingUC.HRDefinitions()...)
+
+	settingUseCase := settingUC.NewUseCase(
+		companyRepo.NewCompanySettingRepository(db),
+		settingRegistry,
+		cache,
+		auditUseCase,
+	)
+
+	documentUseCase := documentUC.NewUseCase(
+		employeeRepo.NewEmployeeDocumentRepository(db),
+		employeeRepository,
+		companyRepository,
+		memberRepository,
+		userRepository,
+		store,
+		quotaUseCase,
+		settingUseCase,
+		asynqClient,
+		notificationUseCase,
+		auditUseCase,
+	)
+
+	invitationUseCase := invitationUC.NewUseCase(
+		invitationRepository,
+		employeeRepository,
+		memberRepository,
+		roleRepository,
+		companyRepository,
+		userRepository,
+		transactor,
+		asynqClient,
+		quotaUseCase,
+		auditUseCase,
+		notificationUseCase,
+		invitationUC.Config{
+			AcceptURL: cfg.App.FrontendURL + "/invitations/accept",
+		},
+	)
+
+	employeeUseCase := employeeUC.NewUseCase(
+		employeeRepository,
+		invitationRepository,
+		memberRepository,
+		companyRepository,
+		divisionRepository,
+		departmentRepository,
+		positionRepository,
+		employeePositionRepository,
+		employeeRepo.NewEmployeeBankAccountRepository(db),
+		employeeRepo.NewEmergencyContactRepository(db),
+		employeeRepo.NewEmployeeAddressRepository(db),
+		addressRepository,
+		employeeRepo.NewWorkLocationRepository(db),
+		regionRepo.NewRegionRepository(db),
+		transactor,
+		quotaUseCase,
+		settingUseCase,
+		auditUseCase,
+	)
+
+	bulkUseCase := bulkUC.NewUseCase(
+		employeeRepo.NewImportRepository(db),
+		employeeRepository,
+		companyRepository,
+		divisionRepository,
+		departmentRepository,
+		positionRepository,
+		employeePositionRepository,
+		roleRepository,
+		transactor,
+		employeeUseCase,
+		invitationUseCase,
+		asynqClient,
+		auditUseCase,
+	)
+
+	holidays, err := holiday.Indonesia()
+	if err != nil {
+		log.Fatalf("Failed to load holiday calendar: %v", err)
+	}
+
+	leaveUseCase := leaveUC.NewUseCase(
+		leaveRepo.NewTypeRepository(db),
+		leaveRepo.NewBalanceRepository(db),
+		leaveRepo.NewRequestRepository(db),
+		employeeRepository,
+		companyRepository,
+		divisionRepository,
+		departmentRepository,
+		memberRepository,
+		transactor,
+		holidays,
+		employeeUseCase,
+		settingUseCase,
+		notificationUseCase,
+		auditUseCase,
+	)
+
+	encryptionUseCase := encryptionUC.NewUseCase(
+		postgres.NewEncryptedColumnRepository(db),
+		keyring,
+	)
+
 	server := pkgAsynq.NewServer(cfg.Asynq.RedisAddr, 10)
 
 	mux := asynqLib.NewServeMux()
 	mux.HandleFunc(tasks.TypeSendOTPEmail, handleSendOTPEmail(m))
+	mux.HandleFunc(tasks.TypeSendInvitationEmail, handleSendInvitationEmail(m))
+	mux.HandleFunc(tasks.TypeExpireInvitations, handleExpireInvitations(invitationRepository))
+	mux.HandleFunc(tasks.TypeSendSubscriptionEmail, handleSendSubscriptionEmail(m))
+	mux.HandleFunc(tasks.TypeProcessSubscriptions, handleProcessSubscriptions(subscriptionUseCase))
+	mux.HandleFunc(tasks.TypeRenderInvoice, handleRenderInvoice(billingUseCase))
+	mux.HandleFunc(tasks.TypeMarkInvoicesOverdue, handleMarkInvoicesOverdue(billingUseCase))
+	mux.HandleFunc(tasks.TypeWriteAuditLog, handleWriteAuditLog(auditUseCase))
+	mux.HandleFunc(tasks.TypeSendDocumentExpiry, handleSendDocumentExpiry(m))
+	mux.HandleFunc(tasks.TypeRemindExpiringDocuments, handleRemindExpiringDocuments(documentUseCase))
+	mux.HandleFunc(tasks.TypeReencryptFields, handleReencryptFields(encryptionUseCase))
+	mux.HandleFunc(tasks.TypeImportEmployees, handleImportEmployees(bulkUseCase))
+	mux.HandleFunc(tasks.TypeSyncLeaveStatus, handleSyncLeaveStatus(leaveUseCase))
+
+	scheduler := pkgAsynq.NewScheduler(cfg.Asynq.RedisAddr)
+	if err := scheduler.Register("@hourly", tasks.NewExpireInvitationsTask(), asynqLib.Queue("low")); err != nil {
+		log.Fatalf("Failed to register invitation expiry job: %v", err)
+	}
+	if err := scheduler.Register("@hourly", tasks.NewProcessSubscriptionsTask(), asynqLib.Queue("default")); err != nil {
+		log.Fatalf("Failed to register subscription lifecycle job: %v", err)
+	}
+	if err := scheduler.Register("@hourly", tasks.NewMarkInvoicesOverdueTask(), asynqLib.Queue("default")); err != nil {
+		log.Fatalf("Failed to register invoice overdue job: %v", err)
+	}
+	if err := scheduler.Register("@daily", tasks.NewRemindExpiringDocumentsTask(), asynqLib.Queue("low")); err != nil {
+		log.Fatalf("Failed to register document expiry reminder job: %v", err)
+	}
+	if err := scheduler.Register("@daily", tasks.NewReencryptFieldsTask(), asynqLib.Queue("low")); err != nil {
+		log.Fatalf("Failed to register re-encryption job: %v", err)
+	}
+	if err := scheduler.Register("@hourly", tasks.NewSyncLeaveStatusTask(), asynqLib.Queue("default")); err != nil {
+		log.Fatalf("Failed to register leave status job: %v", err)
+	}
 
 	logger.Info("Starting worker...")
 
@@ -66,11 +339,16 @@ func main() {
 		}
 	}()
 
+	if err := scheduler.Start(); err != nil {
+		log.Fatalf("Failed to start scheduler: %v", err)
+	}
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 	<-quit
 
 	logger.Info("Shutting down worker...")
+	scheduler.Shutdown()
 	server.Shutdown()
 	logger.Info("Worker stopped")
 }
@@ -85,3 +363,111 @@ func handleSendOTPEmail(m mailer.Mailer) asynqLib.HandlerFunc {
 		return m.SendOTP(payload.To, payload.Name, payload.OTP, payload.Purpose, payload.Lang)
 	}
 }
+
+func handleSendInvitationEmail(m mailer.Mailer) asynqLib.HandlerFunc {
+	return func(ctx context.Context, t *asynqLib.Task) error {
+		var payload tasks.SendInvitationEmailPayload
+		if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+			return err
+		}
+		logger.Infof("Sending invitation email to %s", payload.To)
+		return m.SendInvitation(payload.To, payload.Name, payload.CompanyName, payload.InviterName, payload.AcceptURL, payload.Lang)
+	}
+}
+
+func handleExpireInvitations(invitationRepo repository.EmployeeInvitationRepository) asynqLib.HandlerFunc {
+	return func(ctx context.Context, t *asynqLib.Task) error {
+		n, err := invitationRepo.ExpirePending(ctx, time.Now())
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			logger.Infof("Expired %d pending invitations", n)
+		}
+		return nil
+	}
+}
+
+func handleSendSubscriptionEmail(m mailer.Mailer) asynqLib.HandlerFunc {
+	return func(ctx context.Context, t *asynqLib.Task) error {
+		var payload tasks.SendSubscriptionEmailPayload
+		if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+			return err
+		}
+		logger.Infof("Sending %s subscription email to %s", payload.Event, payload.To)
+		return m.SendSubscriptionNotice(payload.To, payload.Name, payload.CompanyName, payload.Event, payload.PlanName, payload.At, payload.Lang)
+	}
+}
+
+func handleProcessSubscriptions(subscriptionUseCase *subscriptionUC.UseCase) asynqLib.HandlerFunc {
+	return func(ctx context.Context, t *asynqLib.Task) error {
+		return subscriptionUseCase.ProcessLifecycle(ctx)
+	}
+}
+
+func handleRenderInvoice(billingUseCase *billingUC.UseCase) asynqLib.HandlerFunc {
+	return func(ctx context.Context, t *asynqLib.Task) error {
+		var payload tasks.RenderInvoicePayload
+		if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+			return err
+		}
+		logger.Infof("Rendering invoice %d", payload.InvoiceID)
+		return billingUseCase.RenderPDF(ctx, payload.InvoiceID)
+	}
+}
+
+func handleMarkInvoicesOverdue(billingUseCase *billingUC.UseCase) asynqLib.HandlerFunc {
+	return func(ctx context.Context, t *asynqLib.Task) error {
+		return billingUseCase.MarkOverdue(ctx)
+	}
+}
+
+func handleWriteAuditLog(auditUseCase *auditUC.UseCase) asynqLib.HandlerFunc {
+	return func(ctx context.Context, t *asynqLib.Task) error {
+		var payload tasks.WriteAuditLogPayload
+		if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+			return err
+		}
+		return auditUseCase.Write(ctx, payload)
+	}
+}
+
+func handleSendDocumentExpiry(m mailer.Mailer) asynqLib.HandlerFunc {
+	return func(ctx context.Context, t *asynqLib.Task) error {
+		var payload tasks.SendDocumentExpiryPayload
+		if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+			return err
+		}
+		logger.Infof("Sending document expiry email to %s", payload.To)
+		return m.SendDocumentExpiry(payload.To, payload.Name, payload.CompanyName, payload.EmployeeName, payload.DocumentName, payload.ValidUntil, payload.Self, payload.Lang)
+	}
+}
+
+func handleRemindExpiringDocuments(documentUseCase *documentUC.UseCase) asynqLib.HandlerFunc {
+	return func(ctx context.Context, t *asynqLib.Task) error {
+		return documentUseCase.RemindExpiring(ctx)
+	}
+}
+
+func handleReencryptFields(encryptionUseCase *encryptionUC.UseCase) asynqLib.HandlerFunc {
+	return func(ctx context.Context, t *asynqLib.Task) error {
+		return encryptionUseCase.Reencrypt(ctx)
+	}
+}
+
+func handleImportEmployees(bulkUseCase *bulkUC.UseCase) asynqLib.HandlerFunc {
+	return func(ctx context.Context, t *asynqLib.Task) error {
+		var payload tasks.ImportEmployeesPayload
+		if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+			return err
+		}
+		logger.Infof("Importing employees of import %d", payload.ImportID)
+		return bulkUseCase.Run(ctx, payload.ImportID)
+	}
+}
+
+func handleSyncLeaveStatus(leaveUseCase *leaveUC.UseCase) asynqLib.HandlerFunc {
+	return func(ctx context.Context, t *asynqLib.Task) error {
+		return leaveUseCase.SyncStatus(ctx)
+	}
+}
@@ -0,0 +1,125 @@
+package address
+
+import (
+	"net/http"
+	"strconv"
+
+	companyDTO "github.com/haily-id/engine/internal/domain/dto/company"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/address"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	addressUC *address.UseCase
+}
+
+func NewHandler(addressUC *address.UseCase) *Handler {
+	return &Handler{addressUC: addressUC}
+}
+
+func (h *Handler) List(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	addresses, err := h.addressUC.List(c.Request().Context(), companyID)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, companyDTO.ToAddressDTOs(addresses))
+}
+
+func (h *Handler) Get(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidAddressID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.addressUC.Get(c.Request().Context(), companyID, id)
+	if err != nil {
+		return addressError(c, err)
+	}
+
+	return response.Success(c, companyDTO.ToAddressDTO(a))
+}
+
+func (h *Handler) Create(c echo.Context) error {
+	var req address.CreateAddressRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.addressUC.Create(c.Request().Context(), companyID, req)
+	if err != nil {
+		return addressError(c, err)
+	}
+
+	return response.Created(c, companyDTO.ToAddressDTO(a))
+}
+
+func (h *Handler) Update(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidAddressID)
+	}
+
+	var req address.UpdateAddressRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.addressUC.Update(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return addressError(c, err)
+	}
+
+	return response.Success(c, companyDTO.ToAddressDTO(a))
+}
+
+func (h *Handler) Delete(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidAddressID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	if err := h.addressUC.Delete(c.Request().Context(), companyID, id); err != nil {
+		return addressError(c, err)
+	}
+
+	return response.NoContent(c)
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func addressError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "address not found":
+		return response.Error(c, http.StatusNotFound, response.ErrAddressNotFound)
+	case "province not found", "city not found", "district not found", "village not found":
+		return response.Error(c, http.StatusBadRequest, response.ErrRegionNotFound)
+	case "region mismatch":
+		return response.Error(c, http.StatusBadRequest, response.ErrRegionMismatch)
+	case "first address must be main", "primary address must be main":
+		return response.Error(c, http.StatusBadRequest, response.ErrPrimaryAddressMustBeMain)
+	case "primary address must be active", "primary address required":
+		return response.Error(c, http.StatusConflict, response.ErrPrimaryAddressRequired)
+	case "address in use":
+		return response.Error(c, http.StatusConflict, response.ErrAddressInUse)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
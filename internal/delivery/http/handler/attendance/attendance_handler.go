@@ -0,0 +1,378 @@
+package attendance
+
+import (
+	"net/http"
+	"strconv"
+
+	attendanceDTO "github.com/haily-id/engine/internal/domain/dto/attendance"
+	"github.com/haily-id/engine/internal/domain/entity/rbac"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/attendance"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	attendanceUC *attendance.UseCase
+}
+
+func NewHandler(attendanceUC *attendance.UseCase) *Handler {
+	return &Handler{attendanceUC: attendanceUC}
+}
+
+// ─── Shifts ─────────────────────────────────────────────────────
+
+func (h *Handler) ListShifts(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	includeInactive, _ := strconv.ParseBool(c.QueryParam("include_inactive"))
+	list, err := h.attendanceUC.ListShifts(c.Request().Context(), companyID, !includeInactive)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, attendanceDTO.ToShiftDTOs(list))
+}
+
+func (h *Handler) GetShift(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidShiftID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	s, err := h.attendanceUC.GetShift(c.Request().Context(), companyID, id)
+	if err != nil {
+		return attendanceError(c, err)
+	}
+
+	return response.Success(c, attendanceDTO.ToShiftDTO(s))
+}
+
+func (h *Handler) CreateShift(c echo.Context) error {
+	var req attendance.CreateShiftRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	s, err := h.attendanceUC.CreateShift(c.Request().Context(), companyID, req)
+	if err != nil {
+		return attendanceError(c, err)
+	}
+
+	return response.Created(c, attendanceDTO.ToShiftDTO(s))
+}
+
+func (h *Handler) UpdateShift(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidShiftID)
+	}
+
+	var req attendance.UpdateShiftRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	s, err := h.attendanceUC.UpdateShift(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return attendanceError(c, err)
+	}
+
+	return response.Success(c, attendanceDTO.ToShiftDTO(s))
+}
+
+func (h *Handler) DeleteShift(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidShiftID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	if err := h.attendanceUC.DeleteShift(c.Request().Context(), companyID, id); err != nil {
+		return attendanceError(c, err)
+	}
+
+	return response.NoContent(c)
+}
+
+// ─── Shift Assignments ──────────────────────────────────────────
+
+func (h *Handler) Assignments(c echo.Context) error {
+	employeeID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.attendanceUC.Assignments(c.Request().Context(), companyID, employeeID)
+	if err != nil {
+		return attendanceError(c, err)
+	}
+
+	dtos := make([]attendanceDTO.AssignmentDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, attendanceDTO.ToAssignmentDTO(&list[i].Assignment, list[i].Shift))
+	}
+	return response.Success(c, dtos)
+}
+
+func (h *Handler) AssignShift(c echo.Context) error {
+	employeeID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	var req attendance.AssignShiftRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.attendanceUC.AssignShift(c.Request().Context(), companyID, employeeID, actor(c), req)
+	if err != nil {
+		return attendanceError(c, err)
+	}
+
+	return response.Created(c, attendanceDTO.ToAssignmentDTO(&a.Assignment, a.Shift))
+}
+
+func (h *Handler) DeleteAssignment(c echo.Context) error {
+	employeeID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+	id, err := strconv.ParseInt(c.Param("assignment_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidShiftAssignmentID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	if err := h.attendanceUC.DeleteAssignment(c.Request().Context(), companyID, employeeID, id); err != nil {
+		return attendanceError(c, err)
+	}
+
+	return response.NoContent(c)
+}
+
+// ─── Clocking ───────────────────────────────────────────────────
+
+func (h *Handler) ClockIn(c echo.Context) error {
+	var req attendance.ClockRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	v, err := h.attendanceUC.ClockIn(c.Request().Context(), companyID, actor(c), req)
+	if err != nil {
+		return attendanceError(c, err)
+	}
+
+	return response.Created(c, toRecordDTO(v))
+}
+
+func (h *Handler) ClockOut(c echo.Context) error {
+	var req attendance.ClockRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	v, err := h.attendanceUC.ClockOut(c.Request().Context(), companyID, actor(c), req)
+	if err != nil {
+		return attendanceError(c, err)
+	}
+
+	return response.Success(c, toRecordDTO(v))
+}
+
+// ─── Records ────────────────────────────────────────────────────
+
+func (h *Handler) List(c echo.Context) error {
+	var req attendance.ListRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	page, err := h.attendanceUC.List(c.Request().Context(), companyID, actor(c), req)
+	if err != nil {
+		return attendanceError(c, err)
+	}
+
+	dtos := make([]attendanceDTO.RecordDTO, 0, len(page.Records))
+	for i := range page.Records {
+		dtos = append(dtos, toRecordDTO(&page.Records[i]))
+	}
+	return response.Paginated(c, dtos, page.Total, page.Offset, page.Limit)
+}
+
+func (h *Handler) Get(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidAttendanceRecordID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	v, err := h.attendanceUC.Get(c.Request().Context(), companyID, actor(c), id)
+	if err != nil {
+		return attendanceError(c, err)
+	}
+
+	return response.Success(c, toRecordDTO(v))
+}
+
+func (h *Handler) Correct(c echo.Context) error {
+	var req attendance.CorrectionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	v, err := h.attendanceUC.Correct(c.Request().Context(), companyID, actor(c), req)
+	if err != nil {
+		return attendanceError(c, err)
+	}
+
+	return response.Success(c, toRecordDTO(v))
+}
+
+// ─── Daily Summary ──────────────────────────────────────────────
+
+func (h *Handler) Summary(c echo.Context) error {
+	var req attendance.SummaryRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	s, err := h.attendanceUC.Summary(c.Request().Context(), companyID, req)
+	if err != nil {
+		return attendanceError(c, err)
+	}
+
+	dto := attendanceDTO.SummaryDTO{
+		Date:       s.Date.Format("2006-01-02"),
+		WorkingDay: s.WorkingDay,
+		Holiday:    s.Holiday,
+		Counts: attendanceDTO.SummaryCountsDTO{
+			Total:      s.Counts.Total,
+			Present:    s.Counts.Present,
+			Late:       s.Counts.Late,
+			EarlyLeave: s.Counts.EarlyLeave,
+			OnLeave:    s.Counts.OnLeave,
+			Off:        s.Counts.Off,
+			Absent:     s.Counts.Absent,
+		},
+		Entries: make([]attendanceDTO.SummaryEntryDTO, 0, len(s.Entries)),
+	}
+	for _, entry := range s.Entries {
+		e := attendanceDTO.SummaryEntryDTO{
+			EmployeeID:     strconv.FormatInt(entry.Employee.ID, 10),
+			EmployeeName:   entry.Employee.Name,
+			EmployeeNumber: entry.Employee.EmployeeNumber,
+			Status:         entry.Status,
+		}
+		if entry.Record != nil {
+			r := attendanceDTO.ToRecordDTO(entry.Record, entry.Employee, entry.Shift)
+			e.Record = &r
+		}
+		dto.Entries = append(dto.Entries, e)
+	}
+	return response.Success(c, dto)
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+// actor is the member making the request. Owners and admins act as HR.
+func actor(c echo.Context) attendance.Actor {
+	role, _ := c.Get("company_role").(string)
+	return attendance.Actor{
+		UserID: c.Get("user_id").(int64),
+		Admin:  role == rbac.RoleOwner || role == rbac.RoleAdmin,
+	}
+}
+
+func toRecordDTO(v *attendance.RecordView) attendanceDTO.RecordDTO {
+	return attendanceDTO.ToRecordDTO(&v.Record, v.Employee, v.Shift)
+}
+
+func attendanceError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "shift not found":
+		return response.Error(c, http.StatusNotFound, response.ErrShiftNotFound)
+	case "shift code already exists":
+		return response.Error(c, http.StatusConflict, response.ErrShiftCodeAlreadyExists)
+	case "shift inactive":
+		return response.Error(c, http.StatusBadRequest, response.ErrShiftInactive)
+	case "shift in use":
+		return response.Error(c, http.StatusConflict, response.ErrShiftInUse)
+	case "default shift must be active":
+		return response.Error(c, http.StatusBadRequest, response.ErrDefaultShiftMustBeActive)
+	case "shift assignment not found":
+		return response.Error(c, http.StatusNotFound, response.ErrShiftAssignmentNotFound)
+	case "attendance record not found":
+		return response.Error(c, http.StatusNotFound, response.ErrAttendanceRecordNotFound)
+	case "already clocked in":
+		return response.Error(c, http.StatusConflict, response.ErrAlreadyClockedIn)
+	case "already clocked out":
+		return response.Error(c, http.StatusConflict, response.ErrAlreadyClockedOut)
+	case "not clocked in":
+		return response.Error(c, http.StatusConflict, response.ErrNotClockedIn)
+	case "outside work location":
+		return response.Error(c, http.StatusForbidden, response.ErrOutsideWorkLocation)
+	case "no geofenced work location":
+		return response.Error(c, http.StatusConflict, response.ErrNoGeofencedWorkLocation)
+	case "attendance date in future":
+		return response.Error(c, http.StatusBadRequest, response.ErrAttendanceDateInFuture)
+	case "clock out before clock in":
+		return response.Error(c, http.StatusBadRequest, response.ErrClockOutBeforeClockIn)
+	case "attendance access denied":
+		return response.Error(c, http.StatusForbidden, response.ErrAttendanceAccessDenied)
+	case "employee not found":
+		return response.Error(c, http.StatusNotFound, response.ErrEmployeeNotFound)
+	case "employee not active":
+		return response.Error(c, http.StatusBadRequest, response.ErrEmployeeNotActive)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	auditDTO "github.com/haily-id/engine/internal/domain/dto/audit"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/audit"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	auditUC *audit.UseCase
+}
+
+func NewHandler(auditUC *audit.UseCase) *Handler {
+	return &Handler{auditUC: auditUC}
+}
+
+func (h *Handler) List(c echo.Context) error {
+	var req audit.ListRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	page, err := h.auditUC.List(c.Request().Context(), companyID, req)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Paginated(c, auditDTO.ToAuditLogDTOs(page.Logs), page.Total, page.Offset, page.Limit)
+}
+
+func (h *Handler) Export(c echo.Context) error {
+	var req audit.ListRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	filename := fmt.Sprintf("audit-logs-%s.csv", time.Now().Format("20060102-150405"))
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	res.WriteHeader(http.StatusOK)
+
+	// The status is already sent once rows stream; a failure part way can
+	// only cut the file short.
+	if err := h.auditUC.Export(c.Request().Context(), companyID, req, res); err != nil {
+		logger.Errorf("Audit log export for company %d failed: %v", companyID, err)
+	}
+	return nil
+}
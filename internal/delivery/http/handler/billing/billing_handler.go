@@ -0,0 +1,88 @@
+package billing
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	billingDTO "github.com/haily-id/engine/internal/domain/dto/billing"
+	billingEntity "github.com/haily-id/engine/internal/domain/entity/billing"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/usecase/billing"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	billingUC *billing.UseCase
+}
+
+func NewHandler(billingUC *billing.UseCase) *Handler {
+	return &Handler{billingUC: billingUC}
+}
+
+func (h *Handler) ListInvoices(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	status := strings.ToUpper(c.QueryParam("status"))
+	switch status {
+	case "", billingEntity.InvoiceStatusDraft, billingEntity.InvoiceStatusIssued, billingEntity.InvoiceStatusPaid,
+		billingEntity.InvoiceStatusOverdue, billingEntity.InvoiceStatusVoid:
+	default:
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	invoices, err := h.billingUC.ListByCompany(c.Request().Context(), companyID, status)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, billingDTO.ToInvoiceDTOs(invoices))
+}
+
+func (h *Handler) GetInvoice(c echo.Context) error {
+	invoiceID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidInvoiceID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	inv, err := h.billingUC.Get(c.Request().Context(), companyID, invoiceID)
+	if err != nil {
+		return billingError(c, err)
+	}
+
+	return response.Success(c, billingDTO.ToInvoiceDTO(inv))
+}
+
+func (h *Handler) DownloadInvoicePDF(c echo.Context) error {
+	invoiceID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidInvoiceID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	inv, r, err := h.billingUC.OpenPDF(c.Request().Context(), companyID, invoiceID)
+	if err != nil {
+		return billingError(c, err)
+	}
+	defer r.Close()
+
+	filename := strings.ReplaceAll(inv.InvoiceNumber, "/", "-") + ".pdf"
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	return c.Stream(http.StatusOK, "application/pdf", r)
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func billingError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "invoice not found":
+		return response.Error(c, http.StatusNotFound, response.ErrInvoiceNotFound)
+	case "invoice pdf not ready":
+		return response.Error(c, http.StatusConflict, response.ErrInvoicePDFNotReady)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
@@ -0,0 +1,180 @@
+package bulk
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	employeeDTO "github.com/haily-id/engine/internal/domain/dto/employee"
+	"github.com/haily-id/engine/internal/pkg/i18n"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/pkg/xlsx"
+	"github.com/haily-id/engine/internal/usecase/bulk"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	bulkUC *bulk.UseCase
+}
+
+func NewHandler(bulkUC *bulk.UseCase) *Handler {
+	return &Handler{bulkUC: bulkUC}
+}
+
+// Template downloads an empty import file with the template's header row.
+func (h *Handler) Template(c echo.Context) error {
+	var req bulk.TemplateRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	startDownload(c, "employee-import-template", req.Format)
+	if err := h.bulkUC.Template(c.Request().Context(), req.Format, c.Response()); err != nil {
+		logger.Errorf("Employee import template failed: %v", err)
+	}
+	return nil
+}
+
+// Upload takes a multipart form with the CSV or XLSX file in "file" and
+// runs the dry run on it.
+func (h *Handler) Upload(c echo.Context) error {
+	fh, err := c.FormFile("file")
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrFileRequired)
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrFileRequired)
+	}
+	defer f.Close()
+
+	companyID := c.Get("company_id").(int64)
+	userID := c.Get("user_id").(int64)
+
+	lang := i18n.Detect(c.Request().Header.Get("Accept-Language"))
+	ctx := i18n.WithLang(c.Request().Context(), lang)
+
+	imp, err := h.bulkUC.Validate(ctx, companyID, userID, bulk.File{
+		Name:    fh.Filename,
+		Size:    fh.Size,
+		Content: f,
+	})
+	if err != nil {
+		return bulkError(c, err)
+	}
+
+	return response.Created(c, employeeDTO.ToImportDTO(imp))
+}
+
+func (h *Handler) Get(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("import_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidImportID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	imp, err := h.bulkUC.Get(c.Request().Context(), companyID, id)
+	if err != nil {
+		return bulkError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToImportDTO(imp))
+}
+
+func (h *Handler) Confirm(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("import_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidImportID)
+	}
+
+	var req bulk.ConfirmImportRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	lang := i18n.Detect(c.Request().Header.Get("Accept-Language"))
+	ctx := i18n.WithLang(c.Request().Context(), lang)
+
+	imp, err := h.bulkUC.Confirm(ctx, companyID, id, req)
+	if err != nil {
+		return bulkError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToImportDTO(imp))
+}
+
+func (h *Handler) Export(c echo.Context) error {
+	var req bulk.ExportRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if _, err := bulk.ExportColumnsOf(req); err != nil {
+		return bulkError(c, err)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	startDownload(c, "employees-"+time.Now().Format("20060102-150405"), req.Format)
+
+	// The status is already sent once rows stream; a failure part way can
+	// only cut the file short.
+	if err := h.bulkUC.Export(c.Request().Context(), companyID, req, c.Response()); err != nil {
+		logger.Errorf("Employee export for company %d failed: %v", companyID, err)
+	}
+	return nil
+}
+
+// startDownload sends the headers of a CSV or XLSX attachment.
+func startDownload(c echo.Context, name, format string) {
+	contentType, ext := "text/csv; charset=utf-8", "csv"
+	if format == bulk.FormatXLSX {
+		contentType, ext = xlsx.ContentType, "xlsx"
+	}
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, contentType)
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", name+"."+ext))
+	res.WriteHeader(http.StatusOK)
+}
+
+func bulkError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "import not found":
+		return response.Error(c, http.StatusNotFound, response.ErrImportNotFound)
+	case "import not ready":
+		return response.Error(c, http.StatusConflict, response.ErrImportNotReady)
+	case "import is empty":
+		return response.Error(c, http.StatusBadRequest, response.ErrImportEmpty)
+	case "too many rows":
+		return response.Error(c, http.StatusBadRequest, response.ErrTooManyRows)
+	case "invalid import file":
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidImportFile)
+	case "unknown column":
+		return response.Error(c, http.StatusBadRequest, response.ErrUnknownColumn)
+	case "file is empty":
+		return response.Error(c, http.StatusBadRequest, response.ErrFileEmpty)
+	case "file too large":
+		return response.Error(c, http.StatusBadRequest, response.ErrFileTooLarge)
+	case "unsupported file type":
+		return response.Error(c, http.StatusBadRequest, response.ErrUnsupportedFileType)
+	case "role not found":
+		return response.Error(c, http.StatusNotFound, response.ErrRoleNotFound)
+	case "role not assignable":
+		return response.Error(c, http.StatusBadRequest, response.ErrRoleNotAssignable)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
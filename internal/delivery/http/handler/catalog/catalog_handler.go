@@ -0,0 +1,165 @@
+package catalog
+
+import (
+	"net/http"
+	"strconv"
+
+	companyDTO "github.com/haily-id/engine/internal/domain/dto/company"
+	employeeDTO "github.com/haily-id/engine/internal/domain/dto/employee"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/catalog"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	catalogUC *catalog.UseCase
+}
+
+func NewHandler(catalogUC *catalog.UseCase) *Handler {
+	return &Handler{catalogUC: catalogUC}
+}
+
+// ─── Industries ─────────────────────────────────────────────────
+
+func (h *Handler) ListIndustries(c echo.Context) error {
+	industries, err := h.catalogUC.ListIndustries(c.Request().Context(), false)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, companyDTO.ToIndustryDTOs(industries))
+}
+
+func (h *Handler) ListAllIndustries(c echo.Context) error {
+	industries, err := h.catalogUC.ListIndustries(c.Request().Context(), true)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, companyDTO.ToIndustryDTOs(industries))
+}
+
+func (h *Handler) CreateIndustry(c echo.Context) error {
+	var req catalog.CreateEntryRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	i, err := h.catalogUC.CreateIndustry(c.Request().Context(), req)
+	if err != nil {
+		return catalogError(c, err)
+	}
+
+	return response.Created(c, companyDTO.ToIndustryDTO(i))
+}
+
+func (h *Handler) UpdateIndustry(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidIndustryID)
+	}
+
+	var req catalog.UpdateEntryRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	i, err := h.catalogUC.UpdateIndustry(c.Request().Context(), id, req)
+	if err != nil {
+		return catalogError(c, err)
+	}
+
+	return response.Success(c, companyDTO.ToIndustryDTO(i))
+}
+
+// ─── Company Types ──────────────────────────────────────────────
+
+func (h *Handler) ListCompanyTypes(c echo.Context) error {
+	types, err := h.catalogUC.ListCompanyTypes(c.Request().Context(), false)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, companyDTO.ToCompanyTypeDTOs(types))
+}
+
+func (h *Handler) ListAllCompanyTypes(c echo.Context) error {
+	types, err := h.catalogUC.ListCompanyTypes(c.Request().Context(), true)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, companyDTO.ToCompanyTypeDTOs(types))
+}
+
+func (h *Handler) CreateCompanyType(c echo.Context) error {
+	var req catalog.CreateEntryRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	t, err := h.catalogUC.CreateCompanyType(c.Request().Context(), req)
+	if err != nil {
+		return catalogError(c, err)
+	}
+
+	return response.Created(c, companyDTO.ToCompanyTypeDTO(t))
+}
+
+func (h *Handler) UpdateCompanyType(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidCompanyTypeID)
+	}
+
+	var req catalog.UpdateEntryRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	t, err := h.catalogUC.UpdateCompanyType(c.Request().Context(), id, req)
+	if err != nil {
+		return catalogError(c, err)
+	}
+
+	return response.Success(c, companyDTO.ToCompanyTypeDTO(t))
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func catalogError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "industry not found":
+		return response.Error(c, http.StatusNotFound, response.ErrIndustryNotFound)
+	case "industry code already exists":
+		return response.Error(c, http.StatusConflict, response.ErrIndustryCodeAlreadyExists)
+	case "industry name already exists":
+		return response.Error(c, http.StatusConflict, response.ErrIndustryNameAlreadyExists)
+	case "company type not found":
+		return response.Error(c, http.StatusNotFound, response.ErrCompanyTypeNotFound)
+	case "company type code already exists":
+		return response.Error(c, http.StatusConflict, response.ErrCompanyTypeCodeAlreadyExists)
+	case "company type name already exists":
+		return response.Error(c, http.StatusConflict, response.ErrCompanyTypeNameAlreadyExists)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
+
+// ─── Banks ──────────────────────────────────────────────────────
+
+func (h *Handler) ListBanks(c echo.Context) error {
+	return response.Success(c, employeeDTO.ToBankDTOs(h.catalogUC.ListBanks()))
+}
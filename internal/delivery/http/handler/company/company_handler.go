@@ -0,0 +1,80 @@
+package company
+
+import (
+	"net/http"
+
+	companyDTO "github.com/haily-id/engine/internal/domain/dto/company"
+	rbacDTO "github.com/haily-id/engine/internal/domain/dto/rbac"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/company"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	companyUC *company.UseCase
+}
+
+func NewHandler(companyUC *company.UseCase) *Handler {
+	return &Handler{companyUC: companyUC}
+}
+
+func (h *Handler) Create(c echo.Context) error {
+	var req company.CreateCompanyRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	userID := c.Get("user_id").(int64)
+
+	co, err := h.companyUC.Create(c.Request().Context(), userID, req)
+	if err != nil {
+		switch err.Error() {
+		case "company code already exists":
+			return response.Error(c, http.StatusConflict, response.ErrCompanyCodeAlreadyExists)
+		case "industry not available":
+			return response.Error(c, http.StatusBadRequest, response.ErrIndustryNotAvailable)
+		case "company type not available":
+			return response.Error(c, http.StatusBadRequest, response.ErrCompanyTypeNotAvailable)
+		}
+		return response.Error(c, http.StatusInternalServerError, response.ErrCompanyCreateFailed)
+	}
+
+	return response.Created(c, companyDTO.ToDTO(co))
+}
+
+func (h *Handler) ListMine(c echo.Context) error {
+	userID := c.Get("user_id").(int64)
+
+	companies, err := h.companyUC.ListMine(c.Request().Context(), userID)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrCompanyListFailed)
+	}
+
+	return response.Success(c, companyDTO.ToDTOs(companies))
+}
+
+func (h *Handler) Get(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	co, err := h.companyUC.GetByID(c.Request().Context(), companyID)
+	if err != nil {
+		return response.Error(c, http.StatusNotFound, response.ErrCompanyNotFound)
+	}
+
+	return response.Success(c, companyDTO.ToDTO(co))
+}
+
+func (h *Handler) ListRoles(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	roles, err := h.companyUC.ListRoles(c.Request().Context(), companyID)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, rbacDTO.ToDTOs(roles))
+}
@@ -0,0 +1,223 @@
+package document
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	employeeDTO "github.com/haily-id/engine/internal/domain/dto/employee"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/document"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	documentUC *document.UseCase
+}
+
+func NewHandler(documentUC *document.UseCase) *Handler {
+	return &Handler{documentUC: documentUC}
+}
+
+func (h *Handler) List(c echo.Context) error {
+	var req document.ListRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	page, err := h.documentUC.List(c.Request().Context(), companyID, req)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Paginated(c, employeeDTO.ToDocumentDTOs(page.Documents), page.Total, page.Offset, page.Limit)
+}
+
+func (h *Handler) ListByEmployee(c echo.Context) error {
+	employeeID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	var req document.ListRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	page, err := h.documentUC.ListByEmployee(c.Request().Context(), companyID, employeeID, req)
+	if err != nil {
+		return documentError(c, err)
+	}
+
+	return response.Paginated(c, employeeDTO.ToDocumentDTOs(page.Documents), page.Total, page.Offset, page.Limit)
+}
+
+func (h *Handler) Get(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidDocumentID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	d, err := h.documentUC.Get(c.Request().Context(), companyID, id)
+	if err != nil {
+		return documentError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToDocumentDTO(d))
+}
+
+// Upload takes a multipart form with the file in "file" and the document
+// fields alongside.
+func (h *Handler) Upload(c echo.Context) error {
+	employeeID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	var req document.UploadRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	fh, err := c.FormFile("file")
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrFileRequired)
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrFileRequired)
+	}
+	defer f.Close()
+
+	companyID := c.Get("company_id").(int64)
+
+	d, err := h.documentUC.Upload(c.Request().Context(), companyID, employeeID, req, document.File{
+		Name:    fh.Filename,
+		Size:    fh.Size,
+		Content: f,
+	})
+	if err != nil {
+		return documentError(c, err)
+	}
+
+	return response.Created(c, employeeDTO.ToDocumentDTO(d))
+}
+
+func (h *Handler) Download(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidDocumentID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	d, r, err := h.documentUC.Open(c.Request().Context(), companyID, id)
+	if err != nil {
+		return documentError(c, err)
+	}
+	defer r.Close()
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", d.FileName))
+	return c.Stream(http.StatusOK, d.ContentType, r)
+}
+
+func (h *Handler) Verify(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidDocumentID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+	userID := c.Get("user_id").(int64)
+
+	d, err := h.documentUC.Verify(c.Request().Context(), companyID, id, userID)
+	if err != nil {
+		return documentError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToDocumentDTO(d))
+}
+
+func (h *Handler) Reject(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidDocumentID)
+	}
+
+	var req document.RejectRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+	userID := c.Get("user_id").(int64)
+
+	d, err := h.documentUC.Reject(c.Request().Context(), companyID, id, userID, req)
+	if err != nil {
+		return documentError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToDocumentDTO(d))
+}
+
+func (h *Handler) Delete(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidDocumentID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	if err := h.documentUC.Delete(c.Request().Context(), companyID, id); err != nil {
+		return documentError(c, err)
+	}
+
+	return response.NoContent(c)
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func documentError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "document not found":
+		return response.Error(c, http.StatusNotFound, response.ErrDocumentNotFound)
+	case "document file not found":
+		return response.Error(c, http.StatusNotFound, response.ErrDocumentFileNotFound)
+	case "employee not found":
+		return response.Error(c, http.StatusNotFound, response.ErrEmployeeNotFound)
+	case "document already verified":
+		return response.Error(c, http.StatusConflict, response.ErrDocumentAlreadyVerified)
+	case "document already rejected":
+		return response.Error(c, http.StatusConflict, response.ErrDocumentAlreadyRejected)
+	case "file is empty":
+		return response.Error(c, http.StatusBadRequest, response.ErrFileEmpty)
+	case "file too large":
+		return response.Error(c, http.StatusBadRequest, response.ErrFileTooLarge)
+	case "unsupported file type":
+		return response.Error(c, http.StatusBadRequest, response.ErrUnsupportedFileType)
+	case "valid until before valid from":
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidValidityPeriod)
+	case "storage quota exceeded":
+		return response.Error(c, http.StatusForbidden, response.ErrStorageQuotaExceeded)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
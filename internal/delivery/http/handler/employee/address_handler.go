@@ -0,0 +1,121 @@
+package employee
+
+import (
+	"net/http"
+	"strconv"
+
+	employeeDTO "github.com/haily-id/engine/internal/domain/dto/employee"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/employee"
+	"github.com/labstack/echo/v4"
+)
+
+// ─── Addresses ──────────────────────────────────────────────────
+
+func (h *Handler) Addresses(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.employeeUC.Addresses(c.Request().Context(), companyID, id)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToAddressDTOs(list))
+}
+
+func (h *Handler) AddAddress(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	var req employee.AddressRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.employeeUC.AddAddress(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Created(c, employeeDTO.ToAddressDTO(a))
+}
+
+func (h *Handler) UpdateAddress(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+	addressID, err := strconv.ParseInt(c.Param("address_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeAddressID)
+	}
+
+	var req employee.UpdateAddressRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.employeeUC.UpdateAddress(c.Request().Context(), companyID, id, addressID, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToAddressDTO(a))
+}
+
+func (h *Handler) SetPrimaryAddress(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+	addressID, err := strconv.ParseInt(c.Param("address_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeAddressID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.employeeUC.SetPrimaryAddress(c.Request().Context(), companyID, id, addressID)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToAddressDTO(a))
+}
+
+func (h *Handler) DeleteAddress(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+	addressID, err := strconv.ParseInt(c.Param("address_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeAddressID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	if err := h.employeeUC.DeleteAddress(c.Request().Context(), companyID, id, addressID); err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.NoContent(c)
+}
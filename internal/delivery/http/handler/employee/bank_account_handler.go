@@ -0,0 +1,130 @@
+package employee
+
+import (
+	"net/http"
+	"strconv"
+
+	employeeDTO "github.com/haily-id/engine/internal/domain/dto/employee"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/employee"
+	"github.com/labstack/echo/v4"
+)
+
+// ─── Bank Accounts ──────────────────────────────────────────────
+
+func (h *Handler) BankAccounts(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.employeeUC.BankAccounts(c.Request().Context(), companyID, id)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToBankAccountDTOs(list, unmasked(c)))
+}
+
+func (h *Handler) AddBankAccount(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	var req employee.BankAccountRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.employeeUC.AddBankAccount(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Created(c, employeeDTO.ToBankAccountDTO(a, unmasked(c)))
+}
+
+func (h *Handler) UpdateBankAccount(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+	accountID, err := strconv.ParseInt(c.Param("account_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidBankAccountID)
+	}
+
+	var req employee.UpdateBankAccountRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.employeeUC.UpdateBankAccount(c.Request().Context(), companyID, id, accountID, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToBankAccountDTO(a, unmasked(c)))
+}
+
+func (h *Handler) SetPrimaryBankAccount(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+	accountID, err := strconv.ParseInt(c.Param("account_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidBankAccountID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.employeeUC.SetPrimaryBankAccount(c.Request().Context(), companyID, id, accountID)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToBankAccountDTO(a, unmasked(c)))
+}
+
+func (h *Handler) DeleteBankAccount(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+	accountID, err := strconv.ParseInt(c.Param("account_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidBankAccountID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	if err := h.employeeUC.DeleteBankAccount(c.Request().Context(), companyID, id, accountID); err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.NoContent(c)
+}
+
+// ─── Bank Account Helpers ───────────────────────────────────────
+
+// unmasked reports whether the member's role may see full account numbers.
+func unmasked(c echo.Context) bool {
+	role, _ := c.Get("company_role").(string)
+	return employeeEntity.CanViewAccountNumber(role)
+}
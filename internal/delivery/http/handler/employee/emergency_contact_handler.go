@@ -0,0 +1,121 @@
+package employee
+
+import (
+	"net/http"
+	"strconv"
+
+	employeeDTO "github.com/haily-id/engine/internal/domain/dto/employee"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/employee"
+	"github.com/labstack/echo/v4"
+)
+
+// ─── Emergency Contacts ─────────────────────────────────────────
+
+func (h *Handler) EmergencyContacts(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.employeeUC.EmergencyContacts(c.Request().Context(), companyID, id)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToEmergencyContactDTOs(list))
+}
+
+func (h *Handler) AddEmergencyContact(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	var req employee.EmergencyContactRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.employeeUC.AddEmergencyContact(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Created(c, employeeDTO.ToEmergencyContactDTO(a))
+}
+
+func (h *Handler) UpdateEmergencyContact(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+	contactID, err := strconv.ParseInt(c.Param("contact_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmergencyContactID)
+	}
+
+	var req employee.UpdateEmergencyContactRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.employeeUC.UpdateEmergencyContact(c.Request().Context(), companyID, id, contactID, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToEmergencyContactDTO(a))
+}
+
+func (h *Handler) SetPrimaryEmergencyContact(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+	contactID, err := strconv.ParseInt(c.Param("contact_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmergencyContactID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.employeeUC.SetPrimaryEmergencyContact(c.Request().Context(), companyID, id, contactID)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToEmergencyContactDTO(a))
+}
+
+func (h *Handler) DeleteEmergencyContact(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+	contactID, err := strconv.ParseInt(c.Param("contact_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmergencyContactID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	if err := h.employeeUC.DeleteEmergencyContact(c.Request().Context(), companyID, id, contactID); err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.NoContent(c)
+}
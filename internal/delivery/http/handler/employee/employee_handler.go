@@ -0,0 +1,267 @@
+package employee
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	employeeDTO "github.com/haily-id/engine/internal/domain/dto/employee"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/employee"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	employeeUC *employee.UseCase
+}
+
+func NewHandler(employeeUC *employee.UseCase) *Handler {
+	return &Handler{employeeUC: employeeUC}
+}
+
+func (h *Handler) List(c echo.Context) error {
+	var req employee.ListRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	page, err := h.employeeUC.List(c.Request().Context(), companyID, req)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Paginated(c, employeeDTO.ToEmployeeDTOs(page.Employees), page.Total, page.Offset, page.Limit)
+}
+
+func (h *Handler) Get(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	e, err := h.employeeUC.Get(c.Request().Context(), companyID, id)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToEmployeeDTO(e))
+}
+
+func (h *Handler) Create(c echo.Context) error {
+	var req employee.CreateEmployeeRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	e, err := h.employeeUC.Create(c.Request().Context(), companyID, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Created(c, employeeDTO.ToEmployeeDTO(e))
+}
+
+func (h *Handler) Update(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	var req employee.UpdateEmployeeRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	e, err := h.employeeUC.Update(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToEmployeeDTO(e))
+}
+
+func (h *Handler) Delete(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	if err := h.employeeUC.Delete(c.Request().Context(), companyID, id); err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.NoContent(c)
+}
+
+// ─── Lifecycle ──────────────────────────────────────────────────
+
+func (h *Handler) Terminate(c echo.Context) error {
+	return h.changeStatus(c, h.employeeUC.Terminate)
+}
+
+func (h *Handler) Suspend(c echo.Context) error {
+	return h.changeStatus(c, h.employeeUC.Suspend)
+}
+
+func (h *Handler) PutOnLeave(c echo.Context) error {
+	return h.changeStatus(c, h.employeeUC.PutOnLeave)
+}
+
+func (h *Handler) Reactivate(c echo.Context) error {
+	return h.changeStatus(c, h.employeeUC.Reactivate)
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+type statusAction func(ctx context.Context, companyID, id int64, req employee.StatusChangeRequest) (*employeeEntity.Employee, error)
+
+func (h *Handler) changeStatus(c echo.Context, action statusAction) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	var req employee.StatusChangeRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	e, err := action(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToEmployeeDTO(e))
+}
+
+func employeeError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "employee not found":
+		return response.Error(c, http.StatusNotFound, response.ErrEmployeeNotFound)
+	case "employee email already exists":
+		return response.Error(c, http.StatusConflict, response.ErrEmployeeEmailAlreadyExists)
+	case "employee number already exists":
+		return response.Error(c, http.StatusConflict, response.ErrEmployeeNumberAlreadyExists)
+	case "employee number required":
+		return response.Error(c, http.StatusBadRequest, response.ErrEmployeeNumberRequired)
+	case "employee number not available":
+		return response.Error(c, http.StatusConflict, response.ErrEmployeeNumberNotAvailable)
+	case "employee already linked to a user":
+		return response.Error(c, http.StatusConflict, response.ErrEmployeeAlreadyLinked)
+	case "division not found":
+		return response.Error(c, http.StatusBadRequest, response.ErrDivisionNotFound)
+	case "division not available":
+		return response.Error(c, http.StatusBadRequest, response.ErrDivisionNotAvailable)
+	case "department not found":
+		return response.Error(c, http.StatusBadRequest, response.ErrDepartmentNotFound)
+	case "department not available":
+		return response.Error(c, http.StatusBadRequest, response.ErrDepartmentNotAvailable)
+	case "department not in division":
+		return response.Error(c, http.StatusBadRequest, response.ErrDepartmentNotInDivision)
+	case "invalid national id":
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	case "national id region not found":
+		return response.Error(c, http.StatusBadRequest, response.ErrNationalIDRegionNotFound)
+	case "national id birth date mismatch":
+		return response.Error(c, http.StatusBadRequest, response.ErrNationalIDBirthDateMismatch)
+	case "national id gender mismatch":
+		return response.Error(c, http.StatusBadRequest, response.ErrNationalIDGenderMismatch)
+	case "national id already exists":
+		return response.Error(c, http.StatusConflict, response.ErrNationalIDAlreadyExists)
+	case "tax id does not match national id":
+		return response.Error(c, http.StatusBadRequest, response.ErrTaxIDNationalIDMismatch)
+	case "termination date before hire date":
+		return response.Error(c, http.StatusBadRequest, response.ErrTerminationBeforeHire)
+	case "invalid status transition":
+		return response.Error(c, http.StatusConflict, response.ErrInvalidStatusTransition)
+	case "cannot change owner status":
+		return response.Error(c, http.StatusForbidden, response.ErrCannotChangeOwnerStatus)
+	case "employee position not found":
+		return response.Error(c, http.StatusNotFound, response.ErrEmployeePositionNotFound)
+	case "employee not active":
+		return response.Error(c, http.StatusBadRequest, response.ErrEmployeeNotActive)
+	case "position not found":
+		return response.Error(c, http.StatusBadRequest, response.ErrPositionNotFound)
+	case "position not available":
+		return response.Error(c, http.StatusBadRequest, response.ErrPositionNotAvailable)
+	case "end date before start date":
+		return response.Error(c, http.StatusBadRequest, response.ErrEndDateBeforeStartDate)
+	case "effective date not after current start":
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEffectiveDate)
+	case "no primary position":
+		return response.Error(c, http.StatusConflict, response.ErrNoPrimaryPosition)
+	case "position unchanged":
+		return response.Error(c, http.StatusConflict, response.ErrPositionUnchanged)
+	case "position level not higher":
+		return response.Error(c, http.StatusBadRequest, response.ErrPositionLevelNotHigher)
+	case "position level not lower":
+		return response.Error(c, http.StatusBadRequest, response.ErrPositionLevelNotLower)
+	case "position already assigned":
+		return response.Error(c, http.StatusConflict, response.ErrPositionAlreadyAssigned)
+	case "primary position overlaps":
+		return response.Error(c, http.StatusConflict, response.ErrPrimaryPositionOverlaps)
+	case "bank account not found":
+		return response.Error(c, http.StatusNotFound, response.ErrBankAccountNotFound)
+	case "bank account already exists":
+		return response.Error(c, http.StatusConflict, response.ErrBankAccountAlreadyExists)
+	case "bank not found":
+		return response.Error(c, http.StatusBadRequest, response.ErrBankNotFound)
+	case "invalid account number":
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidAccountNumber)
+	case "emergency contact not found":
+		return response.Error(c, http.StatusNotFound, response.ErrEmergencyContactNotFound)
+	case "invalid phone number":
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidPhoneNumber)
+	case "employee address not found":
+		return response.Error(c, http.StatusNotFound, response.ErrEmployeeAddressNotFound)
+	case "work location not found":
+		return response.Error(c, http.StatusNotFound, response.ErrWorkLocationNotFound)
+	case "address not found":
+		return response.Error(c, http.StatusBadRequest, response.ErrAddressNotFound)
+	case "address not available":
+		return response.Error(c, http.StatusBadRequest, response.ErrAddressNotAvailable)
+	case "no primary work location":
+		return response.Error(c, http.StatusConflict, response.ErrNoPrimaryWorkLocation)
+	case "work location unchanged":
+		return response.Error(c, http.StatusConflict, response.ErrWorkLocationUnchanged)
+	case "work location already assigned":
+		return response.Error(c, http.StatusConflict, response.ErrWorkLocationAlreadyAssigned)
+	case "primary work location overlaps":
+		return response.Error(c, http.StatusConflict, response.ErrPrimaryWorkLocationOverlaps)
+	case "province not found", "city not found", "district not found", "village not found":
+		return response.Error(c, http.StatusBadRequest, response.ErrRegionNotFound)
+	case "region mismatch":
+		return response.Error(c, http.StatusBadRequest, response.ErrRegionMismatch)
+	case "user limit reached":
+		return response.Error(c, http.StatusForbidden, response.ErrUserLimitReached)
+	case "employee limit reached":
+		return response.Error(c, http.StatusForbidden, response.ErrEmployeeLimitReached)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
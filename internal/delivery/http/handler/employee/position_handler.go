@@ -0,0 +1,210 @@
+package employee
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	employeeDTO "github.com/haily-id/engine/internal/domain/dto/employee"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/employee"
+	"github.com/labstack/echo/v4"
+)
+
+// ─── Positions ──────────────────────────────────────────────────
+
+func (h *Handler) Positions(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	var req employee.AsOfRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.employeeUC.Timeline(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, toAssignmentDTOs(list))
+}
+
+func (h *Handler) Assignments(c echo.Context) error {
+	var req employee.CompanyAssignmentsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.employeeUC.Assignments(c.Request().Context(), companyID, req)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, toAssignmentDTOs(list))
+}
+
+func (h *Handler) AssignPosition(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	var req employee.AssignPositionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.employeeUC.AssignPosition(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Created(c, toAssignmentDTO(a))
+}
+
+func (h *Handler) Promote(c echo.Context) error {
+	return h.changePosition(c, h.employeeUC.Promote)
+}
+
+func (h *Handler) Transfer(c echo.Context) error {
+	return h.changePosition(c, h.employeeUC.Transfer)
+}
+
+func (h *Handler) Demote(c echo.Context) error {
+	return h.changePosition(c, h.employeeUC.Demote)
+}
+
+func (h *Handler) EndPosition(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+	positionID, err := strconv.ParseInt(c.Param("position_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeePositionID)
+	}
+
+	var req employee.EndPositionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.employeeUC.EndPosition(c.Request().Context(), companyID, id, positionID, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, toAssignmentDTO(a))
+}
+
+func (h *Handler) DeletePosition(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+	positionID, err := strconv.ParseInt(c.Param("position_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeePositionID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	if err := h.employeeUC.DeletePosition(c.Request().Context(), companyID, id, positionID); err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.NoContent(c)
+}
+
+// ─── Position Helpers ───────────────────────────────────────────
+
+type positionAction func(ctx context.Context, companyID, id int64, req employee.PositionChangeRequest) (*employee.Assignment, error)
+
+func (h *Handler) changePosition(c echo.Context, action positionAction) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	var req employee.PositionChangeRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := action(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Created(c, toAssignmentDTO(a))
+}
+
+func toAssignmentDTO(a *employee.Assignment) employeeDTO.AssignmentDTO {
+	r := a.Record
+	dto := employeeDTO.AssignmentDTO{
+		ID:         strconv.FormatInt(r.ID, 10),
+		EmployeeID: strconv.FormatInt(r.EmployeeID, 10),
+		PositionID: strconv.FormatInt(r.PositionID, 10),
+		StartDate:  r.StartDate.Format("2006-01-02"),
+		IsPrimary:  r.IsPrimary,
+		ChangeType: r.ChangeType,
+		Reason:     r.Reason,
+		CreatedAt:  r.CreatedAt.Unix(),
+	}
+	if r.EndDate != nil {
+		end := r.EndDate.Format("2006-01-02")
+		dto.EndDate = &end
+	}
+	if p := a.Position; p != nil {
+		departmentID := strconv.FormatInt(p.DepartmentID, 10)
+		dto.PositionTitle = &p.Title
+		dto.PositionCode = &p.Code
+		dto.Level = &p.Level
+		dto.DepartmentID = &departmentID
+	}
+	if e := a.Employee; e != nil {
+		dto.Employee = &employeeDTO.AssignmentEmployeeDTO{
+			Name:             e.Name,
+			EmployeeNumber:   e.EmployeeNumber,
+			EmploymentStatus: e.EmploymentStatus,
+		}
+	}
+	return dto
+}
+
+func toAssignmentDTOs(list []employee.Assignment) []employeeDTO.AssignmentDTO {
+	dtos := make([]employeeDTO.AssignmentDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, toAssignmentDTO(&list[i]))
+	}
+	return dtos
+}
@@ -0,0 +1,221 @@
+package employee
+
+import (
+	"net/http"
+	"strconv"
+
+	employeeDTO "github.com/haily-id/engine/internal/domain/dto/employee"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/employee"
+	"github.com/labstack/echo/v4"
+)
+
+// ─── Work Locations ─────────────────────────────────────────────
+
+func (h *Handler) WorkLocations(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	var req employee.AsOfRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.employeeUC.WorkLocations(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, toWorkLocationDTOs(list))
+}
+
+func (h *Handler) LocationAssignments(c echo.Context) error {
+	var req employee.CompanyWorkLocationsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.employeeUC.LocationAssignments(c.Request().Context(), companyID, req)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, toWorkLocationDTOs(list))
+}
+
+func (h *Handler) Headcount(c echo.Context) error {
+	var req employee.AsOfRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.employeeUC.Headcount(c.Request().Context(), companyID, req)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	dtos := make([]employeeDTO.LocationHeadcountDTO, 0, len(list))
+	for _, l := range list {
+		dtos = append(dtos, employeeDTO.LocationHeadcountDTO{
+			AddressID:        strconv.FormatInt(l.Address.ID, 10),
+			AddressType:      l.Address.Type,
+			AddressLine1:     l.Address.AddressLine1,
+			IsActive:         l.Address.IsActive,
+			Headcount:        l.Total,
+			PrimaryHeadcount: l.Primary,
+		})
+	}
+	return response.Success(c, dtos)
+}
+
+func (h *Handler) AssignWorkLocation(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	var req employee.AssignWorkLocationRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.employeeUC.AssignWorkLocation(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Created(c, toWorkLocationDTO(a))
+}
+
+func (h *Handler) ReassignWorkLocation(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	var req employee.ReassignWorkLocationRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.employeeUC.ReassignWorkLocation(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Created(c, toWorkLocationDTO(a))
+}
+
+func (h *Handler) EndWorkLocation(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+	locationID, err := strconv.ParseInt(c.Param("location_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidWorkLocationID)
+	}
+
+	var req employee.EndWorkLocationRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	a, err := h.employeeUC.EndWorkLocation(c.Request().Context(), companyID, id, locationID, req)
+	if err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.Success(c, toWorkLocationDTO(a))
+}
+
+func (h *Handler) DeleteWorkLocation(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+	locationID, err := strconv.ParseInt(c.Param("location_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidWorkLocationID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	if err := h.employeeUC.DeleteWorkLocation(c.Request().Context(), companyID, id, locationID); err != nil {
+		return employeeError(c, err)
+	}
+
+	return response.NoContent(c)
+}
+
+// ─── Work Location Helpers ──────────────────────────────────────
+
+func toWorkLocationDTO(a *employee.LocationAssignment) employeeDTO.WorkLocationDTO {
+	r := a.Record
+	dto := employeeDTO.WorkLocationDTO{
+		ID:         strconv.FormatInt(r.ID, 10),
+		EmployeeID: strconv.FormatInt(r.EmployeeID, 10),
+		AddressID:  strconv.FormatInt(r.CompanyAddressID, 10),
+		StartDate:  r.StartDate.Format("2006-01-02"),
+		IsPrimary:  r.IsPrimary,
+		Reason:     r.Reason,
+		CreatedAt:  r.CreatedAt.Unix(),
+	}
+	if r.EndDate != nil {
+		end := r.EndDate.Format("2006-01-02")
+		dto.EndDate = &end
+	}
+	if addr := a.Address; addr != nil {
+		dto.AddressType = &addr.Type
+		dto.AddressLine1 = &addr.AddressLine1
+	}
+	if e := a.Employee; e != nil {
+		dto.Employee = &employeeDTO.AssignmentEmployeeDTO{
+			Name:             e.Name,
+			EmployeeNumber:   e.EmployeeNumber,
+			EmploymentStatus: e.EmploymentStatus,
+		}
+	}
+	return dto
+}
+
+func toWorkLocationDTOs(list []employee.LocationAssignment) []employeeDTO.WorkLocationDTO {
+	dtos := make([]employeeDTO.WorkLocationDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, toWorkLocationDTO(&list[i]))
+	}
+	return dtos
+}
@@ -0,0 +1,127 @@
+package entitlement
+
+import (
+	"net/http"
+	"strconv"
+
+	subscriptionDTO "github.com/haily-id/engine/internal/domain/dto/subscription"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/entitlement"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	entitlementUC *entitlement.UseCase
+}
+
+func NewHandler(entitlementUC *entitlement.UseCase) *Handler {
+	return &Handler{entitlementUC: entitlementUC}
+}
+
+func (h *Handler) ListForCompany(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.entitlementUC.ListForCompany(c.Request().Context(), companyID)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	dtos := make([]subscriptionDTO.EntitlementDTO, 0, len(list))
+	for i := range list {
+		e := &list[i]
+		dto := subscriptionDTO.EntitlementDTO{
+			Module:  subscriptionDTO.ToModuleDTO(&e.Module),
+			Enabled: e.Enabled,
+			Source:  e.Source,
+		}
+		if e.ExpiresAt != nil {
+			v := e.ExpiresAt.Unix()
+			dto.ExpiresAt = &v
+		}
+		dtos = append(dtos, dto)
+	}
+
+	return response.Success(c, dtos)
+}
+
+// ─── Platform admin ─────────────────────────────────────────────
+
+func (h *Handler) SetOverride(c echo.Context) error {
+	companyID, err := strconv.ParseInt(c.Param("company_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidCompanyID)
+	}
+	moduleID, err := strconv.ParseInt(c.Param("module_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidModuleID)
+	}
+
+	var req entitlement.SetOverrideRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	cm, err := h.entitlementUC.SetOverride(c.Request().Context(), companyID, moduleID, req)
+	if err != nil {
+		return entitlementError(c, err)
+	}
+
+	return response.Success(c, subscriptionDTO.ToCompanyModuleDTO(cm))
+}
+
+func (h *Handler) RemoveOverride(c echo.Context) error {
+	companyID, err := strconv.ParseInt(c.Param("company_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidCompanyID)
+	}
+	moduleID, err := strconv.ParseInt(c.Param("module_id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidModuleID)
+	}
+
+	if err := h.entitlementUC.RemoveOverride(c.Request().Context(), companyID, moduleID); err != nil {
+		return entitlementError(c, err)
+	}
+
+	return response.NoContent(c)
+}
+
+func (h *Handler) SetModuleActive(c echo.Context) error {
+	moduleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidModuleID)
+	}
+
+	var req entitlement.SetModuleActiveRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	m, err := h.entitlementUC.SetModuleActive(c.Request().Context(), moduleID, req)
+	if err != nil {
+		return entitlementError(c, err)
+	}
+
+	return response.Success(c, subscriptionDTO.ToModuleDTO(m))
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func entitlementError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "company not found":
+		return response.Error(c, http.StatusNotFound, response.ErrCompanyNotFound)
+	case "module not found":
+		return response.Error(c, http.StatusNotFound, response.ErrModuleNotFound)
+	case "expiry must be in the future":
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidExpiry)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
@@ -0,0 +1,157 @@
+package invitation
+
+import (
+	"net/http"
+	"strconv"
+
+	employeeDTO "github.com/haily-id/engine/internal/domain/dto/employee"
+	"github.com/haily-id/engine/internal/pkg/i18n"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/invitation"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	invitationUC *invitation.UseCase
+}
+
+func NewHandler(invitationUC *invitation.UseCase) *Handler {
+	return &Handler{invitationUC: invitationUC}
+}
+
+func (h *Handler) Create(c echo.Context) error {
+	var req invitation.CreateInvitationRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+	userID := c.Get("user_id").(int64)
+
+	lang := i18n.Detect(c.Request().Header.Get("Accept-Language"))
+	ctx := i18n.WithLang(c.Request().Context(), lang)
+
+	inv, err := h.invitationUC.Create(ctx, companyID, userID, req)
+	if err != nil {
+		return invitationError(c, err)
+	}
+
+	return response.Created(c, employeeDTO.ToInvitationDTO(inv))
+}
+
+func (h *Handler) List(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	invs, err := h.invitationUC.List(c.Request().Context(), companyID, c.QueryParam("status"))
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, employeeDTO.ToInvitationDTOs(invs))
+}
+
+func (h *Handler) Resend(c echo.Context) error {
+	invitationID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidInvitationID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+	userID := c.Get("user_id").(int64)
+
+	lang := i18n.Detect(c.Request().Header.Get("Accept-Language"))
+	ctx := i18n.WithLang(c.Request().Context(), lang)
+
+	inv, err := h.invitationUC.Resend(ctx, companyID, invitationID, userID)
+	if err != nil {
+		return invitationError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToInvitationDTO(inv))
+}
+
+func (h *Handler) Cancel(c echo.Context) error {
+	invitationID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidInvitationID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	if err := h.invitationUC.Cancel(c.Request().Context(), companyID, invitationID); err != nil {
+		return invitationError(c, err)
+	}
+
+	return response.NoContent(c)
+}
+
+func (h *Handler) Preview(c echo.Context) error {
+	p, err := h.invitationUC.Preview(c.Request().Context(), c.Param("token"))
+	if err != nil {
+		return response.Error(c, http.StatusNotFound, response.ErrInvitationNotFound)
+	}
+
+	return response.Success(c, employeeDTO.InvitationPreviewDTO{
+		Email:       p.Invitation.Email,
+		Name:        p.Invitation.Name,
+		CompanyName: p.CompanyName,
+		Status:      p.Invitation.Status,
+		ExpiresAt:   p.Invitation.ExpiresAt.Unix(),
+		HasAccount:  p.HasAccount,
+	})
+}
+
+func (h *Handler) Accept(c echo.Context) error {
+	var req invitation.AcceptInvitationRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	userID := c.Get("user_id").(int64)
+
+	inv, err := h.invitationUC.Accept(c.Request().Context(), userID, req)
+	if err != nil {
+		return invitationError(c, err)
+	}
+
+	return response.Success(c, employeeDTO.ToInvitationDTO(inv))
+}
+
+func invitationError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "invitation not found":
+		return response.Error(c, http.StatusNotFound, response.ErrInvitationNotFound)
+	case "invitation is not pending":
+		return response.Error(c, http.StatusConflict, response.ErrInvitationNotPending)
+	case "invitation already pending":
+		return response.Error(c, http.StatusConflict, response.ErrInvitationAlreadyPending)
+	case "invitation expired":
+		return response.Error(c, http.StatusGone, response.ErrInvitationExpired)
+	case "invitation email mismatch":
+		return response.Error(c, http.StatusForbidden, response.ErrInvitationEmailMismatch)
+	case "already a member of this company":
+		return response.Error(c, http.StatusConflict, response.ErrAlreadyCompanyMember)
+	case "employee already linked to a user":
+		return response.Error(c, http.StatusConflict, response.ErrEmployeeAlreadyLinked)
+	case "employee not found":
+		return response.Error(c, http.StatusNotFound, response.ErrEmployeeNotFound)
+	case "role not found":
+		return response.Error(c, http.StatusNotFound, response.ErrRoleNotFound)
+	case "role not assignable":
+		return response.Error(c, http.StatusBadRequest, response.ErrRoleNotAssignable)
+	case "user not found":
+		return response.Error(c, http.StatusNotFound, response.ErrUserNotFound)
+	case "user limit reached":
+		return response.Error(c, http.StatusForbidden, response.ErrUserLimitReached)
+	case "employee limit reached":
+		return response.Error(c, http.StatusForbidden, response.ErrEmployeeLimitReached)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
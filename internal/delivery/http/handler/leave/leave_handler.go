@@ -0,0 +1,406 @@
+package leave
+
+import (
+	"net/http"
+	"strconv"
+
+	leaveDTO "github.com/haily-id/engine/internal/domain/dto/leave"
+	"github.com/haily-id/engine/internal/domain/entity/rbac"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/leave"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	leaveUC *leave.UseCase
+}
+
+func NewHandler(leaveUC *leave.UseCase) *Handler {
+	return &Handler{leaveUC: leaveUC}
+}
+
+// ─── Leave Types ────────────────────────────────────────────────
+
+func (h *Handler) ListTypes(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.leaveUC.ListTypes(c.Request().Context(), companyID, !includeInactive(c))
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, leaveDTO.ToTypeDTOs(list))
+}
+
+func (h *Handler) GetType(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidLeaveTypeID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	t, err := h.leaveUC.GetType(c.Request().Context(), companyID, id)
+	if err != nil {
+		return leaveError(c, err)
+	}
+
+	return response.Success(c, leaveDTO.ToTypeDTO(t))
+}
+
+func (h *Handler) CreateType(c echo.Context) error {
+	var req leave.CreateTypeRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	t, err := h.leaveUC.CreateType(c.Request().Context(), companyID, req)
+	if err != nil {
+		return leaveError(c, err)
+	}
+
+	return response.Created(c, leaveDTO.ToTypeDTO(t))
+}
+
+func (h *Handler) UpdateType(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidLeaveTypeID)
+	}
+
+	var req leave.UpdateTypeRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	t, err := h.leaveUC.UpdateType(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return leaveError(c, err)
+	}
+
+	return response.Success(c, leaveDTO.ToTypeDTO(t))
+}
+
+func (h *Handler) DeleteType(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidLeaveTypeID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	if err := h.leaveUC.DeleteType(c.Request().Context(), companyID, id); err != nil {
+		return leaveError(c, err)
+	}
+
+	return response.NoContent(c)
+}
+
+// ─── Holidays ───────────────────────────────────────────────────
+
+func (h *Handler) Holidays(c echo.Context) error {
+	var req leave.HolidayRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.leaveUC.Holidays(c.Request().Context(), companyID, req)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	dtos := make([]leaveDTO.HolidayDTO, 0, len(list))
+	for _, d := range list {
+		dtos = append(dtos, leaveDTO.HolidayDTO{Date: d.Date.Format("2006-01-02"), Name: d.Name})
+	}
+	return response.Success(c, dtos)
+}
+
+// ─── Balances ───────────────────────────────────────────────────
+
+// Balances lists the balances of the employee in ?employee_id=, the
+// member's own without it.
+func (h *Handler) Balances(c echo.Context) error {
+	var req leave.BalanceRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	employeeID := c.QueryParam("employee_id")
+	if employeeID != "" {
+		if _, err := strconv.ParseInt(employeeID, 10, 64); err != nil {
+			return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+		}
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.leaveUC.Balances(c.Request().Context(), companyID, actor(c), &employeeID, req)
+	if err != nil {
+		return leaveError(c, err)
+	}
+
+	dtos := make([]leaveDTO.BalanceDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, toBalanceDTO(&list[i]))
+	}
+	return response.Success(c, dtos)
+}
+
+func (h *Handler) AdjustBalance(c echo.Context) error {
+	employeeID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidEmployeeID)
+	}
+
+	var req leave.AdjustBalanceRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	v, err := h.leaveUC.AdjustBalance(c.Request().Context(), companyID, employeeID, req)
+	if err != nil {
+		return leaveError(c, err)
+	}
+
+	return response.Success(c, toBalanceDTO(v))
+}
+
+// ─── Requests ───────────────────────────────────────────────────
+
+func (h *Handler) Submit(c echo.Context) error {
+	var req leave.SubmitRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	v, err := h.leaveUC.Submit(c.Request().Context(), companyID, actor(c), req)
+	if err != nil {
+		return leaveError(c, err)
+	}
+
+	return response.Created(c, toRequestDTO(v))
+}
+
+func (h *Handler) List(c echo.Context) error {
+	var req leave.ListRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	page, err := h.leaveUC.List(c.Request().Context(), companyID, actor(c), req)
+	if err != nil {
+		return leaveError(c, err)
+	}
+
+	return response.Paginated(c, toRequestDTOs(page.Requests), page.Total, page.Offset, page.Limit)
+}
+
+// Awaiting lists the requests waiting for the member's approval.
+func (h *Handler) Awaiting(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.leaveUC.Awaiting(c.Request().Context(), companyID, actor(c))
+	if err != nil {
+		return leaveError(c, err)
+	}
+
+	return response.Success(c, toRequestDTOs(list))
+}
+
+func (h *Handler) Get(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidLeaveRequestID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	v, err := h.leaveUC.Get(c.Request().Context(), companyID, actor(c), id)
+	if err != nil {
+		return leaveError(c, err)
+	}
+
+	return response.Success(c, toRequestDTO(v))
+}
+
+func (h *Handler) Approve(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidLeaveRequestID)
+	}
+
+	var req leave.ApproveRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	v, err := h.leaveUC.Approve(c.Request().Context(), companyID, actor(c), id, req)
+	if err != nil {
+		return leaveError(c, err)
+	}
+
+	return response.Success(c, toRequestDTO(v))
+}
+
+func (h *Handler) Reject(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidLeaveRequestID)
+	}
+
+	var req leave.RejectRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	v, err := h.leaveUC.Reject(c.Request().Context(), companyID, actor(c), id, req)
+	if err != nil {
+		return leaveError(c, err)
+	}
+
+	return response.Success(c, toRequestDTO(v))
+}
+
+func (h *Handler) Cancel(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidLeaveRequestID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	v, err := h.leaveUC.Cancel(c.Request().Context(), companyID, actor(c), id)
+	if err != nil {
+		return leaveError(c, err)
+	}
+
+	return response.Success(c, toRequestDTO(v))
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+// actor is the member making the request. Owners and admins act as HR.
+func actor(c echo.Context) leave.Actor {
+	role, _ := c.Get("company_role").(string)
+	return leave.Actor{
+		UserID: c.Get("user_id").(int64),
+		Admin:  role == rbac.RoleOwner || role == rbac.RoleAdmin,
+	}
+}
+
+func includeInactive(c echo.Context) bool {
+	v, _ := strconv.ParseBool(c.QueryParam("include_inactive"))
+	return v
+}
+
+func toBalanceDTO(v *leave.BalanceView) leaveDTO.BalanceDTO {
+	dto := leaveDTO.ToBalanceDTO(v.Balance, v.Type)
+	dto.AnnualDays = v.AnnualDays
+	dto.Accrued = v.Accrued
+	dto.Expired = v.Expired
+	dto.Available = v.Available
+	dto.AsOf = v.AsOf.Format("2006-01-02")
+	return dto
+}
+
+func toRequestDTO(v *leave.RequestView) leaveDTO.RequestDTO {
+	return leaveDTO.ToRequestDTO(&v.Request, v.Employee, v.Type)
+}
+
+func toRequestDTOs(list []leave.RequestView) []leaveDTO.RequestDTO {
+	dtos := make([]leaveDTO.RequestDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, toRequestDTO(&list[i]))
+	}
+	return dtos
+}
+
+func leaveError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "leave type not found":
+		return response.Error(c, http.StatusNotFound, response.ErrLeaveTypeNotFound)
+	case "leave type code already exists":
+		return response.Error(c, http.StatusConflict, response.ErrLeaveTypeCodeAlreadyExists)
+	case "leave type inactive":
+		return response.Error(c, http.StatusBadRequest, response.ErrLeaveTypeInactive)
+	case "leave type in use":
+		return response.Error(c, http.StatusConflict, response.ErrLeaveTypeInUse)
+	case "leave type has no balance":
+		return response.Error(c, http.StatusBadRequest, response.ErrLeaveTypeHasNoBalance)
+	case "leave request not found":
+		return response.Error(c, http.StatusNotFound, response.ErrLeaveRequestNotFound)
+	case "leave request not pending":
+		return response.Error(c, http.StatusConflict, response.ErrLeaveRequestNotPending)
+	case "leave request not cancellable":
+		return response.Error(c, http.StatusConflict, response.ErrLeaveRequestNotCancellable)
+	case "leave already started":
+		return response.Error(c, http.StatusConflict, response.ErrLeaveAlreadyStarted)
+	case "leave overlaps":
+		return response.Error(c, http.StatusConflict, response.ErrLeaveOverlaps)
+	case "leave spans years":
+		return response.Error(c, http.StatusBadRequest, response.ErrLeaveSpansYears)
+	case "holiday calendar not available":
+		return response.Error(c, http.StatusBadRequest, response.ErrHolidayCalendarUnavailable)
+	case "end date before start date":
+		return response.Error(c, http.StatusBadRequest, response.ErrEndDateBeforeStartDate)
+	case "no working days in leave":
+		return response.Error(c, http.StatusBadRequest, response.ErrNoWorkingDaysInLeave)
+	case "leave exceeds max days per request":
+		return response.Error(c, http.StatusBadRequest, response.ErrLeaveExceedsMaxDays)
+	case "insufficient leave balance":
+		return response.Error(c, http.StatusBadRequest, response.ErrInsufficientLeaveBalance)
+	case "not an approver":
+		return response.Error(c, http.StatusForbidden, response.ErrNotAnApprover)
+	case "leave access denied":
+		return response.Error(c, http.StatusForbidden, response.ErrLeaveAccessDenied)
+	case "employee not found":
+		return response.Error(c, http.StatusNotFound, response.ErrEmployeeNotFound)
+	case "employee not active":
+		return response.Error(c, http.StatusBadRequest, response.ErrEmployeeNotActive)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
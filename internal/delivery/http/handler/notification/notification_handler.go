@@ -0,0 +1,153 @@
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	notificationDTO "github.com/haily-id/engine/internal/domain/dto/notification"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/notification"
+	"github.com/labstack/echo/v4"
+)
+
+// heartbeatInterval keeps idle streams open through proxies that close
+// silent connections.
+const heartbeatInterval = 25 * time.Second
+
+type Handler struct {
+	notificationUC *notification.UseCase
+}
+
+func NewHandler(notificationUC *notification.UseCase) *Handler {
+	return &Handler{notificationUC: notificationUC}
+}
+
+func (h *Handler) List(c echo.Context) error {
+	var req notification.ListRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	userID := c.Get("user_id").(int64)
+
+	page, err := h.notificationUC.List(c.Request().Context(), userID, req)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Paginated(c, notificationDTO.ToNotificationDTOs(page.Notifications), page.Total, page.Offset, page.Limit)
+}
+
+func (h *Handler) UnreadCount(c echo.Context) error {
+	userID := c.Get("user_id").(int64)
+
+	count, err := h.notificationUC.UnreadCount(c.Request().Context(), userID)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, notificationDTO.UnreadCountDTO{UnreadCount: count})
+}
+
+func (h *Handler) MarkRead(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidNotificationID)
+	}
+
+	userID := c.Get("user_id").(int64)
+
+	if err := h.notificationUC.MarkRead(c.Request().Context(), userID, id); err != nil {
+		if err.Error() == "notification not found" {
+			return response.Error(c, http.StatusNotFound, response.ErrNotificationNotFound)
+		}
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.NoContent(c)
+}
+
+func (h *Handler) MarkAllRead(c echo.Context) error {
+	userID := c.Get("user_id").(int64)
+
+	if err := h.notificationUC.MarkAllRead(c.Request().Context(), userID); err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.NoContent(c)
+}
+
+// Stream sends the user's events as Server-Sent Events: "notification" for
+// each new notification and "unread_count" whenever the count changes,
+// starting with the current count.
+func (h *Handler) Stream(c echo.Context) error {
+	userID := c.Get("user_id").(int64)
+	ctx := c.Request().Context()
+
+	events, cancel := h.notificationUC.Subscribe(userID)
+	defer cancel()
+
+	count, err := h.notificationUC.UnreadCount(ctx, userID)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	if err := writeEvent(res, "unread_count", notificationDTO.UnreadCountDTO{UnreadCount: count}); err != nil {
+		return nil
+	}
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(res, ": ping\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+		case ev, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if ev.Notification != nil {
+				if err := writeEvent(res, "notification", notificationDTO.ToNotificationDTO(ev.Notification)); err != nil {
+					return nil
+				}
+			}
+			if err := writeEvent(res, "unread_count", notificationDTO.UnreadCountDTO{UnreadCount: ev.UnreadCount}); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func writeEvent(res *echo.Response, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(res, "event: %s\ndata: %s\n\n", event, payload); err != nil {
+		return err
+	}
+	res.Flush()
+	return nil
+}
@@ -0,0 +1,398 @@
+package organization
+
+import (
+	"net/http"
+	"strconv"
+
+	orgDTO "github.com/haily-id/engine/internal/domain/dto/organization"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/organization"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	organizationUC *organization.UseCase
+}
+
+func NewHandler(organizationUC *organization.UseCase) *Handler {
+	return &Handler{organizationUC: organizationUC}
+}
+
+// ─── Divisions ──────────────────────────────────────────────────
+
+func (h *Handler) ListDivisions(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.organizationUC.ListDivisions(c.Request().Context(), companyID, !includeInactive(c))
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, orgDTO.ToDivisionDTOs(list))
+}
+
+func (h *Handler) GetDivision(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidDivisionID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	d, err := h.organizationUC.GetDivision(c.Request().Context(), companyID, id)
+	if err != nil {
+		return divisionError(c, err)
+	}
+
+	return response.Success(c, orgDTO.ToDivisionDTO(d))
+}
+
+func (h *Handler) CreateDivision(c echo.Context) error {
+	var req organization.CreateDivisionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	d, err := h.organizationUC.CreateDivision(c.Request().Context(), companyID, req)
+	if err != nil {
+		return divisionError(c, err)
+	}
+
+	return response.Created(c, orgDTO.ToDivisionDTO(d))
+}
+
+func (h *Handler) UpdateDivision(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidDivisionID)
+	}
+
+	var req organization.UpdateDivisionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	d, err := h.organizationUC.UpdateDivision(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return divisionError(c, err)
+	}
+
+	return response.Success(c, orgDTO.ToDivisionDTO(d))
+}
+
+func (h *Handler) DeleteDivision(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidDivisionID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	if err := h.organizationUC.DeleteDivision(c.Request().Context(), companyID, id); err != nil {
+		return divisionError(c, err)
+	}
+
+	return response.NoContent(c)
+}
+
+// ─── Departments ────────────────────────────────────────────────
+
+func (h *Handler) ListDepartments(c echo.Context) error {
+	var divisionID int64
+	if raw := c.QueryParam("division_id"); raw != "" {
+		var err error
+		if divisionID, err = strconv.ParseInt(raw, 10, 64); err != nil {
+			return response.Error(c, http.StatusBadRequest, response.ErrInvalidDivisionID)
+		}
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.organizationUC.ListDepartments(c.Request().Context(), companyID, divisionID, !includeInactive(c))
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, orgDTO.ToDepartmentDTOs(list))
+}
+
+func (h *Handler) GetDepartment(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidDepartmentID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	d, err := h.organizationUC.GetDepartment(c.Request().Context(), companyID, id)
+	if err != nil {
+		return departmentError(c, err)
+	}
+
+	return response.Success(c, orgDTO.ToDepartmentDTO(d))
+}
+
+func (h *Handler) CreateDepartment(c echo.Context) error {
+	var req organization.CreateDepartmentRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	d, err := h.organizationUC.CreateDepartment(c.Request().Context(), companyID, req)
+	if err != nil {
+		return departmentError(c, err)
+	}
+
+	return response.Created(c, orgDTO.ToDepartmentDTO(d))
+}
+
+func (h *Handler) UpdateDepartment(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidDepartmentID)
+	}
+
+	var req organization.UpdateDepartmentRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	d, err := h.organizationUC.UpdateDepartment(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return departmentError(c, err)
+	}
+
+	return response.Success(c, orgDTO.ToDepartmentDTO(d))
+}
+
+func (h *Handler) DeleteDepartment(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidDepartmentID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	if err := h.organizationUC.DeleteDepartment(c.Request().Context(), companyID, id); err != nil {
+		return departmentError(c, err)
+	}
+
+	return response.NoContent(c)
+}
+
+// ─── Positions ──────────────────────────────────────────────────
+
+func (h *Handler) ListPositions(c echo.Context) error {
+	var departmentID int64
+	if raw := c.QueryParam("department_id"); raw != "" {
+		var err error
+		if departmentID, err = strconv.ParseInt(raw, 10, 64); err != nil {
+			return response.Error(c, http.StatusBadRequest, response.ErrInvalidDepartmentID)
+		}
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	list, err := h.organizationUC.ListPositions(c.Request().Context(), companyID, departmentID, !includeInactive(c))
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, orgDTO.ToPositionDTOs(list))
+}
+
+func (h *Handler) GetPosition(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidPositionID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	p, err := h.organizationUC.GetPosition(c.Request().Context(), companyID, id)
+	if err != nil {
+		return positionError(c, err)
+	}
+
+	return response.Success(c, orgDTO.ToPositionDTO(p))
+}
+
+func (h *Handler) CreatePosition(c echo.Context) error {
+	var req organization.CreatePositionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	p, err := h.organizationUC.CreatePosition(c.Request().Context(), companyID, req)
+	if err != nil {
+		return positionError(c, err)
+	}
+
+	return response.Created(c, orgDTO.ToPositionDTO(p))
+}
+
+func (h *Handler) UpdatePosition(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidPositionID)
+	}
+
+	var req organization.UpdatePositionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	p, err := h.organizationUC.UpdatePosition(c.Request().Context(), companyID, id, req)
+	if err != nil {
+		return positionError(c, err)
+	}
+
+	return response.Success(c, orgDTO.ToPositionDTO(p))
+}
+
+func (h *Handler) DeletePosition(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidPositionID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	if err := h.organizationUC.DeletePosition(c.Request().Context(), companyID, id); err != nil {
+		return positionError(c, err)
+	}
+
+	return response.NoContent(c)
+}
+
+// ─── Tree ───────────────────────────────────────────────────────
+
+func (h *Handler) Tree(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	tree, err := h.organizationUC.Tree(c.Request().Context(), companyID, includeInactive(c))
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, toTreeDTO(tree))
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func includeInactive(c echo.Context) bool {
+	v, _ := strconv.ParseBool(c.QueryParam("include_inactive"))
+	return v
+}
+
+func toTreeDTO(t *organization.Tree) orgDTO.TreeDTO {
+	dto := orgDTO.TreeDTO{
+		Divisions:   make([]orgDTO.DivisionNodeDTO, 0, len(t.Divisions)),
+		Departments: toDepartmentNodeDTOs(t.Departments),
+	}
+	for _, n := range t.Divisions {
+		dto.Divisions = append(dto.Divisions, orgDTO.DivisionNodeDTO{
+			DivisionDTO: orgDTO.ToDivisionDTO(n.Division),
+			Departments: toDepartmentNodeDTOs(n.Departments),
+		})
+	}
+	return dto
+}
+
+func toDepartmentNodeDTOs(nodes []organization.DepartmentNode) []orgDTO.DepartmentNodeDTO {
+	dtos := make([]orgDTO.DepartmentNodeDTO, 0, len(nodes))
+	for _, n := range nodes {
+		dtos = append(dtos, orgDTO.DepartmentNodeDTO{
+			DepartmentDTO: orgDTO.ToDepartmentDTO(n.Department),
+			Positions:     orgDTO.ToPositionDTOs(n.Positions),
+		})
+	}
+	return dtos
+}
+
+func divisionError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "employee not found":
+		return response.Error(c, http.StatusBadRequest, response.ErrEmployeeNotFound)
+	case "employee not active":
+		return response.Error(c, http.StatusBadRequest, response.ErrEmployeeNotActive)
+	case "division not found":
+		return response.Error(c, http.StatusNotFound, response.ErrDivisionNotFound)
+	case "division code already exists":
+		return response.Error(c, http.StatusConflict, response.ErrDivisionCodeAlreadyExists)
+	case "division has active employees":
+		return response.Error(c, http.StatusConflict, response.ErrDivisionHasActiveEmployees)
+	case "division in use":
+		return response.Error(c, http.StatusConflict, response.ErrDivisionInUse)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
+
+func departmentError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "employee not found":
+		return response.Error(c, http.StatusBadRequest, response.ErrEmployeeNotFound)
+	case "employee not active":
+		return response.Error(c, http.StatusBadRequest, response.ErrEmployeeNotActive)
+	case "department not found":
+		return response.Error(c, http.StatusNotFound, response.ErrDepartmentNotFound)
+	case "division not found":
+		return response.Error(c, http.StatusBadRequest, response.ErrDivisionNotFound)
+	case "division not available":
+		return response.Error(c, http.StatusBadRequest, response.ErrDivisionNotAvailable)
+	case "department code already exists":
+		return response.Error(c, http.StatusConflict, response.ErrDepartmentCodeAlreadyExists)
+	case "department has active employees":
+		return response.Error(c, http.StatusConflict, response.ErrDepartmentHasActiveEmployees)
+	case "department in use":
+		return response.Error(c, http.StatusConflict, response.ErrDepartmentInUse)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
+
+func positionError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "position not found":
+		return response.Error(c, http.StatusNotFound, response.ErrPositionNotFound)
+	case "department not found":
+		return response.Error(c, http.StatusBadRequest, response.ErrDepartmentNotFound)
+	case "department not available":
+		return response.Error(c, http.StatusBadRequest, response.ErrDepartmentNotAvailable)
+	case "position code already exists":
+		return response.Error(c, http.StatusConflict, response.ErrPositionCodeAlreadyExists)
+	case "position has active employees":
+		return response.Error(c, http.StatusConflict, response.ErrPositionHasActiveEmployees)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
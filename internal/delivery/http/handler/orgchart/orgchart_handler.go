@@ -0,0 +1,98 @@
+package orgchart
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+
+	chartDTO "github.com/haily-id/engine/internal/domain/dto/orgchart"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/orgchart"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	orgChartUC *orgchart.UseCase
+}
+
+func NewHandler(orgChartUC *orgchart.UseCase) *Handler {
+	return &Handler{orgChartUC: orgChartUC}
+}
+
+func (h *Handler) Chart(c echo.Context) error {
+	var req orgchart.ChartRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	chart, err := h.orgChartUC.Build(c.Request().Context(), companyID, req)
+	if err != nil {
+		if err.Error() == "employee not found" {
+			return response.Error(c, http.StatusNotFound, response.ErrEmployeeNotFound)
+		}
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	switch req.Format {
+	case orgchart.FormatDOT:
+		return writeText(c, "text/vnd.graphviz; charset=utf-8", chart, orgchart.WriteDOT)
+	case orgchart.FormatMermaid:
+		return writeText(c, echo.MIMETextPlainCharsetUTF8, chart, orgchart.WriteMermaid)
+	}
+
+	dto := chartDTO.ChartDTO{Roots: toNodeDTOs(chart.Roots)}
+	return response.Success(c, dto)
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func writeText(c echo.Context, contentType string, chart *orgchart.Chart, render func(io.Writer, *orgchart.Chart) error) error {
+	var buf bytes.Buffer
+	if err := render(&buf, chart); err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+	return c.Blob(http.StatusOK, contentType, buf.Bytes())
+}
+
+func toNodeDTOs(nodes []*orgchart.Node) []chartDTO.NodeDTO {
+	dtos := make([]chartDTO.NodeDTO, 0, len(nodes))
+	for _, n := range nodes {
+		dto := chartDTO.NodeDTO{
+			EmployeeID:     strconv.FormatInt(n.Employee.ID, 10),
+			EmployeeNumber: n.Employee.EmployeeNumber,
+			Name:           n.Employee.Name,
+			Email:          n.Employee.Email,
+			Reports:        toNodeDTOs(n.Reports),
+		}
+		if n.Position != nil {
+			dto.PositionID = formatID(n.Position.ID)
+			dto.PositionTitle = &n.Position.Title
+			dto.Level = &n.Position.Level
+		}
+		if n.Department != nil {
+			dto.DepartmentID = formatID(n.Department.ID)
+			dto.DepartmentName = &n.Department.Name
+		}
+		if n.Division != nil {
+			dto.DivisionID = formatID(n.Division.ID)
+			dto.DivisionName = &n.Division.Name
+		}
+		if n.HeadOf != "" {
+			dto.HeadOf = &n.HeadOf
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos
+}
+
+func formatID(id int64) *string {
+	s := strconv.FormatInt(id, 10)
+	return &s
+}
@@ -0,0 +1,119 @@
+package payment
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	billingDTO "github.com/haily-id/engine/internal/domain/dto/billing"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/payment"
+	"github.com/labstack/echo/v4"
+)
+
+// maxWebhookBody caps the size of provider webhook payloads.
+const maxWebhookBody = 1 << 20
+
+type Handler struct {
+	paymentUC *payment.UseCase
+}
+
+func NewHandler(paymentUC *payment.UseCase) *Handler {
+	return &Handler{paymentUC: paymentUC}
+}
+
+func (h *Handler) Pay(c echo.Context) error {
+	invoiceID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidInvoiceID)
+	}
+
+	var req payment.PayRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	r, err := h.paymentUC.Pay(c.Request().Context(), companyID, invoiceID, req)
+	if err != nil {
+		return paymentError(c, err)
+	}
+
+	dto := billingDTO.PaymentDTO{
+		Invoice: billingDTO.ToInvoiceDTO(r.Invoice),
+		Status:  r.ChargeStatus,
+	}
+	if va := r.VirtualAccount; va != nil {
+		dto.VirtualAccount = &billingDTO.VirtualAccountDTO{
+			BankCode:  va.BankCode,
+			Number:    va.Number,
+			Amount:    va.Amount,
+			ExpiresAt: va.ExpiresAt.Unix(),
+		}
+	}
+
+	return response.Success(c, dto)
+}
+
+func (h *Handler) SimulatePayment(c echo.Context) error {
+	invoiceID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidInvoiceID)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	inv, err := h.paymentUC.SimulatePayment(c.Request().Context(), companyID, invoiceID)
+	if err != nil {
+		return paymentError(c, err)
+	}
+
+	return response.Success(c, billingDTO.ToInvoiceDTO(inv))
+}
+
+// Webhook receives provider callbacks. It answers 204 for every event that
+// was verified, including duplicates, so providers stop retrying.
+func (h *Handler) Webhook(c echo.Context) error {
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxWebhookBody))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	err = h.paymentUC.HandleWebhook(c.Request().Context(), c.Param("provider"), body, c.Request().Header)
+	if err != nil {
+		return paymentError(c, err)
+	}
+
+	return response.NoContent(c)
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func paymentError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "invoice not found":
+		return response.Error(c, http.StatusNotFound, response.ErrInvoiceNotFound)
+	case "invoice is not payable":
+		return response.Error(c, http.StatusConflict, response.ErrInvoiceNotPayable)
+	case "payment in progress":
+		return response.Error(c, http.StatusConflict, response.ErrPaymentInProgress)
+	case "payment method not supported":
+		return response.Error(c, http.StatusBadRequest, response.ErrPaymentMethodNotSupported)
+	case "payment failed":
+		return response.Error(c, http.StatusPaymentRequired, response.ErrPaymentFailed)
+	case "payment simulation not supported":
+		return response.Error(c, http.StatusNotFound, response.ErrPaymentSimulationUnavailable)
+	case "unknown payment provider":
+		return response.Error(c, http.StatusNotFound, response.ErrUnknownPaymentProvider)
+	case "invalid signature":
+		return response.Error(c, http.StatusUnauthorized, response.ErrInvalidSignature)
+	case "invalid payment event":
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidPaymentEvent)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
@@ -0,0 +1,45 @@
+package quota
+
+import (
+	"net/http"
+	"strconv"
+
+	subscriptionDTO "github.com/haily-id/engine/internal/domain/dto/subscription"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/usecase/quota"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	quotaUC *quota.UseCase
+}
+
+func NewHandler(quotaUC *quota.UseCase) *Handler {
+	return &Handler{quotaUC: quotaUC}
+}
+
+func (h *Handler) Usage(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	u, err := h.quotaUC.Usage(c.Request().Context(), companyID)
+	if err != nil {
+		switch err.Error() {
+		case "subscription not found":
+			return response.Error(c, http.StatusNotFound, response.ErrSubscriptionNotFound)
+		case "plan not found":
+			return response.Error(c, http.StatusNotFound, response.ErrPlanNotFound)
+		}
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, subscriptionDTO.UsageDTO{
+		PlanID:          strconv.FormatInt(u.Plan.ID, 10),
+		PlanCode:        u.Plan.Code,
+		Users:           u.Users,
+		MaxUsers:        u.MaxUsers,
+		Employees:       u.Employees,
+		MaxEmployees:    u.MaxEmployees,
+		StorageBytes:    u.StorageBytes,
+		MaxStorageBytes: u.MaxStorageBytes,
+	})
+}
@@ -0,0 +1,111 @@
+package region
+
+import (
+	"net/http"
+	"strconv"
+
+	regionDTO "github.com/haily-id/engine/internal/domain/dto/region"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/region"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	regionUC *region.UseCase
+}
+
+func NewHandler(regionUC *region.UseCase) *Handler {
+	return &Handler{regionUC: regionUC}
+}
+
+func (h *Handler) ListProvinces(c echo.Context) error {
+	provinces, err := h.regionUC.ListProvinces(c.Request().Context())
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	return response.Success(c, regionDTO.ToProvinceDTOs(provinces))
+}
+
+func (h *Handler) ListCities(c echo.Context) error {
+	provinceID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidRegionID)
+	}
+
+	cities, err := h.regionUC.ListCities(c.Request().Context(), provinceID)
+	if err != nil {
+		return regionError(c, err)
+	}
+
+	return response.Success(c, regionDTO.ToCityDTOs(cities))
+}
+
+func (h *Handler) ListDistricts(c echo.Context) error {
+	cityID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidRegionID)
+	}
+
+	districts, err := h.regionUC.ListDistricts(c.Request().Context(), cityID)
+	if err != nil {
+		return regionError(c, err)
+	}
+
+	return response.Success(c, regionDTO.ToDistrictDTOs(districts))
+}
+
+func (h *Handler) ListVillages(c echo.Context) error {
+	districtID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidRegionID)
+	}
+
+	villages, err := h.regionUC.ListVillages(c.Request().Context(), districtID)
+	if err != nil {
+		return regionError(c, err)
+	}
+
+	return response.Success(c, regionDTO.ToVillageDTOs(villages))
+}
+
+func (h *Handler) SearchVillages(c echo.Context) error {
+	var req region.SearchVillagesRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	villages, err := h.regionUC.SearchVillages(c.Request().Context(), req)
+	if err != nil {
+		return regionError(c, err)
+	}
+
+	return response.Success(c, regionDTO.ToHierarchyDTOs(villages))
+}
+
+func (h *Handler) GetHierarchy(c echo.Context) error {
+	v, err := h.regionUC.Hierarchy(c.Request().Context(), c.Param("code"))
+	if err != nil {
+		return regionError(c, err)
+	}
+
+	return response.Success(c, regionDTO.ToHierarchyDTO(v))
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func regionError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "province not found", "city not found", "district not found", "village not found":
+		return response.Error(c, http.StatusNotFound, response.ErrRegionNotFound)
+	case "invalid region code":
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidRegionCode)
+	case "search query required":
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
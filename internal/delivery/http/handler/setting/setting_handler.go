@@ -0,0 +1,101 @@
+package setting
+
+import (
+	"net/http"
+	"strings"
+
+	companyDTO "github.com/haily-id/engine/internal/domain/dto/company"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/setting"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	settingUC *setting.UseCase
+}
+
+func NewHandler(settingUC *setting.UseCase) *Handler {
+	return &Handler{settingUC: settingUC}
+}
+
+func (h *Handler) List(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	values, err := h.settingUC.List(c.Request().Context(), companyID, c.QueryParam("module"))
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+
+	dtos := make([]companyDTO.SettingDTO, 0, len(values))
+	for _, v := range values {
+		dtos = append(dtos, toSettingDTO(v))
+	}
+	return response.Success(c, dtos)
+}
+
+func (h *Handler) Get(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	v, err := h.settingUC.Get(c.Request().Context(), companyID, c.Param("key"))
+	if err != nil {
+		return settingError(c, err)
+	}
+
+	return response.Success(c, toSettingDTO(*v))
+}
+
+func (h *Handler) Set(c echo.Context) error {
+	var req setting.SetValueRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	v, err := h.settingUC.Set(c.Request().Context(), companyID, c.Param("key"), req)
+	if err != nil {
+		return settingError(c, err)
+	}
+
+	return response.Success(c, toSettingDTO(*v))
+}
+
+func (h *Handler) Reset(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	v, err := h.settingUC.Reset(c.Request().Context(), companyID, c.Param("key"))
+	if err != nil {
+		return settingError(c, err)
+	}
+
+	return response.Success(c, toSettingDTO(*v))
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func toSettingDTO(v setting.Value) companyDTO.SettingDTO {
+	d := v.Definition
+	return companyDTO.SettingDTO{
+		Key:         d.Key,
+		Module:      d.Module,
+		Type:        d.Type,
+		Value:       companyDTO.SettingJSON(d.Type, v.Value),
+		Default:     companyDTO.SettingJSON(d.Type, d.Default),
+		IsDefault:   v.IsDefault,
+		Description: d.Description,
+	}
+}
+
+func settingError(c echo.Context, err error) error {
+	if strings.HasPrefix(err.Error(), "invalid setting value") {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidSettingValue)
+	}
+	if err.Error() == "setting not found" {
+		return response.Error(c, http.StatusNotFound, response.ErrSettingNotFound)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
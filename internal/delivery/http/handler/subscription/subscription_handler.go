@@ -0,0 +1,224 @@
+package subscription
+
+import (
+	"net/http"
+	"strconv"
+
+	subscriptionDTO "github.com/haily-id/engine/internal/domain/dto/subscription"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/usecase/subscription"
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	subscriptionUC *subscription.UseCase
+}
+
+func NewHandler(subscriptionUC *subscription.UseCase) *Handler {
+	return &Handler{subscriptionUC: subscriptionUC}
+}
+
+// ─── Plans ──────────────────────────────────────────────────────
+
+func (h *Handler) ListPublicPlans(c echo.Context) error {
+	plans, err := h.subscriptionUC.ListPlans(c.Request().Context(), true)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+	return response.Success(c, toPlanDTOs(plans))
+}
+
+func (h *Handler) ListPlans(c echo.Context) error {
+	plans, err := h.subscriptionUC.ListPlans(c.Request().Context(), false)
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+	return response.Success(c, toPlanDTOs(plans))
+}
+
+func (h *Handler) CreatePlan(c echo.Context) error {
+	var req subscription.CreatePlanRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	p, err := h.subscriptionUC.CreatePlan(c.Request().Context(), req)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return response.Created(c, subscriptionDTO.ToPlanDTO(p.Plan, p.Modules))
+}
+
+func (h *Handler) UpdatePlan(c echo.Context) error {
+	planID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidPlanID)
+	}
+
+	var req subscription.UpdatePlanRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	p, err := h.subscriptionUC.UpdatePlan(c.Request().Context(), planID, req)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return response.Success(c, subscriptionDTO.ToPlanDTO(p.Plan, p.Modules))
+}
+
+func (h *Handler) SetPlanModules(c echo.Context) error {
+	planID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrInvalidPlanID)
+	}
+
+	var req subscription.SetPlanModulesRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	p, err := h.subscriptionUC.SetPlanModules(c.Request().Context(), planID, req)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return response.Success(c, subscriptionDTO.ToPlanDTO(p.Plan, p.Modules))
+}
+
+func (h *Handler) ListModules(c echo.Context) error {
+	modules, err := h.subscriptionUC.ListModules(c.Request().Context())
+	if err != nil {
+		return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+	}
+	return response.Success(c, subscriptionDTO.ToModuleDTOs(modules))
+}
+
+// ─── Company Subscription ───────────────────────────────────────
+
+func (h *Handler) GetCurrent(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	d, err := h.subscriptionUC.GetCurrent(c.Request().Context(), companyID)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return response.Success(c, toSubscriptionDTO(d))
+}
+
+func (h *Handler) PreviewChange(c echo.Context) error {
+	req := subscription.ChangePlanRequest{
+		PlanID:       c.QueryParam("plan_id"),
+		BillingCycle: c.QueryParam("billing_cycle"),
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	r, err := h.subscriptionUC.PreviewChange(c.Request().Context(), companyID, req)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return response.Success(c, subscriptionDTO.ChangeResultDTO{
+		Subscription: toSubscriptionDTO(&r.SubscriptionDetail),
+		Change:       subscriptionDTO.ToChangeDTO(r.Change),
+	})
+}
+
+func (h *Handler) ChangePlan(c echo.Context) error {
+	var req subscription.ChangePlanRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+	if err := validator.Validate(req); err != nil {
+		return response.Error(c, http.StatusBadRequest, response.ErrValidation)
+	}
+
+	companyID := c.Get("company_id").(int64)
+
+	r, err := h.subscriptionUC.ChangePlan(c.Request().Context(), companyID, req)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return response.Success(c, subscriptionDTO.ChangeResultDTO{
+		Subscription: toSubscriptionDTO(&r.SubscriptionDetail),
+		Change:       subscriptionDTO.ToChangeDTO(r.Change),
+	})
+}
+
+func (h *Handler) Cancel(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	d, err := h.subscriptionUC.Cancel(c.Request().Context(), companyID)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return response.Success(c, toSubscriptionDTO(d))
+}
+
+func (h *Handler) Resume(c echo.Context) error {
+	companyID := c.Get("company_id").(int64)
+
+	d, err := h.subscriptionUC.Resume(c.Request().Context(), companyID)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return response.Success(c, toSubscriptionDTO(d))
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func toPlanDTOs(plans []subscription.PlanDetail) []subscriptionDTO.PlanDTO {
+	dtos := make([]subscriptionDTO.PlanDTO, 0, len(plans))
+	for _, p := range plans {
+		dtos = append(dtos, subscriptionDTO.ToPlanDTO(p.Plan, p.Modules))
+	}
+	return dtos
+}
+
+func toSubscriptionDTO(d *subscription.SubscriptionDetail) subscriptionDTO.SubscriptionDTO {
+	return subscriptionDTO.ToSubscriptionDTO(d.Subscription, d.Plan, d.PendingPlan)
+}
+
+func subscriptionError(c echo.Context, err error) error {
+	switch err.Error() {
+	case "plan not found":
+		return response.Error(c, http.StatusNotFound, response.ErrPlanNotFound)
+	case "plan code already exists":
+		return response.Error(c, http.StatusConflict, response.ErrPlanCodeAlreadyExists)
+	case "plan not available":
+		return response.Error(c, http.StatusBadRequest, response.ErrPlanNotAvailable)
+	case "plan unchanged":
+		return response.Error(c, http.StatusBadRequest, response.ErrPlanUnchanged)
+	case "module not found":
+		return response.Error(c, http.StatusNotFound, response.ErrModuleNotFound)
+	case "subscription not found":
+		return response.Error(c, http.StatusNotFound, response.ErrSubscriptionNotFound)
+	case "subscription cannot be changed":
+		return response.Error(c, http.StatusConflict, response.ErrSubscriptionNotChangeable)
+	case "subscription cannot be cancelled":
+		return response.Error(c, http.StatusConflict, response.ErrSubscriptionNotCancellable)
+	case "subscription is not cancelled":
+		return response.Error(c, http.StatusConflict, response.ErrSubscriptionNotCancelled)
+	}
+	return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// PlatformAdmin allows the request only for users whose email is listed in
+// emails. It must run after JWTAuth.
+func PlatformAdmin(emails []string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			email, _ := c.Get("email").(string)
+			for _, admin := range emails {
+				if email != "" && strings.EqualFold(email, admin) {
+					return next(c)
+				}
+			}
+			return response.Error(c, http.StatusForbidden, response.ErrForbidden)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/labstack/echo/v4"
+)
+
+// AuditContext puts the request ID, client IP and user agent on the request
+// context for audit entries. It must run after Echo's RequestID middleware;
+// JWTAuth adds the actor.
+func AuditContext() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ctx := audit.WithRequest(req.Context(), audit.Request{
+				ID:        c.Response().Header().Get(echo.HeaderXRequestID),
+				IP:        c.RealIP(),
+				UserAgent: req.UserAgent(),
+			})
+			c.SetRequest(req.WithContext(ctx))
+			return next(c)
+		}
+	}
+}
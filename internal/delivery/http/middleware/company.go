@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// CompanyMember resolves the :company_id path parameter and rejects the
+// request unless the authenticated user is an active member of that company.
+// It must run after JWTAuth. On success "company_id" and "company_role" are
+// set on the context.
+func CompanyMember(members repository.UserCompanyRepository) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			companyID, err := strconv.ParseInt(c.Param("company_id"), 10, 64)
+			if err != nil {
+				return response.Error(c, http.StatusBadRequest, response.ErrInvalidCompanyID)
+			}
+
+			userID, ok := c.Get("user_id").(int64)
+			if !ok {
+				return response.Error(c, http.StatusUnauthorized, response.ErrUnauthorized)
+			}
+
+			m, err := members.FindByUserAndCompany(c.Request().Context(), userID, companyID)
+			if err != nil || !m.IsActive || m.Role == nil {
+				return response.Error(c, http.StatusForbidden, response.ErrNotCompanyMember)
+			}
+
+			c.Set("company_id", companyID)
+			c.Set("company_role", m.Role.Code)
+
+			return next(c)
+		}
+	}
+}
+
+// RequireRole allows the request only when the member's role code set by
+// CompanyMember is one of codes.
+func RequireRole(codes ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			role, _ := c.Get("company_role").(string)
+			for _, code := range codes {
+				if role == code {
+					return next(c)
+				}
+			}
+			return response.Error(c, http.StatusForbidden, response.ErrForbidden)
+		}
+	}
+}
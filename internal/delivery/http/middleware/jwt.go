@@ -2,9 +2,11 @@ package middleware
 
 import (
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/haily-id/engine/internal/pkg/audit"
 	"github.com/haily-id/engine/internal/pkg/response"
 	"github.com/labstack/echo/v4"
 )
@@ -39,6 +41,7 @@ func JWTAuth(secret string) echo.MiddlewareFunc {
 
 			if userID, ok := claims["user_id"].(float64); ok {
 				c.Set("user_id", int64(userID))
+				c.SetRequest(c.Request().WithContext(audit.WithActor(c.Request().Context(), int64(userID))))
 			}
 			if email, ok := claims["email"].(string); ok {
 				c.Set("email", email)
@@ -51,3 +54,52 @@ func JWTAuth(secret string) echo.MiddlewareFunc {
 		}
 	}
 }
+
+// TokenFromQuery lets clients that cannot set headers, such as the browser
+// EventSource, pass the bearer token as a query parameter. It must run
+// before JWTAuth and only on routes that need it.
+func TokenFromQuery(param string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if token := c.QueryParam(param); token != "" && req.Header.Get("Authorization") == "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			return next(c)
+		}
+	}
+}
+
+// RedactQuery masks the values of the given query parameters in the
+// request URI, which the access log records, so tokens accepted by
+// TokenFromQuery stay out of the logs. The parsed URL keeps them. It must
+// be registered before the logger.
+func RedactQuery(params ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			path, query, ok := strings.Cut(req.RequestURI, "?")
+			if !ok {
+				return next(c)
+			}
+			pairs := strings.Split(query, "&")
+			for i, pair := range pairs {
+				key, _, _ := strings.Cut(pair, "=")
+				if k, err := url.QueryUnescape(key); err == nil && contains(params, k) {
+					pairs[i] = key + "=REDACTED"
+				}
+			}
+			req.RequestURI = path + "?" + strings.Join(pairs, "&")
+			return next(c)
+		}
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/haily-id/engine/internal/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// ModuleChecker answers whether a module is enabled for a company.
+type ModuleChecker interface {
+	IsEnabled(ctx context.Context, companyID int64, code string) (bool, error)
+}
+
+// RequireModule allows the request only when module code is enabled for the
+// company resolved by CompanyMember.
+func RequireModule(checker ModuleChecker, code string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			companyID, ok := c.Get("company_id").(int64)
+			if !ok {
+				return response.Error(c, http.StatusForbidden, response.ErrNotCompanyMember)
+			}
+
+			enabled, err := checker.IsEnabled(c.Request().Context(), companyID, code)
+			if err != nil {
+				return response.Error(c, http.StatusInternalServerError, response.ErrInternalServer)
+			}
+			if !enabled {
+				return response.Error(c, http.StatusForbidden, response.ErrModuleNotEnabled)
+			}
+
+			return next(c)
+		}
+	}
+}
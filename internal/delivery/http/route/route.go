@@ -1,28 +1,81 @@
 package route
 
 import (
+	addressHandler "github.com/haily-id/engine/internal/delivery/http/handler/address"
+	attendanceHandler "github.com/haily-id/engine/internal/delivery/http/handler/attendance"
+	auditHandler "github.com/haily-id/engine/internal/delivery/http/handler/audit"
 	authHandler "github.com/haily-id/engine/internal/delivery/http/handler/auth"
+	billingHandler "github.com/haily-id/engine/internal/delivery/http/handler/billing"
+	bulkHandler "github.com/haily-id/engine/internal/delivery/http/handler/bulk"
+	catalogHandler "github.com/haily-id/engine/internal/delivery/http/handler/catalog"
+	companyHandler "github.com/haily-id/engine/internal/delivery/http/handler/company"
+	documentHandler "github.com/haily-id/engine/internal/delivery/http/handler/document"
+	employeeHandler "github.com/haily-id/engine/internal/delivery/http/handler/employee"
+	entitlementHandler "github.com/haily-id/engine/internal/delivery/http/handler/entitlement"
+	invitationHandler "github.com/haily-id/engine/internal/delivery/http/handler/invitation"
+	leaveHandler "github.com/haily-id/engine/internal/delivery/http/handler/leave"
+	notificationHandler "github.com/haily-id/engine/internal/delivery/http/handler/notification"
+	organizationHandler "github.com/haily-id/engine/internal/delivery/http/handler/organization"
+	orgChartHandler "github.com/haily-id/engine/internal/delivery/http/handler/orgchart"
+	paymentHandler "github.com/haily-id/engine/internal/delivery/http/handler/payment"
+	quotaHandler "github.com/haily-id/engine/internal/delivery/http/handler/quota"
+	regionHandler "github.com/haily-id/engine/internal/delivery/http/handler/region"
+	settingHandler "github.com/haily-id/engine/internal/delivery/http/handler/setting"
+	subscriptionHandler "github.com/haily-id/engine/internal/delivery/http/handler/subscription"
 	"github.com/haily-id/engine/internal/delivery/http/middleware"
+	"github.com/haily-id/engine/internal/domain/entity/rbac"
+	subscriptionEntity "github.com/haily-id/engine/internal/domain/entity/subscription"
+	"github.com/haily-id/engine/internal/domain/repository"
 	"github.com/labstack/echo/v4"
 	echoMiddleware "github.com/labstack/echo/v4/middleware"
 )
 
 type RouteConfig struct {
-	AuthHandler *authHandler.Handler
-	JWTSecret   string
+	AuthHandler         *authHandler.Handler
+	CompanyHandler      *companyHandler.Handler
+	InvitationHandler   *invitationHandler.Handler
+	SubscriptionHandler *subscriptionHandler.Handler
+	EntitlementHandler  *entitlementHandler.Handler
+	QuotaHandler        *quotaHandler.Handler
+	BillingHandler      *billingHandler.Handler
+	PaymentHandler      *paymentHandler.Handler
+	RegionHandler       *regionHandler.Handler
+	CatalogHandler      *catalogHandler.Handler
+	AddressHandler      *addressHandler.Handler
+	SettingHandler      *settingHandler.Handler
+	AuditHandler        *auditHandler.Handler
+	NotificationHandler *notificationHandler.Handler
+	OrganizationHandler *organizationHandler.Handler
+	OrgChartHandler     *orgChartHandler.Handler
+	EmployeeHandler     *employeeHandler.Handler
+	BulkHandler         *bulkHandler.Handler
+	DocumentHandler     *documentHandler.Handler
+	LeaveHandler        *leaveHandler.Handler
+	AttendanceHandler   *attendanceHandler.Handler
+	MemberRepo          repository.UserCompanyRepository
+	Entitlements        middleware.ModuleChecker
+	JWTSecret           string
+	AdminEmails         []string
 }
 
 func Setup(e *echo.Echo, cfg RouteConfig) {
+	e.Use(middleware.RedactQuery("access_token"))
 	e.Use(echoMiddleware.Logger())
 	e.Use(echoMiddleware.Recover())
 	e.Use(echoMiddleware.CORS())
 	e.Use(echoMiddleware.RequestID())
+	e.Use(middleware.AuditContext())
 
 	e.GET("/health", func(c echo.Context) error {
 		return c.JSON(200, map[string]string{"status": "healthy"})
 	})
 
 	v1 := e.Group("/api/v1")
+	jwtAuth := middleware.JWTAuth(cfg.JWTSecret)
+	companyAdmin := middleware.RequireRole(rbac.RoleOwner, rbac.RoleAdmin)
+	companyOwner := middleware.RequireRole(rbac.RoleOwner)
+	companyStaff := middleware.RequireRole(rbac.RoleOwner, rbac.RoleAdmin, rbac.RoleManager)
+	hrModule := middleware.RequireModule(cfg.Entitlements, subscriptionEntity.ModuleHR)
 
 	// ── Auth (public) ────────────────────────────────────────────
 	auth := v1.Group("/auth")
@@ -35,6 +88,204 @@ func Setup(e *echo.Echo, cfg RouteConfig) {
 
 	// ── Auth (protected) ─────────────────────────────────────────
 	authProtected := v1.Group("/auth")
-	authProtected.Use(middleware.JWTAuth(cfg.JWTSecret))
+	authProtected.Use(jwtAuth)
 	authProtected.GET("/me", cfg.AuthHandler.GetMe)
+
+	// ── Notifications ────────────────────────────────────────────
+	notifications := v1.Group("/notifications")
+	notifications.GET("/stream", cfg.NotificationHandler.Stream, middleware.TokenFromQuery("access_token"), jwtAuth)
+	notifications.GET("", cfg.NotificationHandler.List, jwtAuth)
+	notifications.GET("/unread-count", cfg.NotificationHandler.UnreadCount, jwtAuth)
+	notifications.POST("/read-all", cfg.NotificationHandler.MarkAllRead, jwtAuth)
+	notifications.POST("/:id/read", cfg.NotificationHandler.MarkRead, jwtAuth)
+
+	// ── Plans (public) ───────────────────────────────────────────
+	v1.GET("/plans", cfg.SubscriptionHandler.ListPublicPlans)
+
+	// ── Industries & company types (public) ─────────────────────
+	v1.GET("/industries", cfg.CatalogHandler.ListIndustries)
+	v1.GET("/company-types", cfg.CatalogHandler.ListCompanyTypes)
+	v1.GET("/banks", cfg.CatalogHandler.ListBanks)
+
+	// ── Regions (public) ─────────────────────────────────────────
+	regions := v1.Group("/regions")
+	regions.GET("/provinces", cfg.RegionHandler.ListProvinces)
+	regions.GET("/provinces/:id/cities", cfg.RegionHandler.ListCities)
+	regions.GET("/cities/:id/districts", cfg.RegionHandler.ListDistricts)
+	regions.GET("/districts/:id/villages", cfg.RegionHandler.ListVillages)
+	regions.GET("/villages", cfg.RegionHandler.SearchVillages)
+	regions.GET("/villages/:code/hierarchy", cfg.RegionHandler.GetHierarchy)
+
+	// ── Platform admin ───────────────────────────────────────────
+	admin := v1.Group("/admin")
+	admin.Use(jwtAuth, middleware.PlatformAdmin(cfg.AdminEmails))
+	admin.GET("/modules", cfg.SubscriptionHandler.ListModules)
+	admin.PUT("/modules/:id", cfg.EntitlementHandler.SetModuleActive)
+	admin.GET("/plans", cfg.SubscriptionHandler.ListPlans)
+	admin.POST("/plans", cfg.SubscriptionHandler.CreatePlan)
+	admin.PUT("/plans/:id", cfg.SubscriptionHandler.UpdatePlan)
+	admin.PUT("/plans/:id/modules", cfg.SubscriptionHandler.SetPlanModules)
+	admin.GET("/industries", cfg.CatalogHandler.ListAllIndustries)
+	admin.POST("/industries", cfg.CatalogHandler.CreateIndustry)
+	admin.PUT("/industries/:id", cfg.CatalogHandler.UpdateIndustry)
+	admin.GET("/company-types", cfg.CatalogHandler.ListAllCompanyTypes)
+	admin.POST("/company-types", cfg.CatalogHandler.CreateCompanyType)
+	admin.PUT("/company-types/:id", cfg.CatalogHandler.UpdateCompanyType)
+	admin.PUT("/companies/:company_id/modules/:module_id", cfg.EntitlementHandler.SetOverride)
+	admin.DELETE("/companies/:company_id/modules/:module_id", cfg.EntitlementHandler.RemoveOverride)
+
+	// ── Payment webhooks (signed by the provider) ───────────────
+	v1.POST("/webhooks/payments/:provider", cfg.PaymentHandler.Webhook)
+
+	// ── Invitations ──────────────────────────────────────────────
+	invitations := v1.Group("/invitations")
+	invitations.GET("/:token", cfg.InvitationHandler.Preview)
+	invitations.POST("/accept", cfg.InvitationHandler.Accept, jwtAuth)
+
+	// ── Companies ────────────────────────────────────────────────
+	companies := v1.Group("/companies")
+	companies.Use(jwtAuth)
+	companies.POST("", cfg.CompanyHandler.Create)
+	companies.GET("", cfg.CompanyHandler.ListMine)
+
+	company := companies.Group("/:company_id")
+	company.Use(middleware.CompanyMember(cfg.MemberRepo))
+	company.GET("", cfg.CompanyHandler.Get)
+	company.GET("/roles", cfg.CompanyHandler.ListRoles)
+
+	company.GET("/addresses", cfg.AddressHandler.List)
+	company.GET("/addresses/:id", cfg.AddressHandler.Get)
+	company.POST("/addresses", cfg.AddressHandler.Create, companyAdmin)
+	company.PUT("/addresses/:id", cfg.AddressHandler.Update, companyAdmin)
+	company.DELETE("/addresses/:id", cfg.AddressHandler.Delete, companyAdmin)
+
+	company.GET("/settings", cfg.SettingHandler.List)
+	company.GET("/settings/:key", cfg.SettingHandler.Get)
+	company.PUT("/settings/:key", cfg.SettingHandler.Set, companyAdmin)
+	company.DELETE("/settings/:key", cfg.SettingHandler.Reset, companyAdmin)
+
+	company.GET("/org-tree", cfg.OrganizationHandler.Tree, hrModule)
+	company.GET("/org-chart", cfg.OrgChartHandler.Chart, hrModule)
+	company.GET("/divisions", cfg.OrganizationHandler.ListDivisions, hrModule)
+	company.GET("/divisions/:id", cfg.OrganizationHandler.GetDivision, hrModule)
+	company.POST("/divisions", cfg.OrganizationHandler.CreateDivision, hrModule, companyAdmin)
+	company.PUT("/divisions/:id", cfg.OrganizationHandler.UpdateDivision, hrModule, companyAdmin)
+	company.DELETE("/divisions/:id", cfg.OrganizationHandler.DeleteDivision, hrModule, companyAdmin)
+	company.GET("/departments", cfg.OrganizationHandler.ListDepartments, hrModule)
+	company.GET("/departments/:id", cfg.OrganizationHandler.GetDepartment, hrModule)
+	company.POST("/departments", cfg.OrganizationHandler.CreateDepartment, hrModule, companyAdmin)
+	company.PUT("/departments/:id", cfg.OrganizationHandler.UpdateDepartment, hrModule, companyAdmin)
+	company.DELETE("/departments/:id", cfg.OrganizationHandler.DeleteDepartment, hrModule, companyAdmin)
+	company.GET("/positions", cfg.OrganizationHandler.ListPositions, hrModule)
+	company.GET("/positions/:id", cfg.OrganizationHandler.GetPosition, hrModule)
+	company.POST("/positions", cfg.OrganizationHandler.CreatePosition, hrModule, companyAdmin)
+	company.PUT("/positions/:id", cfg.OrganizationHandler.UpdatePosition, hrModule, companyAdmin)
+	company.DELETE("/positions/:id", cfg.OrganizationHandler.DeletePosition, hrModule, companyAdmin)
+
+	company.GET("/employees", cfg.EmployeeHandler.List, hrModule, companyAdmin)
+	company.GET("/employees/export", cfg.BulkHandler.Export, hrModule, companyAdmin)
+	company.GET("/employees/imports/template", cfg.BulkHandler.Template, hrModule, companyAdmin)
+	company.POST("/employees/imports", cfg.BulkHandler.Upload, hrModule, companyAdmin)
+	company.GET("/employees/imports/:import_id", cfg.BulkHandler.Get, hrModule, companyAdmin)
+	company.POST("/employees/imports/:import_id/confirm", cfg.BulkHandler.Confirm, hrModule, companyAdmin)
+	company.GET("/employees/:id", cfg.EmployeeHandler.Get, hrModule, companyAdmin)
+	company.POST("/employees", cfg.EmployeeHandler.Create, hrModule, companyAdmin)
+	company.PUT("/employees/:id", cfg.EmployeeHandler.Update, hrModule, companyAdmin)
+	company.DELETE("/employees/:id", cfg.EmployeeHandler.Delete, hrModule, companyAdmin)
+	company.POST("/employees/:id/terminate", cfg.EmployeeHandler.Terminate, hrModule, companyAdmin)
+	company.POST("/employees/:id/suspend", cfg.EmployeeHandler.Suspend, hrModule, companyAdmin)
+	company.POST("/employees/:id/leave", cfg.EmployeeHandler.PutOnLeave, hrModule, companyAdmin)
+	company.POST("/employees/:id/reactivate", cfg.EmployeeHandler.Reactivate, hrModule, companyAdmin)
+	company.GET("/employees/:id/positions", cfg.EmployeeHandler.Positions, hrModule, companyAdmin)
+	company.POST("/employees/:id/positions", cfg.EmployeeHandler.AssignPosition, hrModule, companyAdmin)
+	company.PUT("/employees/:id/positions/:position_id", cfg.EmployeeHandler.EndPosition, hrModule, companyAdmin)
+	company.DELETE("/employees/:id/positions/:position_id", cfg.EmployeeHandler.DeletePosition, hrModule, companyAdmin)
+	company.POST("/employees/:id/promote", cfg.EmployeeHandler.Promote, hrModule, companyAdmin)
+	company.POST("/employees/:id/transfer", cfg.EmployeeHandler.Transfer, hrModule, companyAdmin)
+	company.POST("/employees/:id/demote", cfg.EmployeeHandler.Demote, hrModule, companyAdmin)
+	company.GET("/position-assignments", cfg.EmployeeHandler.Assignments, hrModule, companyAdmin)
+	company.GET("/employees/:id/bank-accounts", cfg.EmployeeHandler.BankAccounts, hrModule, companyStaff)
+	company.POST("/employees/:id/bank-accounts", cfg.EmployeeHandler.AddBankAccount, hrModule, companyAdmin)
+	company.PUT("/employees/:id/bank-accounts/:account_id", cfg.EmployeeHandler.UpdateBankAccount, hrModule, companyAdmin)
+	company.DELETE("/employees/:id/bank-accounts/:account_id", cfg.EmployeeHandler.DeleteBankAccount, hrModule, companyAdmin)
+	company.POST("/employees/:id/bank-accounts/:account_id/primary", cfg.EmployeeHandler.SetPrimaryBankAccount, hrModule, companyAdmin)
+	company.GET("/employees/:id/emergency-contacts", cfg.EmployeeHandler.EmergencyContacts, hrModule, companyStaff)
+	company.POST("/employees/:id/emergency-contacts", cfg.EmployeeHandler.AddEmergencyContact, hrModule, companyAdmin)
+	company.PUT("/employees/:id/emergency-contacts/:contact_id", cfg.EmployeeHandler.UpdateEmergencyContact, hrModule, companyAdmin)
+	company.DELETE("/employees/:id/emergency-contacts/:contact_id", cfg.EmployeeHandler.DeleteEmergencyContact, hrModule, companyAdmin)
+	company.POST("/employees/:id/emergency-contacts/:contact_id/primary", cfg.EmployeeHandler.SetPrimaryEmergencyContact, hrModule, companyAdmin)
+	company.GET("/employees/:id/addresses", cfg.EmployeeHandler.Addresses, hrModule, companyStaff)
+	company.POST("/employees/:id/addresses", cfg.EmployeeHandler.AddAddress, hrModule, companyAdmin)
+	company.PUT("/employees/:id/addresses/:address_id", cfg.EmployeeHandler.UpdateAddress, hrModule, companyAdmin)
+	company.DELETE("/employees/:id/addresses/:address_id", cfg.EmployeeHandler.DeleteAddress, hrModule, companyAdmin)
+	company.POST("/employees/:id/addresses/:address_id/primary", cfg.EmployeeHandler.SetPrimaryAddress, hrModule, companyAdmin)
+	company.GET("/employees/:id/work-locations", cfg.EmployeeHandler.WorkLocations, hrModule, companyStaff)
+	company.POST("/employees/:id/work-locations", cfg.EmployeeHandler.AssignWorkLocation, hrModule, companyAdmin)
+	company.POST("/employees/:id/work-locations/reassign", cfg.EmployeeHandler.ReassignWorkLocation, hrModule, companyAdmin)
+	company.PUT("/employees/:id/work-locations/:location_id", cfg.EmployeeHandler.EndWorkLocation, hrModule, companyAdmin)
+	company.DELETE("/employees/:id/work-locations/:location_id", cfg.EmployeeHandler.DeleteWorkLocation, hrModule, companyAdmin)
+	company.GET("/work-locations", cfg.EmployeeHandler.LocationAssignments, hrModule, companyStaff)
+	company.GET("/work-locations/headcount", cfg.EmployeeHandler.Headcount, hrModule, companyStaff)
+	company.GET("/employees/:id/documents", cfg.DocumentHandler.ListByEmployee, hrModule, companyAdmin)
+	company.POST("/employees/:id/documents", cfg.DocumentHandler.Upload, hrModule, companyAdmin)
+	company.GET("/documents", cfg.DocumentHandler.List, hrModule, companyAdmin)
+	company.GET("/documents/:id", cfg.DocumentHandler.Get, hrModule, companyAdmin)
+	company.GET("/documents/:id/file", cfg.DocumentHandler.Download, hrModule, companyAdmin)
+	company.POST("/documents/:id/verify", cfg.DocumentHandler.Verify, hrModule, companyAdmin)
+	company.POST("/documents/:id/reject", cfg.DocumentHandler.Reject, hrModule, companyAdmin)
+	company.DELETE("/documents/:id", cfg.DocumentHandler.Delete, hrModule, companyAdmin)
+
+	company.GET("/leave-types", cfg.LeaveHandler.ListTypes, hrModule)
+	company.GET("/leave-types/:id", cfg.LeaveHandler.GetType, hrModule)
+	company.POST("/leave-types", cfg.LeaveHandler.CreateType, hrModule, companyAdmin)
+	company.PUT("/leave-types/:id", cfg.LeaveHandler.UpdateType, hrModule, companyAdmin)
+	company.DELETE("/leave-types/:id", cfg.LeaveHandler.DeleteType, hrModule, companyAdmin)
+	company.GET("/holidays", cfg.LeaveHandler.Holidays, hrModule)
+	company.GET("/leave-balances", cfg.LeaveHandler.Balances, hrModule)
+	company.POST("/employees/:id/leave-balances/adjust", cfg.LeaveHandler.AdjustBalance, hrModule, companyAdmin)
+	company.GET("/leave-requests", cfg.LeaveHandler.List, hrModule)
+	company.POST("/leave-requests", cfg.LeaveHandler.Submit, hrModule)
+	company.GET("/leave-requests/approvals", cfg.LeaveHandler.Awaiting, hrModule)
+	company.GET("/leave-requests/:id", cfg.LeaveHandler.Get, hrModule)
+	company.POST("/leave-requests/:id/approve", cfg.LeaveHandler.Approve, hrModule)
+	company.POST("/leave-requests/:id/reject", cfg.LeaveHandler.Reject, hrModule)
+	company.POST("/leave-requests/:id/cancel", cfg.LeaveHandler.Cancel, hrModule)
+
+	company.GET("/shifts", cfg.AttendanceHandler.ListShifts, hrModule)
+	company.GET("/shifts/:id", cfg.AttendanceHandler.GetShift, hrModule)
+	company.POST("/shifts", cfg.AttendanceHandler.CreateShift, hrModule, companyAdmin)
+	company.PUT("/shifts/:id", cfg.AttendanceHandler.UpdateShift, hrModule, companyAdmin)
+	company.DELETE("/shifts/:id", cfg.AttendanceHandler.DeleteShift, hrModule, companyAdmin)
+	company.GET("/employees/:id/shifts", cfg.AttendanceHandler.Assignments, hrModule, companyAdmin)
+	company.POST("/employees/:id/shifts", cfg.AttendanceHandler.AssignShift, hrModule, companyAdmin)
+	company.DELETE("/employees/:id/shifts/:assignment_id", cfg.AttendanceHandler.DeleteAssignment, hrModule, companyAdmin)
+	company.POST("/attendance/clock-in", cfg.AttendanceHandler.ClockIn, hrModule)
+	company.POST("/attendance/clock-out", cfg.AttendanceHandler.ClockOut, hrModule)
+	company.GET("/attendance/records", cfg.AttendanceHandler.List, hrModule)
+	company.GET("/attendance/records/:id", cfg.AttendanceHandler.Get, hrModule)
+	company.GET("/attendance/summary", cfg.AttendanceHandler.Summary, hrModule, companyAdmin)
+	company.POST("/attendance/corrections", cfg.AttendanceHandler.Correct, hrModule, companyAdmin)
+
+	company.GET("/audit-logs", cfg.AuditHandler.List, companyAdmin)
+	company.GET("/audit-logs/export", cfg.AuditHandler.Export, companyAdmin)
+
+	company.GET("/invitations", cfg.InvitationHandler.List, companyAdmin)
+	company.POST("/invitations", cfg.InvitationHandler.Create, companyAdmin)
+	company.POST("/invitations/:id/resend", cfg.InvitationHandler.Resend, companyAdmin)
+	company.DELETE("/invitations/:id", cfg.InvitationHandler.Cancel, companyAdmin)
+
+	company.GET("/subscription", cfg.SubscriptionHandler.GetCurrent)
+	company.GET("/subscription/change-preview", cfg.SubscriptionHandler.PreviewChange, companyOwner)
+	company.POST("/subscription/change", cfg.SubscriptionHandler.ChangePlan, companyOwner)
+	company.POST("/subscription/cancel", cfg.SubscriptionHandler.Cancel, companyOwner)
+	company.POST("/subscription/resume", cfg.SubscriptionHandler.Resume, companyOwner)
+
+	company.GET("/modules", cfg.EntitlementHandler.ListForCompany)
+	company.GET("/usage", cfg.QuotaHandler.Usage)
+
+	company.GET("/invoices", cfg.BillingHandler.ListInvoices, companyAdmin)
+	company.GET("/invoices/:id", cfg.BillingHandler.GetInvoice, companyAdmin)
+	company.GET("/invoices/:id/pdf", cfg.BillingHandler.DownloadInvoicePDF, companyAdmin)
+	company.POST("/invoices/:id/pay", cfg.PaymentHandler.Pay, companyAdmin)
+	company.POST("/invoices/:id/simulate-payment", cfg.PaymentHandler.SimulatePayment, companyAdmin)
 }
@@ -0,0 +1,206 @@
+package attendance
+
+import (
+	"strconv"
+	"time"
+
+	attendanceEntity "github.com/haily-id/engine/internal/domain/entity/attendance"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+type ShiftDTO struct {
+	ID                         string `json:"id"`
+	Code                       string `json:"code"`
+	Name                       string `json:"name"`
+	StartTime                  string `json:"start_time"`
+	EndTime                    string `json:"end_time"`
+	Overnight                  bool   `json:"overnight"`
+	LateToleranceMinutes       int    `json:"late_tolerance_minutes"`
+	EarlyLeaveToleranceMinutes int    `json:"early_leave_tolerance_minutes"`
+	IsDefault                  bool   `json:"is_default"`
+	IsActive                   bool   `json:"is_active"`
+	CreatedAt                  int64  `json:"created_at"`
+	UpdatedAt                  int64  `json:"updated_at"`
+}
+
+type AssignmentDTO struct {
+	ID         string    `json:"id"`
+	EmployeeID string    `json:"employee_id"`
+	StartDate  string    `json:"start_date"`
+	Shift      *ShiftDTO `json:"shift"`
+	AssignedBy string    `json:"assigned_by"`
+	CreatedAt  int64     `json:"created_at"`
+	UpdatedAt  int64     `json:"updated_at"`
+}
+
+// ClockDTO is one clock of a record. The position fields are nil for
+// clocks set by HR.
+type ClockDTO struct {
+	At        int64    `json:"at"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+	AddressID *string  `json:"address_id"`
+	Distance  *int     `json:"distance_meters"`
+}
+
+type CorrectionDTO struct {
+	CorrectedBy string  `json:"corrected_by"`
+	CorrectedAt int64   `json:"corrected_at"`
+	Reason      *string `json:"reason"`
+}
+
+type RecordDTO struct {
+	ID                string         `json:"id"`
+	EmployeeID        string         `json:"employee_id"`
+	EmployeeName      string         `json:"employee_name"`
+	Date              string         `json:"date"`
+	ShiftID           *string        `json:"shift_id"`
+	ShiftCode         *string        `json:"shift_code"`
+	ShiftName         *string        `json:"shift_name"`
+	ScheduledStart    *int64         `json:"scheduled_start"`
+	ScheduledEnd      *int64         `json:"scheduled_end"`
+	ClockIn           *ClockDTO      `json:"clock_in"`
+	ClockOut          *ClockDTO      `json:"clock_out"`
+	IsLate            bool           `json:"is_late"`
+	LateMinutes       int            `json:"late_minutes"`
+	IsEarlyLeave      bool           `json:"is_early_leave"`
+	EarlyLeaveMinutes int            `json:"early_leave_minutes"`
+	WorkedMinutes     int            `json:"worked_minutes"`
+	Correction        *CorrectionDTO `json:"correction"`
+	CreatedAt         int64          `json:"created_at"`
+	UpdatedAt         int64          `json:"updated_at"`
+}
+
+type SummaryCountsDTO struct {
+	Total      int `json:"total"`
+	Present    int `json:"present"`
+	Late       int `json:"late"`
+	EarlyLeave int `json:"early_leave"`
+	OnLeave    int `json:"on_leave"`
+	Off        int `json:"off"`
+	Absent     int `json:"absent"`
+}
+
+type SummaryEntryDTO struct {
+	EmployeeID     string     `json:"employee_id"`
+	EmployeeName   string     `json:"employee_name"`
+	EmployeeNumber *string    `json:"employee_number"`
+	Status         string     `json:"status"`
+	Record         *RecordDTO `json:"record"`
+}
+
+type SummaryDTO struct {
+	Date       string            `json:"date"`
+	WorkingDay bool              `json:"working_day"`
+	Holiday    *string           `json:"holiday"`
+	Counts     SummaryCountsDTO  `json:"counts"`
+	Entries    []SummaryEntryDTO `json:"entries"`
+}
+
+func ToShiftDTO(s *attendanceEntity.Shift) ShiftDTO {
+	return ShiftDTO{
+		ID:                         strconv.FormatInt(s.ID, 10),
+		Code:                       s.Code,
+		Name:                       s.Name,
+		StartTime:                  s.StartTime,
+		EndTime:                    s.EndTime,
+		Overnight:                  s.Overnight(),
+		LateToleranceMinutes:       s.LateToleranceMinutes,
+		EarlyLeaveToleranceMinutes: s.EarlyLeaveToleranceMinutes,
+		IsDefault:                  s.IsDefault,
+		IsActive:                   s.IsActive,
+		CreatedAt:                  s.CreatedAt.Unix(),
+		UpdatedAt:                  s.UpdatedAt.Unix(),
+	}
+}
+
+func ToShiftDTOs(list []attendanceEntity.Shift) []ShiftDTO {
+	dtos := make([]ShiftDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, ToShiftDTO(&list[i]))
+	}
+	return dtos
+}
+
+// ToAssignmentDTO maps an assignment with its shift, which may be nil.
+func ToAssignmentDTO(a *attendanceEntity.ShiftAssignment, s *attendanceEntity.Shift) AssignmentDTO {
+	dto := AssignmentDTO{
+		ID:         strconv.FormatInt(a.ID, 10),
+		EmployeeID: strconv.FormatInt(a.EmployeeID, 10),
+		StartDate:  a.StartDate.Format("2006-01-02"),
+		AssignedBy: strconv.FormatInt(a.AssignedBy, 10),
+		CreatedAt:  a.CreatedAt.Unix(),
+		UpdatedAt:  a.UpdatedAt.Unix(),
+	}
+	if s != nil {
+		shift := ToShiftDTO(s)
+		dto.Shift = &shift
+	}
+	return dto
+}
+
+// ToRecordDTO maps a record with its employee and shift, either of which
+// may be nil.
+func ToRecordDTO(r *attendanceEntity.Record, e *employeeEntity.Employee, s *attendanceEntity.Shift) RecordDTO {
+	dto := RecordDTO{
+		ID:                strconv.FormatInt(r.ID, 10),
+		EmployeeID:        strconv.FormatInt(r.EmployeeID, 10),
+		Date:              r.Date.Format("2006-01-02"),
+		ShiftID:           idPtr(r.ShiftID),
+		ScheduledStart:    unixPtr(r.ScheduledStart),
+		ScheduledEnd:      unixPtr(r.ScheduledEnd),
+		ClockIn:           clock(r.ClockIn, r.ClockInLatitude, r.ClockInLongitude, r.ClockInAddressID, r.ClockInDistance),
+		ClockOut:          clock(r.ClockOut, r.ClockOutLatitude, r.ClockOutLongitude, r.ClockOutAddressID, r.ClockOutDistance),
+		IsLate:            r.IsLate,
+		LateMinutes:       r.LateMinutes,
+		IsEarlyLeave:      r.IsEarlyLeave,
+		EarlyLeaveMinutes: r.EarlyLeaveMinutes,
+		WorkedMinutes:     r.WorkedMinutes,
+		CreatedAt:         r.CreatedAt.Unix(),
+		UpdatedAt:         r.UpdatedAt.Unix(),
+	}
+	if e != nil {
+		dto.EmployeeName = e.Name
+	}
+	if s != nil {
+		dto.ShiftCode = &s.Code
+		dto.ShiftName = &s.Name
+	}
+	if r.Corrected && r.CorrectedBy != nil && r.CorrectedAt != nil {
+		dto.Correction = &CorrectionDTO{
+			CorrectedBy: strconv.FormatInt(*r.CorrectedBy, 10),
+			CorrectedAt: r.CorrectedAt.Unix(),
+			Reason:      r.CorrectionReason,
+		}
+	}
+	return dto
+}
+
+func clock(at *time.Time, lat, lng *float64, addressID *int64, distance *int) *ClockDTO {
+	if at == nil {
+		return nil
+	}
+	return &ClockDTO{
+		At:        at.Unix(),
+		Latitude:  lat,
+		Longitude: lng,
+		AddressID: idPtr(addressID),
+		Distance:  distance,
+	}
+}
+
+func idPtr(id *int64) *string {
+	if id == nil {
+		return nil
+	}
+	s := strconv.FormatInt(*id, 10)
+	return &s
+}
+
+func unixPtr(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	u := t.Unix()
+	return &u
+}
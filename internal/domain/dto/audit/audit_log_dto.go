@@ -0,0 +1,63 @@
+package audit
+
+import (
+	"encoding/json"
+	"strconv"
+
+	auditEntity "github.com/haily-id/engine/internal/domain/entity/audit"
+)
+
+type AuditLogDTO struct {
+	ID        string          `json:"id"`
+	UserID    *string         `json:"user_id"`
+	RequestID *string         `json:"request_id"`
+	Module    string          `json:"module"`
+	Entity    string          `json:"entity"`
+	EntityID  *string         `json:"entity_id"`
+	Action    string          `json:"action"`
+	OldValues json.RawMessage `json:"old_values"`
+	NewValues json.RawMessage `json:"new_values"`
+	IPAddress *string         `json:"ip_address"`
+	UserAgent *string         `json:"user_agent"`
+	CreatedAt int64           `json:"created_at"`
+}
+
+func ToAuditLogDTO(l *auditEntity.AuditLog) AuditLogDTO {
+	return AuditLogDTO{
+		ID:        strconv.FormatInt(l.ID, 10),
+		UserID:    idPtr(l.UserID),
+		RequestID: l.RequestID,
+		Module:    l.Module,
+		Entity:    l.Entity,
+		EntityID:  idPtr(l.EntityID),
+		Action:    l.Action,
+		OldValues: rawJSON(l.OldValues),
+		NewValues: rawJSON(l.NewValues),
+		IPAddress: l.IPAddress,
+		UserAgent: l.UserAgent,
+		CreatedAt: l.CreatedAt.Unix(),
+	}
+}
+
+func ToAuditLogDTOs(logs []auditEntity.AuditLog) []AuditLogDTO {
+	dtos := make([]AuditLogDTO, 0, len(logs))
+	for i := range logs {
+		dtos = append(dtos, ToAuditLogDTO(&logs[i]))
+	}
+	return dtos
+}
+
+func idPtr(id *int64) *string {
+	if id == nil {
+		return nil
+	}
+	s := strconv.FormatInt(*id, 10)
+	return &s
+}
+
+func rawJSON(s *string) json.RawMessage {
+	if s == nil {
+		return json.RawMessage("null")
+	}
+	return json.RawMessage(*s)
+}
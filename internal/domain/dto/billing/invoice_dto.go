@@ -0,0 +1,102 @@
+package billing
+
+import (
+	"strconv"
+	"time"
+
+	billingEntity "github.com/haily-id/engine/internal/domain/entity/billing"
+)
+
+type InvoiceItemDTO struct {
+	Description string `json:"description"`
+	Quantity    int    `json:"quantity"`
+	UnitPrice   int64  `json:"unit_price"`
+	Amount      int64  `json:"amount"`
+}
+
+type InvoiceDTO struct {
+	ID               string           `json:"id"`
+	CompanyID        string           `json:"company_id"`
+	SubscriptionID   string           `json:"subscription_id"`
+	InvoiceNumber    string           `json:"invoice_number"`
+	Status           string           `json:"status"`
+	Currency         string           `json:"currency"`
+	Subtotal         int64            `json:"subtotal"`
+	TaxRate          int              `json:"tax_rate"`
+	TaxAmount        int64            `json:"tax_amount"`
+	TotalAmount      int64            `json:"total_amount"`
+	PaymentMethod    *string          `json:"payment_method"`
+	PaymentReference *string          `json:"payment_reference"`
+	Notes            *string          `json:"notes"`
+	PeriodStart      int64            `json:"period_start"`
+	PeriodEnd        int64            `json:"period_end"`
+	HasPDF           bool             `json:"has_pdf"`
+	IssuedAt         *int64           `json:"issued_at"`
+	DueAt            *int64           `json:"due_at"`
+	PaidAt           *int64           `json:"paid_at"`
+	Items            []InvoiceItemDTO `json:"items,omitempty"`
+	CreatedAt        int64            `json:"created_at"`
+}
+
+func ToInvoiceDTO(inv *billingEntity.Invoice) InvoiceDTO {
+	dto := InvoiceDTO{
+		ID:               strconv.FormatInt(inv.ID, 10),
+		CompanyID:        strconv.FormatInt(inv.CompanyID, 10),
+		SubscriptionID:   strconv.FormatInt(inv.SubscriptionID, 10),
+		InvoiceNumber:    inv.InvoiceNumber,
+		Status:           inv.Status,
+		Currency:         inv.Currency,
+		Subtotal:         inv.Subtotal,
+		TaxRate:          inv.TaxRate,
+		TaxAmount:        inv.TaxAmount,
+		TotalAmount:      inv.TotalAmount,
+		PaymentMethod:    inv.PaymentMethod,
+		PaymentReference: inv.PaymentReference,
+		Notes:            inv.Notes,
+		PeriodStart:      inv.PeriodStart.Unix(),
+		PeriodEnd:        inv.PeriodEnd.Unix(),
+		HasPDF:           inv.PDFKey != nil,
+		IssuedAt:         unixPtr(inv.IssuedAt),
+		DueAt:            unixPtr(inv.DueAt),
+		PaidAt:           unixPtr(inv.PaidAt),
+		CreatedAt:        inv.CreatedAt.Unix(),
+	}
+	for _, item := range inv.Items {
+		dto.Items = append(dto.Items, InvoiceItemDTO{
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			Amount:      item.Amount,
+		})
+	}
+	return dto
+}
+
+func ToInvoiceDTOs(invoices []billingEntity.Invoice) []InvoiceDTO {
+	dtos := make([]InvoiceDTO, 0, len(invoices))
+	for i := range invoices {
+		dtos = append(dtos, ToInvoiceDTO(&invoices[i]))
+	}
+	return dtos
+}
+
+func unixPtr(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	v := t.Unix()
+	return &v
+}
+
+type VirtualAccountDTO struct {
+	BankCode  string `json:"bank_code"`
+	Number    string `json:"number"`
+	Amount    int64  `json:"amount"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+type PaymentDTO struct {
+	Invoice        InvoiceDTO         `json:"invoice"`
+	Status         string             `json:"status"`
+	VirtualAccount *VirtualAccountDTO `json:"virtual_account"`
+}
@@ -0,0 +1,78 @@
+package company
+
+import (
+	"strconv"
+
+	regionDTO "github.com/haily-id/engine/internal/domain/dto/region"
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+)
+
+type AddressDTO struct {
+	ID             string                 `json:"id"`
+	CompanyID      string                 `json:"company_id"`
+	Type           string                 `json:"type"`
+	AddressLine1   string                 `json:"address_line1"`
+	AddressLine2   *string                `json:"address_line2"`
+	Province       *regionDTO.ProvinceDTO `json:"province"`
+	City           *regionDTO.CityDTO     `json:"city"`
+	District       *regionDTO.DistrictDTO `json:"district"`
+	Village        *regionDTO.VillageDTO  `json:"village"`
+	PostalCode     *string                `json:"postal_code"`
+	Phone          *string                `json:"phone"`
+	PICName        *string                `json:"pic_name"`
+	PICPhone       *string                `json:"pic_phone"`
+	Latitude       *float64               `json:"latitude"`
+	Longitude      *float64               `json:"longitude"`
+	GeofenceRadius *int                   `json:"geofence_radius"`
+	IsPrimary      bool                   `json:"is_primary"`
+	IsActive       bool                   `json:"is_active"`
+	CreatedAt      int64                  `json:"created_at"`
+	UpdatedAt      int64                  `json:"updated_at"`
+}
+
+// ToAddressDTO expects the address's regions to be loaded.
+func ToAddressDTO(a *companyEntity.Address) AddressDTO {
+	dto := AddressDTO{
+		ID:             strconv.FormatInt(a.ID, 10),
+		CompanyID:      strconv.FormatInt(a.CompanyID, 10),
+		Type:           a.Type,
+		AddressLine1:   a.AddressLine1,
+		AddressLine2:   a.AddressLine2,
+		PostalCode:     a.PostalCode,
+		Phone:          a.Phone,
+		PICName:        a.PICName,
+		PICPhone:       a.PICPhone,
+		Latitude:       a.Latitude,
+		Longitude:      a.Longitude,
+		GeofenceRadius: a.GeofenceRadius,
+		IsPrimary:      a.IsPrimary,
+		IsActive:       a.IsActive,
+		CreatedAt:      a.CreatedAt.Unix(),
+		UpdatedAt:      a.UpdatedAt.Unix(),
+	}
+	if a.Province != nil {
+		p := regionDTO.ToProvinceDTO(a.Province)
+		dto.Province = &p
+	}
+	if a.City != nil {
+		c := regionDTO.ToCityDTO(a.City)
+		dto.City = &c
+	}
+	if a.District != nil {
+		d := regionDTO.ToDistrictDTO(a.District)
+		dto.District = &d
+	}
+	if a.Village != nil {
+		v := regionDTO.ToVillageDTO(a.Village)
+		dto.Village = &v
+	}
+	return dto
+}
+
+func ToAddressDTOs(addresses []companyEntity.Address) []AddressDTO {
+	dtos := make([]AddressDTO, 0, len(addresses))
+	for i := range addresses {
+		dtos = append(dtos, ToAddressDTO(&addresses[i]))
+	}
+	return dtos
+}
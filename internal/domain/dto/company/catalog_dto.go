@@ -0,0 +1,52 @@
+package company
+
+import (
+	"strconv"
+
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+)
+
+// CatalogEntryDTO is an industry or a company type.
+type CatalogEntryDTO struct {
+	ID          string  `json:"id"`
+	Code        string  `json:"code"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	IsActive    bool    `json:"is_active"`
+}
+
+func ToIndustryDTO(i *companyEntity.Industry) CatalogEntryDTO {
+	return CatalogEntryDTO{
+		ID:          strconv.FormatInt(i.ID, 10),
+		Code:        i.Code,
+		Name:        i.Name,
+		Description: i.Description,
+		IsActive:    i.IsActive,
+	}
+}
+
+func ToIndustryDTOs(industries []companyEntity.Industry) []CatalogEntryDTO {
+	dtos := make([]CatalogEntryDTO, 0, len(industries))
+	for i := range industries {
+		dtos = append(dtos, ToIndustryDTO(&industries[i]))
+	}
+	return dtos
+}
+
+func ToCompanyTypeDTO(t *companyEntity.CompanyType) CatalogEntryDTO {
+	return CatalogEntryDTO{
+		ID:          strconv.FormatInt(t.ID, 10),
+		Code:        t.Code,
+		Name:        t.Name,
+		Description: t.Description,
+		IsActive:    t.IsActive,
+	}
+}
+
+func ToCompanyTypeDTOs(types []companyEntity.CompanyType) []CatalogEntryDTO {
+	dtos := make([]CatalogEntryDTO, 0, len(types))
+	for i := range types {
+		dtos = append(dtos, ToCompanyTypeDTO(&types[i]))
+	}
+	return dtos
+}
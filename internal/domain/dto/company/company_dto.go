@@ -0,0 +1,65 @@
+package company
+
+import (
+	"strconv"
+
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+)
+
+type CompanyDTO struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	LegalName     string  `json:"legal_name"`
+	Code          string  `json:"code"`
+	Email         *string `json:"email"`
+	Phone         *string `json:"phone"`
+	IndustryID    *string `json:"industry_id"`
+	CompanyTypeID *string `json:"company_type_id"`
+	TaxID         *string `json:"tax_id"`
+	LogoKey       *string `json:"logo_key"`
+	OwnerID       string  `json:"owner_id"`
+	Timezone      string  `json:"timezone"`
+	Locale        string  `json:"locale"`
+	Currency      string  `json:"currency"`
+	IsActive      bool    `json:"is_active"`
+	CreatedAt     int64   `json:"created_at"`
+	UpdatedAt     int64   `json:"updated_at"`
+}
+
+func ToDTO(c *companyEntity.Company) CompanyDTO {
+	return CompanyDTO{
+		ID:            strconv.FormatInt(c.ID, 10),
+		Name:          c.Name,
+		LegalName:     c.LegalName,
+		Code:          c.Code,
+		Email:         c.Email,
+		Phone:         c.Phone,
+		IndustryID:    idPtr(c.IndustryID),
+		CompanyTypeID: idPtr(c.CompanyTypeID),
+		TaxID:         c.TaxID,
+		LogoKey:       c.LogoKey,
+		OwnerID:       strconv.FormatInt(c.OwnerID, 10),
+		Timezone:      c.Timezone,
+		Locale:        c.Locale,
+		Currency:      c.Currency,
+		IsActive:      c.IsActive,
+		CreatedAt:     c.CreatedAt.Unix(),
+		UpdatedAt:     c.UpdatedAt.Unix(),
+	}
+}
+
+func ToDTOs(companies []companyEntity.Company) []CompanyDTO {
+	dtos := make([]CompanyDTO, 0, len(companies))
+	for i := range companies {
+		dtos = append(dtos, ToDTO(&companies[i]))
+	}
+	return dtos
+}
+
+func idPtr(id *int64) *string {
+	if id == nil {
+		return nil
+	}
+	s := strconv.FormatInt(*id, 10)
+	return &s
+}
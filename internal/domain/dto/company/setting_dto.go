@@ -0,0 +1,27 @@
+package company
+
+import (
+	"encoding/json"
+
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+)
+
+type SettingDTO struct {
+	Key         string          `json:"key"`
+	Module      string          `json:"module"`
+	Type        string          `json:"type"`
+	Value       json.RawMessage `json:"value"`
+	Default     json.RawMessage `json:"default"`
+	IsDefault   bool            `json:"is_default"`
+	Description string          `json:"description"`
+}
+
+// SettingJSON renders a canonical setting value as JSON of its type: numbers
+// and booleans bare, strings quoted, JSON as is.
+func SettingJSON(valueType, value string) json.RawMessage {
+	if valueType == companyEntity.SettingTypeString {
+		b, _ := json.Marshal(value)
+		return b
+	}
+	return json.RawMessage(value)
+}
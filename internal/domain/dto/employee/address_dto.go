@@ -0,0 +1,64 @@
+package employee
+
+import (
+	"strconv"
+
+	regionDTO "github.com/haily-id/engine/internal/domain/dto/region"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+type AddressDTO struct {
+	ID           string                 `json:"id"`
+	EmployeeID   string                 `json:"employee_id"`
+	Type         string                 `json:"type"`
+	AddressLine1 string                 `json:"address_line1"`
+	AddressLine2 *string                `json:"address_line2"`
+	Province     *regionDTO.ProvinceDTO `json:"province"`
+	City         *regionDTO.CityDTO     `json:"city"`
+	District     *regionDTO.DistrictDTO `json:"district"`
+	Village      *regionDTO.VillageDTO  `json:"village"`
+	PostalCode   *string                `json:"postal_code"`
+	IsPrimary    bool                   `json:"is_primary"`
+	CreatedAt    int64                  `json:"created_at"`
+	UpdatedAt    int64                  `json:"updated_at"`
+}
+
+// ToAddressDTO expects the address's regions to be loaded.
+func ToAddressDTO(a *employeeEntity.Address) AddressDTO {
+	dto := AddressDTO{
+		ID:           strconv.FormatInt(a.ID, 10),
+		EmployeeID:   strconv.FormatInt(a.EmployeeID, 10),
+		Type:         a.Type,
+		AddressLine1: a.AddressLine1,
+		AddressLine2: a.AddressLine2,
+		PostalCode:   a.PostalCode,
+		IsPrimary:    a.IsPrimary,
+		CreatedAt:    a.CreatedAt.Unix(),
+		UpdatedAt:    a.UpdatedAt.Unix(),
+	}
+	if a.Province != nil {
+		p := regionDTO.ToProvinceDTO(a.Province)
+		dto.Province = &p
+	}
+	if a.City != nil {
+		c := regionDTO.ToCityDTO(a.City)
+		dto.City = &c
+	}
+	if a.District != nil {
+		d := regionDTO.ToDistrictDTO(a.District)
+		dto.District = &d
+	}
+	if a.Village != nil {
+		v := regionDTO.ToVillageDTO(a.Village)
+		dto.Village = &v
+	}
+	return dto
+}
+
+func ToAddressDTOs(list []employeeEntity.Address) []AddressDTO {
+	dtos := make([]AddressDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, ToAddressDTO(&list[i]))
+	}
+	return dtos
+}
@@ -0,0 +1,71 @@
+package employee
+
+import (
+	"strconv"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+type BankDTO struct {
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	ShortName string `json:"short_name"`
+	MinLength int    `json:"min_length"`
+	MaxLength int    `json:"max_length"`
+}
+
+func ToBankDTOs(list []employeeEntity.Bank) []BankDTO {
+	dtos := make([]BankDTO, 0, len(list))
+	for _, b := range list {
+		dtos = append(dtos, BankDTO{
+			Code:      b.Code,
+			Name:      b.Name,
+			ShortName: b.ShortName,
+			MinLength: b.MinLength,
+			MaxLength: b.MaxLength,
+		})
+	}
+	return dtos
+}
+
+// BankAccountDTO carries the account number masked unless the caller may
+// see it in full.
+type BankAccountDTO struct {
+	ID            string `json:"id"`
+	EmployeeID    string `json:"employee_id"`
+	BankCode      string `json:"bank_code"`
+	BankName      string `json:"bank_name"`
+	AccountNumber string `json:"account_number"`
+	AccountName   string `json:"account_name"`
+	IsPrimary     bool   `json:"is_primary"`
+	IsMasked      bool   `json:"is_masked"`
+	CreatedAt     int64  `json:"created_at"`
+	UpdatedAt     int64  `json:"updated_at"`
+}
+
+func ToBankAccountDTO(a *employeeEntity.BankAccount, unmasked bool) BankAccountDTO {
+	number := a.AccountNumber
+	if !unmasked {
+		number = employeeEntity.MaskAccountNumber(number)
+	}
+	return BankAccountDTO{
+		ID:            strconv.FormatInt(a.ID, 10),
+		EmployeeID:    strconv.FormatInt(a.EmployeeID, 10),
+		BankCode:      a.BankCode,
+		BankName:      a.BankName,
+		AccountNumber: number,
+		AccountName:   a.AccountName,
+		IsPrimary:     a.IsPrimary,
+		IsMasked:      !unmasked,
+		CreatedAt:     a.CreatedAt.Unix(),
+		UpdatedAt:     a.UpdatedAt.Unix(),
+	}
+}
+
+func ToBankAccountDTOs(list []employeeEntity.BankAccount, unmasked bool) []BankAccountDTO {
+	dtos := make([]BankAccountDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, ToBankAccountDTO(&list[i], unmasked))
+	}
+	return dtos
+}
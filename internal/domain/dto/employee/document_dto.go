@@ -0,0 +1,59 @@
+package employee
+
+import (
+	"strconv"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+type DocumentDTO struct {
+	ID              string  `json:"id"`
+	EmployeeID      string  `json:"employee_id"`
+	Type            string  `json:"type"`
+	Name            string  `json:"name"`
+	FileName        string  `json:"file_name"`
+	ContentType     string  `json:"content_type"`
+	Size            int64   `json:"size"`
+	ValidFrom       *string `json:"valid_from"`
+	ValidUntil      *string `json:"valid_until"`
+	Status          string  `json:"status"`
+	IsVerified      bool    `json:"is_verified"`
+	VerifiedAt      *int64  `json:"verified_at"`
+	VerifiedBy      *string `json:"verified_by"`
+	RejectionReason *string `json:"rejection_reason"`
+	CreatedAt       int64   `json:"created_at"`
+	UpdatedAt       int64   `json:"updated_at"`
+}
+
+func ToDocumentDTO(d *employeeEntity.Document) DocumentDTO {
+	dto := DocumentDTO{
+		ID:              strconv.FormatInt(d.ID, 10),
+		EmployeeID:      strconv.FormatInt(d.EmployeeID, 10),
+		Type:            d.Type,
+		Name:            d.Name,
+		FileName:        d.FileName,
+		ContentType:     d.ContentType,
+		Size:            d.Size,
+		ValidFrom:       datePtr(d.ValidFrom),
+		ValidUntil:      datePtr(d.ValidUntil),
+		Status:          d.Status,
+		IsVerified:      d.IsVerified,
+		VerifiedBy:      idPtr(d.VerifiedBy),
+		RejectionReason: d.RejectionReason,
+		CreatedAt:       d.CreatedAt.Unix(),
+		UpdatedAt:       d.UpdatedAt.Unix(),
+	}
+	if d.VerifiedAt != nil {
+		at := d.VerifiedAt.Unix()
+		dto.VerifiedAt = &at
+	}
+	return dto
+}
+
+func ToDocumentDTOs(list []employeeEntity.Document) []DocumentDTO {
+	dtos := make([]DocumentDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, ToDocumentDTO(&list[i]))
+	}
+	return dtos
+}
@@ -0,0 +1,41 @@
+package employee
+
+import (
+	"strconv"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+type EmergencyContactDTO struct {
+	ID               string  `json:"id"`
+	EmployeeID       string  `json:"employee_id"`
+	Name             string  `json:"name"`
+	Relationship     string  `json:"relationship"`
+	Phone            string  `json:"phone"`
+	AlternativePhone *string `json:"alternative_phone"`
+	IsPrimary        bool    `json:"is_primary"`
+	CreatedAt        int64   `json:"created_at"`
+	UpdatedAt        int64   `json:"updated_at"`
+}
+
+func ToEmergencyContactDTO(c *employeeEntity.EmergencyContact) EmergencyContactDTO {
+	return EmergencyContactDTO{
+		ID:               strconv.FormatInt(c.ID, 10),
+		EmployeeID:       strconv.FormatInt(c.EmployeeID, 10),
+		Name:             c.Name,
+		Relationship:     c.Relationship,
+		Phone:            c.Phone,
+		AlternativePhone: c.AlternativePhone,
+		IsPrimary:        c.IsPrimary,
+		CreatedAt:        c.CreatedAt.Unix(),
+		UpdatedAt:        c.UpdatedAt.Unix(),
+	}
+}
+
+func ToEmergencyContactDTOs(list []employeeEntity.EmergencyContact) []EmergencyContactDTO {
+	dtos := make([]EmergencyContactDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, ToEmergencyContactDTO(&list[i]))
+	}
+	return dtos
+}
@@ -0,0 +1,90 @@
+package employee
+
+import (
+	"strconv"
+	"time"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+// EmployeeDTO renders calendar dates as YYYY-MM-DD, the format they are
+// sent in.
+type EmployeeDTO struct {
+	ID               string  `json:"id"`
+	CompanyID        string  `json:"company_id"`
+	UserID           *string `json:"user_id"`
+	DivisionID       *string `json:"division_id"`
+	DepartmentID     *string `json:"department_id"`
+	EmployeeNumber   *string `json:"employee_number"`
+	Email            string  `json:"email"`
+	Name             string  `json:"name"`
+	Phone            *string `json:"phone"`
+	Gender           *string `json:"gender"`
+	DateOfBirth      *string `json:"date_of_birth"`
+	PlaceOfBirth     *string `json:"place_of_birth"`
+	NationalID       *string `json:"national_id"`
+	TaxID            *string `json:"tax_id"`
+	MaritalStatus    *string `json:"marital_status"`
+	HireDate         *string `json:"hire_date"`
+	TerminationDate  *string `json:"termination_date"`
+	EmploymentStatus string  `json:"employment_status"`
+	StatusChangedOn  *string `json:"status_changed_on"`
+	EmploymentType   string  `json:"employment_type"`
+	DependentsCount  int     `json:"dependents_count"`
+	IsActive         bool    `json:"is_active"`
+	CreatedAt        int64   `json:"created_at"`
+	UpdatedAt        int64   `json:"updated_at"`
+}
+
+func ToEmployeeDTO(e *employeeEntity.Employee) EmployeeDTO {
+	return EmployeeDTO{
+		ID:               strconv.FormatInt(e.ID, 10),
+		CompanyID:        strconv.FormatInt(e.CompanyID, 10),
+		UserID:           idPtr(e.UserID),
+		DivisionID:       idPtr(e.DivisionID),
+		DepartmentID:     idPtr(e.DepartmentID),
+		EmployeeNumber:   e.EmployeeNumber,
+		Email:            e.Email,
+		Name:             e.Name,
+		Phone:            e.Phone,
+		Gender:           e.Gender,
+		DateOfBirth:      datePtr(e.DateOfBirth),
+		PlaceOfBirth:     e.PlaceOfBirth,
+		NationalID:       e.NationalID,
+		TaxID:            e.TaxID,
+		MaritalStatus:    e.MaritalStatus,
+		HireDate:         datePtr(e.HireDate),
+		TerminationDate:  datePtr(e.TerminationDate),
+		EmploymentStatus: e.EmploymentStatus,
+		StatusChangedOn:  datePtr(e.StatusChangedOn),
+		EmploymentType:   e.EmploymentType,
+		DependentsCount:  e.DependentsCount,
+		IsActive:         e.IsActive,
+		CreatedAt:        e.CreatedAt.Unix(),
+		UpdatedAt:        e.UpdatedAt.Unix(),
+	}
+}
+
+func ToEmployeeDTOs(list []employeeEntity.Employee) []EmployeeDTO {
+	dtos := make([]EmployeeDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, ToEmployeeDTO(&list[i]))
+	}
+	return dtos
+}
+
+func idPtr(id *int64) *string {
+	if id == nil {
+		return nil
+	}
+	s := strconv.FormatInt(*id, 10)
+	return &s
+}
+
+func datePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format("2006-01-02")
+	return &s
+}
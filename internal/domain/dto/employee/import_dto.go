@@ -0,0 +1,57 @@
+package employee
+
+import (
+	"strconv"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+type ImportDTO struct {
+	ID              string                          `json:"id"`
+	FileName        string                          `json:"file_name"`
+	Status          string                          `json:"status"`
+	TotalRows       int                             `json:"total_rows"`
+	ProcessedRows   int                             `json:"processed_rows"`
+	CreatedCount    int                             `json:"created_count"`
+	InvitedCount    int                             `json:"invited_count"`
+	FailedCount     int                             `json:"failed_count"`
+	SendInvitations bool                            `json:"send_invitations"`
+	RoleID          *string                         `json:"role_id"`
+	Errors          []employeeEntity.ImportRowError `json:"errors"`
+	CreatedBy       string                          `json:"created_by"`
+	StartedAt       *int64                          `json:"started_at"`
+	FinishedAt      *int64                          `json:"finished_at"`
+	CreatedAt       int64                           `json:"created_at"`
+	UpdatedAt       int64                           `json:"updated_at"`
+}
+
+func ToImportDTO(i *employeeEntity.Import) ImportDTO {
+	dto := ImportDTO{
+		ID:              strconv.FormatInt(i.ID, 10),
+		FileName:        i.FileName,
+		Status:          i.Status,
+		TotalRows:       i.TotalRows,
+		ProcessedRows:   i.ProcessedRows,
+		CreatedCount:    i.CreatedCount,
+		InvitedCount:    i.InvitedCount,
+		FailedCount:     i.FailedCount,
+		SendInvitations: i.SendInvitations,
+		RoleID:          idPtr(i.RoleID),
+		Errors:          i.Errors,
+		CreatedBy:       strconv.FormatInt(i.CreatedBy, 10),
+		CreatedAt:       i.CreatedAt.Unix(),
+		UpdatedAt:       i.UpdatedAt.Unix(),
+	}
+	if dto.Errors == nil {
+		dto.Errors = []employeeEntity.ImportRowError{}
+	}
+	if i.StartedAt != nil {
+		at := i.StartedAt.Unix()
+		dto.StartedAt = &at
+	}
+	if i.FinishedAt != nil {
+		at := i.FinishedAt.Unix()
+		dto.FinishedAt = &at
+	}
+	return dto
+}
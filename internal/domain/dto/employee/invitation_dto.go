@@ -0,0 +1,52 @@
+package employee
+
+import (
+	"strconv"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+type InvitationDTO struct {
+	ID         string `json:"id"`
+	CompanyID  string `json:"company_id"`
+	EmployeeID string `json:"employee_id"`
+	RoleID     string `json:"role_id"`
+	Email      string `json:"email"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	ExpiresAt  int64  `json:"expires_at"`
+	CreatedAt  int64  `json:"created_at"`
+	UpdatedAt  int64  `json:"updated_at"`
+}
+
+type InvitationPreviewDTO struct {
+	Email       string `json:"email"`
+	Name        string `json:"name"`
+	CompanyName string `json:"company_name"`
+	Status      string `json:"status"`
+	ExpiresAt   int64  `json:"expires_at"`
+	HasAccount  bool   `json:"has_account"`
+}
+
+func ToInvitationDTO(inv *employeeEntity.Invitation) InvitationDTO {
+	return InvitationDTO{
+		ID:         strconv.FormatInt(inv.ID, 10),
+		CompanyID:  strconv.FormatInt(inv.CompanyID, 10),
+		EmployeeID: strconv.FormatInt(inv.EmployeeID, 10),
+		RoleID:     strconv.FormatInt(inv.RoleID, 10),
+		Email:      inv.Email,
+		Name:       inv.Name,
+		Status:     inv.Status,
+		ExpiresAt:  inv.ExpiresAt.Unix(),
+		CreatedAt:  inv.CreatedAt.Unix(),
+		UpdatedAt:  inv.UpdatedAt.Unix(),
+	}
+}
+
+func ToInvitationDTOs(invs []employeeEntity.Invitation) []InvitationDTO {
+	dtos := make([]InvitationDTO, 0, len(invs))
+	for i := range invs {
+		dtos = append(dtos, ToInvitationDTO(&invs[i]))
+	}
+	return dtos
+}
@@ -0,0 +1,27 @@
+package employee
+
+// AssignmentDTO is one employee position assignment. The position fields
+// are nil once the position is deleted; Employee is set on company-wide
+// listings only.
+type AssignmentDTO struct {
+	ID            string                 `json:"id"`
+	EmployeeID    string                 `json:"employee_id"`
+	PositionID    string                 `json:"position_id"`
+	PositionTitle *string                `json:"position_title"`
+	PositionCode  *string                `json:"position_code"`
+	Level         *string                `json:"level"`
+	DepartmentID  *string                `json:"department_id"`
+	StartDate     string                 `json:"start_date"`
+	EndDate       *string                `json:"end_date"`
+	IsPrimary     bool                   `json:"is_primary"`
+	ChangeType    string                 `json:"change_type"`
+	Reason        *string                `json:"reason"`
+	Employee      *AssignmentEmployeeDTO `json:"employee,omitempty"`
+	CreatedAt     int64                  `json:"created_at"`
+}
+
+type AssignmentEmployeeDTO struct {
+	Name             string  `json:"name"`
+	EmployeeNumber   *string `json:"employee_number"`
+	EmploymentStatus string  `json:"employment_status"`
+}
@@ -0,0 +1,26 @@
+package employee
+
+// WorkLocationDTO is one employee work location assignment. Employee is
+// set on company-wide listings only.
+type WorkLocationDTO struct {
+	ID           string                 `json:"id"`
+	EmployeeID   string                 `json:"employee_id"`
+	AddressID    string                 `json:"address_id"`
+	AddressType  *string                `json:"address_type"`
+	AddressLine1 *string                `json:"address_line1"`
+	StartDate    string                 `json:"start_date"`
+	EndDate      *string                `json:"end_date"`
+	IsPrimary    bool                   `json:"is_primary"`
+	Reason       *string                `json:"reason"`
+	Employee     *AssignmentEmployeeDTO `json:"employee,omitempty"`
+	CreatedAt    int64                  `json:"created_at"`
+}
+
+type LocationHeadcountDTO struct {
+	AddressID        string `json:"address_id"`
+	AddressType      string `json:"address_type"`
+	AddressLine1     string `json:"address_line1"`
+	IsActive         bool   `json:"is_active"`
+	Headcount        int    `json:"headcount"`
+	PrimaryHeadcount int    `json:"primary_headcount"`
+}
@@ -0,0 +1,201 @@
+package leave
+
+import (
+	"strconv"
+	"time"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	leaveEntity "github.com/haily-id/engine/internal/domain/entity/leave"
+)
+
+type TypeDTO struct {
+	ID                string  `json:"id"`
+	Code              string  `json:"code"`
+	Name              string  `json:"name"`
+	Description       *string `json:"description"`
+	IsPaid            bool    `json:"is_paid"`
+	Accrual           string  `json:"accrual"`
+	AnnualDays        *int    `json:"annual_days"`
+	MaxCarryOverDays  int     `json:"max_carry_over_days"`
+	CarryOverMonths   int     `json:"carry_over_months"`
+	MaxDaysPerRequest *int    `json:"max_days_per_request"`
+	RequiresApproval  bool    `json:"requires_approval"`
+	IsActive          bool    `json:"is_active"`
+	CreatedAt         int64   `json:"created_at"`
+	UpdatedAt         int64   `json:"updated_at"`
+}
+
+// BalanceDTO shows a balance as of as_of. available already takes pending
+// requests and expired carry-over into account.
+type BalanceDTO struct {
+	ID                 string  `json:"id"`
+	EmployeeID         string  `json:"employee_id"`
+	LeaveTypeID        string  `json:"leave_type_id"`
+	LeaveTypeCode      string  `json:"leave_type_code"`
+	LeaveTypeName      string  `json:"leave_type_name"`
+	Year               int     `json:"year"`
+	Accrual            string  `json:"accrual"`
+	AnnualDays         int     `json:"annual_days"`
+	Entitled           int     `json:"entitled"`
+	Accrued            int     `json:"accrued"`
+	CarriedOver        int     `json:"carried_over"`
+	CarryOverUsed      int     `json:"carry_over_used"`
+	CarryOverExpiresOn *string `json:"carry_over_expires_on"`
+	Expired            int     `json:"expired"`
+	Adjustment         int     `json:"adjustment"`
+	Used               int     `json:"used"`
+	Pending            int     `json:"pending"`
+	Available          int     `json:"available"`
+	AsOf               string  `json:"as_of"`
+}
+
+type ApprovalDTO struct {
+	ID         string  `json:"id"`
+	Level      int     `json:"level"`
+	ApproverID string  `json:"approver_id"`
+	Status     string  `json:"status"`
+	DecidedBy  *string `json:"decided_by"`
+	DecidedAt  *int64  `json:"decided_at"`
+	Note       *string `json:"note"`
+}
+
+type RequestDTO struct {
+	ID            string        `json:"id"`
+	EmployeeID    string        `json:"employee_id"`
+	EmployeeName  string        `json:"employee_name"`
+	LeaveTypeID   string        `json:"leave_type_id"`
+	LeaveTypeCode string        `json:"leave_type_code"`
+	LeaveTypeName string        `json:"leave_type_name"`
+	StartDate     string        `json:"start_date"`
+	EndDate       string        `json:"end_date"`
+	Days          int           `json:"days"`
+	CarryOverDays int           `json:"carry_over_days"`
+	Reason        *string       `json:"reason"`
+	Status        string        `json:"status"`
+	RequestedBy   string        `json:"requested_by"`
+	DecidedAt     *int64        `json:"decided_at"`
+	DecisionNote  *string       `json:"decision_note"`
+	OnLeave       bool          `json:"on_leave"`
+	Approvals     []ApprovalDTO `json:"approvals"`
+	CreatedAt     int64         `json:"created_at"`
+	UpdatedAt     int64         `json:"updated_at"`
+}
+
+type HolidayDTO struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+func ToTypeDTO(t *leaveEntity.Type) TypeDTO {
+	return TypeDTO{
+		ID:                strconv.FormatInt(t.ID, 10),
+		Code:              t.Code,
+		Name:              t.Name,
+		Description:       t.Description,
+		IsPaid:            t.IsPaid,
+		Accrual:           t.Accrual,
+		AnnualDays:        t.AnnualDays,
+		MaxCarryOverDays:  t.MaxCarryOverDays,
+		CarryOverMonths:   t.CarryOverMonths,
+		MaxDaysPerRequest: t.MaxDaysPerRequest,
+		RequiresApproval:  t.RequiresApproval,
+		IsActive:          t.IsActive,
+		CreatedAt:         t.CreatedAt.Unix(),
+		UpdatedAt:         t.UpdatedAt.Unix(),
+	}
+}
+
+func ToTypeDTOs(list []leaveEntity.Type) []TypeDTO {
+	dtos := make([]TypeDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, ToTypeDTO(&list[i]))
+	}
+	return dtos
+}
+
+// ToBalanceDTO maps the stored figures of a balance; the ones worked out
+// as of a date are filled in by the caller.
+func ToBalanceDTO(b *leaveEntity.Balance, t *leaveEntity.Type) BalanceDTO {
+	return BalanceDTO{
+		ID:                 strconv.FormatInt(b.ID, 10),
+		EmployeeID:         strconv.FormatInt(b.EmployeeID, 10),
+		LeaveTypeID:        strconv.FormatInt(b.LeaveTypeID, 10),
+		LeaveTypeCode:      t.Code,
+		LeaveTypeName:      t.Name,
+		Year:               b.Year,
+		Accrual:            t.Accrual,
+		Entitled:           b.Entitled,
+		CarriedOver:        b.CarriedOver,
+		CarryOverUsed:      b.CarryOverUsed,
+		CarryOverExpiresOn: datePtr(b.CarryOverExpiresOn),
+		Adjustment:         b.Adjustment,
+		Used:               b.Used,
+		Pending:            b.Pending,
+	}
+}
+
+// ToRequestDTO maps a request with its employee and leave type, either of
+// which may be nil.
+func ToRequestDTO(r *leaveEntity.Request, e *employeeEntity.Employee, t *leaveEntity.Type) RequestDTO {
+	dto := RequestDTO{
+		ID:            strconv.FormatInt(r.ID, 10),
+		EmployeeID:    strconv.FormatInt(r.EmployeeID, 10),
+		LeaveTypeID:   strconv.FormatInt(r.LeaveTypeID, 10),
+		StartDate:     r.StartDate.Format("2006-01-02"),
+		EndDate:       r.EndDate.Format("2006-01-02"),
+		Days:          r.Days,
+		CarryOverDays: r.CarryOverDays,
+		Reason:        r.Reason,
+		Status:        r.Status,
+		RequestedBy:   strconv.FormatInt(r.RequestedBy, 10),
+		DecidedAt:     unixPtr(r.DecidedAt),
+		DecisionNote:  r.DecisionNote,
+		OnLeave:       r.StatusApplied,
+		Approvals:     make([]ApprovalDTO, 0, len(r.Approvals)),
+		CreatedAt:     r.CreatedAt.Unix(),
+		UpdatedAt:     r.UpdatedAt.Unix(),
+	}
+	if e != nil {
+		dto.EmployeeName = e.Name
+	}
+	if t != nil {
+		dto.LeaveTypeCode = t.Code
+		dto.LeaveTypeName = t.Name
+	}
+	for _, a := range r.Approvals {
+		dto.Approvals = append(dto.Approvals, ApprovalDTO{
+			ID:         strconv.FormatInt(a.ID, 10),
+			Level:      a.Level,
+			ApproverID: strconv.FormatInt(a.ApproverID, 10),
+			Status:     a.Status,
+			DecidedBy:  idPtr(a.DecidedBy),
+			DecidedAt:  unixPtr(a.DecidedAt),
+			Note:       a.Note,
+		})
+	}
+	return dto
+}
+
+func idPtr(id *int64) *string {
+	if id == nil {
+		return nil
+	}
+	s := strconv.FormatInt(*id, 10)
+	return &s
+}
+
+func datePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format("2006-01-02")
+	return &s
+}
+
+func unixPtr(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	u := t.Unix()
+	return &u
+}
@@ -0,0 +1,56 @@
+package notification
+
+import (
+	"encoding/json"
+	"strconv"
+
+	notificationEntity "github.com/haily-id/engine/internal/domain/entity/notification"
+)
+
+type NotificationDTO struct {
+	ID        string          `json:"id"`
+	CompanyID *string         `json:"company_id"`
+	Type      string          `json:"type"`
+	Title     string          `json:"title"`
+	Body      string          `json:"body"`
+	Data      json.RawMessage `json:"data"`
+	IsRead    bool            `json:"is_read"`
+	ReadAt    *int64          `json:"read_at"`
+	CreatedAt int64           `json:"created_at"`
+}
+
+type UnreadCountDTO struct {
+	UnreadCount int64 `json:"unread_count"`
+}
+
+func ToNotificationDTO(n *notificationEntity.Notification) NotificationDTO {
+	dto := NotificationDTO{
+		ID:        strconv.FormatInt(n.ID, 10),
+		Type:      n.Type,
+		Title:     n.Title,
+		Body:      n.Body,
+		Data:      json.RawMessage("{}"),
+		IsRead:    n.IsRead(),
+		CreatedAt: n.CreatedAt.Unix(),
+	}
+	if n.CompanyID != nil {
+		id := strconv.FormatInt(*n.CompanyID, 10)
+		dto.CompanyID = &id
+	}
+	if n.Data != nil {
+		dto.Data = json.RawMessage(*n.Data)
+	}
+	if n.ReadAt != nil {
+		t := n.ReadAt.Unix()
+		dto.ReadAt = &t
+	}
+	return dto
+}
+
+func ToNotificationDTOs(list []notificationEntity.Notification) []NotificationDTO {
+	dtos := make([]NotificationDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, ToNotificationDTO(&list[i]))
+	}
+	return dtos
+}
@@ -0,0 +1,132 @@
+package organization
+
+import (
+	"strconv"
+
+	orgEntity "github.com/haily-id/engine/internal/domain/entity/organization"
+)
+
+type DivisionDTO struct {
+	ID             string  `json:"id"`
+	Name           string  `json:"name"`
+	Code           string  `json:"code"`
+	Description    *string `json:"description"`
+	HeadEmployeeID *string `json:"head_employee_id"`
+	IsActive       bool    `json:"is_active"`
+	CreatedAt      int64   `json:"created_at"`
+	UpdatedAt      int64   `json:"updated_at"`
+}
+
+type DepartmentDTO struct {
+	ID             string  `json:"id"`
+	DivisionID     *string `json:"division_id"`
+	Name           string  `json:"name"`
+	Code           string  `json:"code"`
+	Description    *string `json:"description"`
+	HeadEmployeeID *string `json:"head_employee_id"`
+	IsActive       bool    `json:"is_active"`
+	CreatedAt      int64   `json:"created_at"`
+	UpdatedAt      int64   `json:"updated_at"`
+}
+
+type PositionDTO struct {
+	ID           string  `json:"id"`
+	DepartmentID string  `json:"department_id"`
+	Title        string  `json:"title"`
+	Code         string  `json:"code"`
+	Level        string  `json:"level"`
+	Description  *string `json:"description"`
+	IsActive     bool    `json:"is_active"`
+	CreatedAt    int64   `json:"created_at"`
+	UpdatedAt    int64   `json:"updated_at"`
+}
+
+// TreeDTO is the whole structure of a company. Departments without a
+// division are listed next to the divisions.
+type TreeDTO struct {
+	Divisions   []DivisionNodeDTO   `json:"divisions"`
+	Departments []DepartmentNodeDTO `json:"departments"`
+}
+
+type DivisionNodeDTO struct {
+	DivisionDTO
+	Departments []DepartmentNodeDTO `json:"departments"`
+}
+
+type DepartmentNodeDTO struct {
+	DepartmentDTO
+	Positions []PositionDTO `json:"positions"`
+}
+
+func ToDivisionDTO(d *orgEntity.Division) DivisionDTO {
+	return DivisionDTO{
+		ID:             strconv.FormatInt(d.ID, 10),
+		Name:           d.Name,
+		Code:           d.Code,
+		Description:    d.Description,
+		HeadEmployeeID: idPtr(d.HeadEmployeeID),
+		IsActive:       d.IsActive,
+		CreatedAt:      d.CreatedAt.Unix(),
+		UpdatedAt:      d.UpdatedAt.Unix(),
+	}
+}
+
+func ToDivisionDTOs(list []orgEntity.Division) []DivisionDTO {
+	dtos := make([]DivisionDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, ToDivisionDTO(&list[i]))
+	}
+	return dtos
+}
+
+func ToDepartmentDTO(d *orgEntity.Department) DepartmentDTO {
+	return DepartmentDTO{
+		ID:             strconv.FormatInt(d.ID, 10),
+		DivisionID:     idPtr(d.DivisionID),
+		Name:           d.Name,
+		Code:           d.Code,
+		Description:    d.Description,
+		HeadEmployeeID: idPtr(d.HeadEmployeeID),
+		IsActive:       d.IsActive,
+		CreatedAt:      d.CreatedAt.Unix(),
+		UpdatedAt:      d.UpdatedAt.Unix(),
+	}
+}
+
+func ToDepartmentDTOs(list []orgEntity.Department) []DepartmentDTO {
+	dtos := make([]DepartmentDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, ToDepartmentDTO(&list[i]))
+	}
+	return dtos
+}
+
+func ToPositionDTO(p *orgEntity.Position) PositionDTO {
+	return PositionDTO{
+		ID:           strconv.FormatInt(p.ID, 10),
+		DepartmentID: strconv.FormatInt(p.DepartmentID, 10),
+		Title:        p.Title,
+		Code:         p.Code,
+		Level:        p.Level,
+		Description:  p.Description,
+		IsActive:     p.IsActive,
+		CreatedAt:    p.CreatedAt.Unix(),
+		UpdatedAt:    p.UpdatedAt.Unix(),
+	}
+}
+
+func ToPositionDTOs(list []orgEntity.Position) []PositionDTO {
+	dtos := make([]PositionDTO, 0, len(list))
+	for i := range list {
+		dtos = append(dtos, ToPositionDTO(&list[i]))
+	}
+	return dtos
+}
+
+func idPtr(id *int64) *string {
+	if id == nil {
+		return nil
+	}
+	s := strconv.FormatInt(*id, 10)
+	return &s
+}
@@ -0,0 +1,21 @@
+package orgchart
+
+type NodeDTO struct {
+	EmployeeID     string    `json:"employee_id"`
+	EmployeeNumber *string   `json:"employee_number"`
+	Name           string    `json:"name"`
+	Email          string    `json:"email"`
+	PositionID     *string   `json:"position_id"`
+	PositionTitle  *string   `json:"position_title"`
+	Level          *string   `json:"level"`
+	DepartmentID   *string   `json:"department_id"`
+	DepartmentName *string   `json:"department_name"`
+	DivisionID     *string   `json:"division_id"`
+	DivisionName   *string   `json:"division_name"`
+	HeadOf         *string   `json:"head_of"`
+	Reports        []NodeDTO `json:"reports"`
+}
+
+type ChartDTO struct {
+	Roots []NodeDTO `json:"roots"`
+}
@@ -0,0 +1,31 @@
+package rbac
+
+import (
+	"strconv"
+
+	rbacEntity "github.com/haily-id/engine/internal/domain/entity/rbac"
+)
+
+type RoleDTO struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Code     string `json:"code"`
+	IsSystem bool   `json:"is_system"`
+}
+
+func ToDTO(r *rbacEntity.Role) RoleDTO {
+	return RoleDTO{
+		ID:       strconv.FormatInt(r.ID, 10),
+		Name:     r.Name,
+		Code:     r.Code,
+		IsSystem: r.IsSystem,
+	}
+}
+
+func ToDTOs(roles []rbacEntity.Role) []RoleDTO {
+	dtos := make([]RoleDTO, 0, len(roles))
+	for i := range roles {
+		dtos = append(dtos, ToDTO(&roles[i]))
+	}
+	return dtos
+}
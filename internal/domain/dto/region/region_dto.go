@@ -0,0 +1,133 @@
+package region
+
+import (
+	regionEntity "github.com/haily-id/engine/internal/domain/entity/region"
+)
+
+type ProvinceDTO struct {
+	ID   int    `json:"id"`
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+type CityDTO struct {
+	ID         int    `json:"id"`
+	ProvinceID int    `json:"province_id"`
+	Type       string `json:"type"`
+	Code       string `json:"code"`
+	FullCode   string `json:"full_code"`
+	Name       string `json:"name"`
+}
+
+type DistrictDTO struct {
+	ID       int    `json:"id"`
+	CityID   int    `json:"city_id"`
+	Code     string `json:"code"`
+	FullCode string `json:"full_code"`
+	Name     string `json:"name"`
+}
+
+type VillageDTO struct {
+	ID         int     `json:"id"`
+	DistrictID int     `json:"district_id"`
+	Code       string  `json:"code"`
+	FullCode   string  `json:"full_code"`
+	Name       string  `json:"name"`
+	PostalCode *string `json:"postal_code"`
+}
+
+// HierarchyDTO is a village with every level above it.
+type HierarchyDTO struct {
+	Province ProvinceDTO `json:"province"`
+	City     CityDTO     `json:"city"`
+	District DistrictDTO `json:"district"`
+	Village  VillageDTO  `json:"village"`
+}
+
+func ToProvinceDTO(p *regionEntity.Province) ProvinceDTO {
+	return ProvinceDTO{ID: p.ID, Code: p.Code, Name: p.Name}
+}
+
+func ToProvinceDTOs(provinces []regionEntity.Province) []ProvinceDTO {
+	dtos := make([]ProvinceDTO, len(provinces))
+	for i := range provinces {
+		dtos[i] = ToProvinceDTO(&provinces[i])
+	}
+	return dtos
+}
+
+func ToCityDTO(c *regionEntity.City) CityDTO {
+	return CityDTO{
+		ID:         c.ID,
+		ProvinceID: c.ProvinceID,
+		Type:       c.Type,
+		Code:       c.Code,
+		FullCode:   c.FullCode,
+		Name:       c.Name,
+	}
+}
+
+func ToCityDTOs(cities []regionEntity.City) []CityDTO {
+	dtos := make([]CityDTO, len(cities))
+	for i := range cities {
+		dtos[i] = ToCityDTO(&cities[i])
+	}
+	return dtos
+}
+
+func ToDistrictDTO(d *regionEntity.District) DistrictDTO {
+	return DistrictDTO{
+		ID:       d.ID,
+		CityID:   d.CityID,
+		Code:     d.Code,
+		FullCode: d.FullCode,
+		Name:     d.Name,
+	}
+}
+
+func ToDistrictDTOs(districts []regionEntity.District) []DistrictDTO {
+	dtos := make([]DistrictDTO, len(districts))
+	for i := range districts {
+		dtos[i] = ToDistrictDTO(&districts[i])
+	}
+	return dtos
+}
+
+func ToVillageDTO(v *regionEntity.Village) VillageDTO {
+	return VillageDTO{
+		ID:         v.ID,
+		DistrictID: v.DistrictID,
+		Code:       v.Code,
+		FullCode:   v.FullCode,
+		Name:       v.Name,
+		PostalCode: v.PosCode,
+	}
+}
+
+func ToVillageDTOs(villages []regionEntity.Village) []VillageDTO {
+	dtos := make([]VillageDTO, len(villages))
+	for i := range villages {
+		dtos[i] = ToVillageDTO(&villages[i])
+	}
+	return dtos
+}
+
+// ToHierarchyDTO expects the village's district, city and province to be
+// loaded.
+func ToHierarchyDTO(v *regionEntity.Village) HierarchyDTO {
+	return HierarchyDTO{
+		Province: ToProvinceDTO(v.District.City.Province),
+		City:     ToCityDTO(v.District.City),
+		District: ToDistrictDTO(v.District),
+		Village:  ToVillageDTO(v),
+	}
+}
+
+// ToHierarchyDTOs converts search results, which carry their hierarchy.
+func ToHierarchyDTOs(villages []regionEntity.Village) []HierarchyDTO {
+	dtos := make([]HierarchyDTO, len(villages))
+	for i := range villages {
+		dtos[i] = ToHierarchyDTO(&villages[i])
+	}
+	return dtos
+}
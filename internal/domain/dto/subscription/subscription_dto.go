@@ -0,0 +1,195 @@
+package subscription
+
+import (
+	"strconv"
+
+	subscriptionEntity "github.com/haily-id/engine/internal/domain/entity/subscription"
+)
+
+type ModuleDTO struct {
+	ID          string  `json:"id"`
+	Code        string  `json:"code"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	IconKey     *string `json:"icon_key"`
+	IsActive    bool    `json:"is_active"`
+}
+
+type PlanDTO struct {
+	ID           string      `json:"id"`
+	Name         string      `json:"name"`
+	Code         string      `json:"code"`
+	Currency     string      `json:"currency"`
+	PriceMonthly int64       `json:"price_monthly"`
+	PriceYearly  int64       `json:"price_yearly"`
+	MaxUsers     int         `json:"max_users"`
+	MaxEmployees int         `json:"max_employees"`
+	MaxStorageGB int         `json:"max_storage_gb"`
+	IsPublic     bool        `json:"is_public"`
+	IsActive     bool        `json:"is_active"`
+	Modules      []ModuleDTO `json:"modules"`
+	CreatedAt    int64       `json:"created_at"`
+	UpdatedAt    int64       `json:"updated_at"`
+}
+
+type SubscriptionDTO struct {
+	ID                  string   `json:"id"`
+	CompanyID           string   `json:"company_id"`
+	Status              string   `json:"status"`
+	BillingCycle        string   `json:"billing_cycle"`
+	Plan                PlanDTO  `json:"plan"`
+	PendingPlan         *PlanDTO `json:"pending_plan"`
+	PendingBillingCycle *string  `json:"pending_billing_cycle"`
+	TrialEndsAt         *int64   `json:"trial_ends_at"`
+	CurrentPeriodStart  int64    `json:"current_period_start"`
+	CurrentPeriodEnd    int64    `json:"current_period_end"`
+	CancelledAt         *int64   `json:"cancelled_at"`
+	CreatedAt           int64    `json:"created_at"`
+	UpdatedAt           int64    `json:"updated_at"`
+}
+
+type ChangeDTO struct {
+	FromPlanID       string `json:"from_plan_id"`
+	ToPlanID         string `json:"to_plan_id"`
+	FromBillingCycle string `json:"from_billing_cycle"`
+	ToBillingCycle   string `json:"to_billing_cycle"`
+	ProrationCredit  int64  `json:"proration_credit"`
+	ProrationCharge  int64  `json:"proration_charge"`
+	AmountDue        int64  `json:"amount_due"`
+	IsScheduled      bool   `json:"is_scheduled"`
+	EffectiveAt      int64  `json:"effective_at"`
+}
+
+type ChangeResultDTO struct {
+	Subscription SubscriptionDTO `json:"subscription"`
+	Change       ChangeDTO       `json:"change"`
+}
+
+type EntitlementDTO struct {
+	Module    ModuleDTO `json:"module"`
+	Enabled   bool      `json:"enabled"`
+	Source    string    `json:"source"`
+	ExpiresAt *int64    `json:"expires_at"`
+}
+
+type CompanyModuleDTO struct {
+	ID        string `json:"id"`
+	CompanyID string `json:"company_id"`
+	ModuleID  string `json:"module_id"`
+	IsActive  bool   `json:"is_active"`
+	EnabledAt *int64 `json:"enabled_at"`
+	ExpiresAt *int64 `json:"expires_at"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+type UsageDTO struct {
+	PlanID          string `json:"plan_id"`
+	PlanCode        string `json:"plan_code"`
+	Users           int64  `json:"users"`
+	MaxUsers        int64  `json:"max_users"`
+	Employees       int64  `json:"employees"`
+	MaxEmployees    int64  `json:"max_employees"`
+	StorageBytes    int64  `json:"storage_bytes"`
+	MaxStorageBytes int64  `json:"max_storage_bytes"`
+}
+
+func ToModuleDTO(m *subscriptionEntity.Module) ModuleDTO {
+	return ModuleDTO{
+		ID:          strconv.FormatInt(m.ID, 10),
+		Code:        m.Code,
+		Name:        m.Name,
+		Description: m.Description,
+		IconKey:     m.IconKey,
+		IsActive:    m.IsActive,
+	}
+}
+
+func ToModuleDTOs(modules []subscriptionEntity.Module) []ModuleDTO {
+	dtos := make([]ModuleDTO, 0, len(modules))
+	for i := range modules {
+		dtos = append(dtos, ToModuleDTO(&modules[i]))
+	}
+	return dtos
+}
+
+func ToPlanDTO(p *subscriptionEntity.Plan, modules []subscriptionEntity.Module) PlanDTO {
+	return PlanDTO{
+		ID:           strconv.FormatInt(p.ID, 10),
+		Name:         p.Name,
+		Code:         p.Code,
+		Currency:     p.Currency,
+		PriceMonthly: p.PriceMonthly,
+		PriceYearly:  p.PriceYearly,
+		MaxUsers:     p.MaxUsers,
+		MaxEmployees: p.MaxEmployees,
+		MaxStorageGB: p.MaxStorageGB,
+		IsPublic:     p.IsPublic,
+		IsActive:     p.IsActive,
+		Modules:      ToModuleDTOs(modules),
+		CreatedAt:    p.CreatedAt.Unix(),
+		UpdatedAt:    p.UpdatedAt.Unix(),
+	}
+}
+
+func ToSubscriptionDTO(s *subscriptionEntity.CompanySubscription, plan, pendingPlan *subscriptionEntity.Plan) SubscriptionDTO {
+	dto := SubscriptionDTO{
+		ID:                  strconv.FormatInt(s.ID, 10),
+		CompanyID:           strconv.FormatInt(s.CompanyID, 10),
+		Status:              s.Status,
+		BillingCycle:        s.BillingCycle,
+		Plan:                ToPlanDTO(plan, nil),
+		PendingBillingCycle: s.PendingBillingCycle,
+		CurrentPeriodStart:  s.CurrentPeriodStart.Unix(),
+		CurrentPeriodEnd:    s.CurrentPeriodEnd.Unix(),
+		CreatedAt:           s.CreatedAt.Unix(),
+		UpdatedAt:           s.UpdatedAt.Unix(),
+	}
+
+	if pendingPlan != nil {
+		p := ToPlanDTO(pendingPlan, nil)
+		dto.PendingPlan = &p
+	}
+	if s.TrialEndsAt != nil {
+		v := s.TrialEndsAt.Unix()
+		dto.TrialEndsAt = &v
+	}
+	if s.CancelledAt != nil {
+		v := s.CancelledAt.Unix()
+		dto.CancelledAt = &v
+	}
+
+	return dto
+}
+
+func ToChangeDTO(c *subscriptionEntity.SubscriptionChange) ChangeDTO {
+	return ChangeDTO{
+		FromPlanID:       strconv.FormatInt(c.FromPlanID, 10),
+		ToPlanID:         strconv.FormatInt(c.ToPlanID, 10),
+		FromBillingCycle: c.FromBillingCycle,
+		ToBillingCycle:   c.ToBillingCycle,
+		ProrationCredit:  c.ProrationCredit,
+		ProrationCharge:  c.ProrationCharge,
+		AmountDue:        c.AmountDue,
+		IsScheduled:      c.IsScheduled,
+		EffectiveAt:      c.EffectiveAt.Unix(),
+	}
+}
+
+func ToCompanyModuleDTO(cm *subscriptionEntity.CompanyModule) CompanyModuleDTO {
+	dto := CompanyModuleDTO{
+		ID:        strconv.FormatInt(cm.ID, 10),
+		CompanyID: strconv.FormatInt(cm.CompanyID, 10),
+		ModuleID:  strconv.FormatInt(cm.ModuleID, 10),
+		IsActive:  cm.IsActive,
+		UpdatedAt: cm.UpdatedAt.Unix(),
+	}
+	if cm.EnabledAt != nil {
+		v := cm.EnabledAt.Unix()
+		dto.EnabledAt = &v
+	}
+	if cm.ExpiresAt != nil {
+		v := cm.ExpiresAt.Unix()
+		dto.ExpiresAt = &v
+	}
+	return dto
+}
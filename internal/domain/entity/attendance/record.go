@@ -0,0 +1,80 @@
+package attendance
+
+import "time"
+
+// Record is an employee's attendance on one day, the day in the company's
+// timezone their clock-in falls on. ScheduledStart and ScheduledEnd are
+// copied from the shift in force when the record is made, so later shift
+// changes leave past records alone; both are nil when the employee had no
+// shift, and such records are never late or early.
+//
+// Each clock keeps the position it was made from, the work location
+// address it was matched to and the distance to it in meters. Records
+// changed by HR are marked Corrected, with who changed them, when and
+// why; the previous values go to the audit log.
+type Record struct {
+	ID                int64     `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID         int64     `gorm:"not null;index:idx_attendance_records_company_date"`
+	EmployeeID        int64     `gorm:"not null;uniqueIndex:idx_attendance_records_employee_date"`
+	Date              time.Time `gorm:"type:date;not null;uniqueIndex:idx_attendance_records_employee_date;index:idx_attendance_records_company_date"`
+	ShiftID           *int64    `gorm:"index"`
+	ScheduledStart    *time.Time
+	ScheduledEnd      *time.Time
+	ClockIn           *time.Time
+	ClockInLatitude   *float64
+	ClockInLongitude  *float64
+	ClockInAddressID  *int64
+	ClockInDistance   *int
+	ClockOut          *time.Time
+	ClockOutLatitude  *float64
+	ClockOutLongitude *float64
+	ClockOutAddressID *int64
+	ClockOutDistance  *int
+	IsLate            bool `gorm:"not null;default:false"`
+	LateMinutes       int  `gorm:"not null;default:0"`
+	IsEarlyLeave      bool `gorm:"not null;default:false"`
+	EarlyLeaveMinutes int  `gorm:"not null;default:0"`
+	WorkedMinutes     int  `gorm:"not null;default:0"`
+	Corrected         bool `gorm:"not null;default:false"`
+	CorrectedBy       *int64
+	CorrectedAt       *time.Time
+	CorrectionReason  *string `gorm:"type:varchar(500)"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func (Record) TableName() string {
+	return "attendance_records"
+}
+
+// Open reports whether the employee clocked in and not out yet.
+func (r *Record) Open() bool {
+	return r.ClockIn != nil && r.ClockOut == nil
+}
+
+// Evaluate works out the late and early-leave flags and the minutes
+// worked from the clocks, against the schedule and the tolerances of s.
+// Late and early minutes count from the schedule, tolerance included, and
+// stay 0 within it. Minutes are whole, rounded down.
+func (r *Record) Evaluate(s *Shift) {
+	r.IsLate, r.LateMinutes = false, 0
+	r.IsEarlyLeave, r.EarlyLeaveMinutes = false, 0
+	r.WorkedMinutes = 0
+
+	if r.ClockIn != nil && r.ClockOut != nil && r.ClockOut.After(*r.ClockIn) {
+		r.WorkedMinutes = int(r.ClockOut.Sub(*r.ClockIn) / time.Minute)
+	}
+	if s == nil || r.ScheduledStart == nil || r.ScheduledEnd == nil {
+		return
+	}
+	if r.ClockIn != nil {
+		if m := int(r.ClockIn.Sub(*r.ScheduledStart) / time.Minute); m > s.LateToleranceMinutes {
+			r.IsLate, r.LateMinutes = true, m
+		}
+	}
+	if r.ClockOut != nil {
+		if m := int(r.ScheduledEnd.Sub(*r.ClockOut) / time.Minute); m > s.EarlyLeaveToleranceMinutes {
+			r.IsEarlyLeave, r.EarlyLeaveMinutes = true, m
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package attendance
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// RadiusSetting is the company setting holding how far, in meters, a
+	// clock-in may be from a work location. Addresses can override it with
+	// their own GeofenceRadius.
+	RadiusSetting = "hr.attendance.radius_meters"
+
+	timeLayout = "15:04"
+)
+
+// Shift is a working schedule of a company. StartTime and EndTime are
+// HH:MM in the company's timezone; a shift whose end is not after its
+// start runs overnight into the next day. Clocking in more than
+// LateToleranceMinutes after the start counts as late, clocking out more
+// than EarlyLeaveToleranceMinutes before the end as leaving early.
+//
+// Codes are unique per company among rows that are not deleted. The
+// default shift applies to employees without a shift assignment; a
+// company has at most one.
+type Shift struct {
+	ID                         int64  `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID                  int64  `gorm:"not null;uniqueIndex:idx_attendance_shifts_company_code,where:deleted_at IS NULL"`
+	Code                       string `gorm:"type:varchar(50);not null;uniqueIndex:idx_attendance_shifts_company_code,where:deleted_at IS NULL"`
+	Name                       string `gorm:"type:varchar(255);not null"`
+	StartTime                  string `gorm:"type:varchar(5);not null"`
+	EndTime                    string `gorm:"type:varchar(5);not null"`
+	LateToleranceMinutes       int    `gorm:"not null;default:0"`
+	EarlyLeaveToleranceMinutes int    `gorm:"not null;default:0"`
+	IsDefault                  bool   `gorm:"not null;default:false"`
+	IsActive                   bool   `gorm:"not null;default:true"`
+	CreatedAt                  time.Time
+	UpdatedAt                  time.Time
+	DeletedAt                  gorm.DeletedAt `gorm:"index"`
+}
+
+func (Shift) TableName() string {
+	return "attendance_shifts"
+}
+
+// Overnight reports whether the shift ends on the day after it starts.
+func (s *Shift) Overnight() bool {
+	return s.EndTime <= s.StartTime
+}
+
+// Window returns when the shift starts and ends on date, a day given as
+// midnight UTC, in loc.
+func (s *Shift) Window(date time.Time, loc *time.Location) (time.Time, time.Time, error) {
+	start, err := clock(date, s.StartTime, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	end, err := clock(date, s.EndTime, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if s.Overnight() {
+		end = end.AddDate(0, 0, 1)
+	}
+	return start, end, nil
+}
+
+func clock(date time.Time, hhmm string, loc *time.Location) (time.Time, error) {
+	t, err := time.Parse(timeLayout, hhmm)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid shift time %q: %w", hhmm, err)
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), t.Hour(), t.Minute(), 0, 0, loc), nil
+}
+
+// ShiftAssignment puts an employee on a shift from StartDate on, until
+// their next assignment starts. An employee has at most one assignment
+// per start date.
+type ShiftAssignment struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID  int64     `gorm:"not null;index"`
+	EmployeeID int64     `gorm:"not null;uniqueIndex:idx_attendance_shift_assignments_employee_start"`
+	ShiftID    int64     `gorm:"not null;index"`
+	StartDate  time.Time `gorm:"type:date;not null;uniqueIndex:idx_attendance_shift_assignments_employee_start"`
+	AssignedBy int64     `gorm:"not null"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (ShiftAssignment) TableName() string {
+	return "attendance_shift_assignments"
+}
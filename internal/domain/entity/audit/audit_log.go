@@ -0,0 +1,25 @@
+package audit
+
+import "time"
+
+// AuditLog records who changed what. OldValues and NewValues hold JSON
+// objects; for updates they only contain the fields that changed.
+type AuditLog struct {
+	ID        int64   `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID *int64  `gorm:"index:idx_audit_logs_company_created"`
+	UserID    *int64  `gorm:"index"`
+	RequestID *string `gorm:"type:varchar(64);index"`
+	Module    string  `gorm:"type:varchar(50);not null"`
+	Entity    string  `gorm:"type:varchar(50);not null"`
+	EntityID  *int64
+	Action    string    `gorm:"type:varchar(30);not null"`
+	OldValues *string   `gorm:"type:jsonb"`
+	NewValues *string   `gorm:"type:jsonb"`
+	IPAddress *string   `gorm:"type:varchar(45)"`
+	UserAgent *string   `gorm:"type:varchar(500)"`
+	CreatedAt time.Time `gorm:"not null;index:idx_audit_logs_company_created"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
@@ -0,0 +1,94 @@
+package billing
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	InvoiceStatusDraft   = "DRAFT"
+	InvoiceStatusIssued  = "ISSUED"
+	InvoiceStatusPaid    = "PAID"
+	InvoiceStatusOverdue = "OVERDUE"
+	InvoiceStatusVoid    = "VOID"
+
+	PaymentMethodBankTransfer = "BANK_TRANSFER"
+	PaymentMethodCreditCard   = "CREDIT_CARD"
+	PaymentMethodVA           = "VA"
+
+	// DefaultTaxRate is the PPN (VAT) rate in percent.
+	DefaultTaxRate = 11
+)
+
+// Invoice bills a company for one subscription period or for the prorated
+// difference of an immediate plan change. Amounts are whole IDR.
+type Invoice struct {
+	ID                   int64     `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID            int64     `gorm:"not null;index"`
+	SubscriptionID       int64     `gorm:"not null;index"`
+	SubscriptionChangeID *int64    `gorm:"uniqueIndex"`
+	InvoiceNumber        string    `gorm:"uniqueIndex;type:varchar(30);not null"`
+	Status               string    `gorm:"type:varchar(20);not null;index"`
+	Currency             string    `gorm:"type:varchar(3);not null;default:'IDR'"`
+	Subtotal             int64     `gorm:"not null;default:0"`
+	TaxRate              int       `gorm:"not null;default:0"`
+	TaxAmount            int64     `gorm:"not null;default:0"`
+	TotalAmount          int64     `gorm:"not null;default:0"`
+	PaymentMethod        *string   `gorm:"type:varchar(20)"`
+	PaymentReference     *string   `gorm:"type:varchar(255)"`
+	Notes                *string   `gorm:"type:text"`
+	PeriodStart          time.Time `gorm:"not null"`
+	PeriodEnd            time.Time `gorm:"not null"`
+	PDFKey               *string   `gorm:"type:varchar(500)"`
+	IssuedAt             *time.Time
+	DueAt                *time.Time `gorm:"index"`
+	PaidAt               *time.Time
+	Items                []InvoiceItem `gorm:"foreignKey:InvoiceID"`
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+func (Invoice) TableName() string {
+	return "billing_invoices"
+}
+
+// IsPayable reports whether the invoice still awaits payment.
+func (i *Invoice) IsPayable() bool {
+	return i.Status == InvoiceStatusIssued || i.Status == InvoiceStatusOverdue
+}
+
+type InvoiceItem struct {
+	ID          int64  `gorm:"primaryKey;autoIncrement:false"`
+	InvoiceID   int64  `gorm:"not null;index"`
+	Description string `gorm:"type:varchar(255);not null"`
+	Quantity    int    `gorm:"not null;default:1"`
+	UnitPrice   int64  `gorm:"not null"`
+	Amount      int64  `gorm:"not null"`
+	CreatedAt   time.Time
+}
+
+func (InvoiceItem) TableName() string {
+	return "billing_invoice_items"
+}
+
+// InvoiceSequence holds the last invoice number used in a year. It is
+// incremented in the same transaction that inserts the invoice, so numbers
+// are gap-free.
+type InvoiceSequence struct {
+	Year       int   `gorm:"primaryKey;autoIncrement:false"`
+	LastNumber int64 `gorm:"not null;default:0"`
+}
+
+func (InvoiceSequence) TableName() string {
+	return "billing_invoice_sequences"
+}
+
+// FormatInvoiceNumber renders the n-th invoice of year, e.g. INV/2026/000123.
+func FormatInvoiceNumber(year int, n int64) string {
+	return fmt.Sprintf("INV/%d/%06d", year, n)
+}
+
+// TaxOf returns rate percent of amount, rounded half up.
+func TaxOf(amount int64, rate int) int64 {
+	return (amount*int64(rate) + 50) / 100
+}
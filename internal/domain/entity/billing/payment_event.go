@@ -0,0 +1,33 @@
+package billing
+
+import "time"
+
+// PaymentEvent is a webhook received from a payment provider. The unique
+// (provider, event_id) pair makes processing idempotent: providers retry
+// deliveries, and a repeated event is acknowledged without side effects.
+type PaymentEvent struct {
+	ID          int64  `gorm:"primaryKey;autoIncrement:false"`
+	Provider    string `gorm:"type:varchar(30);not null;uniqueIndex:idx_payment_events_provider_event"`
+	EventID     string `gorm:"type:varchar(100);not null;uniqueIndex:idx_payment_events_provider_event"`
+	Type        string `gorm:"type:varchar(30);not null"`
+	InvoiceID   *int64 `gorm:"index"`
+	Amount      int64  `gorm:"not null;default:0"`
+	Payload     string `gorm:"type:text;not null"`
+	Result      string `gorm:"type:varchar(30);not null"`
+	ProcessedAt time.Time
+	CreatedAt   time.Time
+}
+
+func (PaymentEvent) TableName() string {
+	return "payment_events"
+}
+
+// Results recorded on a PaymentEvent.
+const (
+	EventResultSettled        = "SETTLED"
+	EventResultAlreadyPaid    = "ALREADY_PAID"
+	EventResultAmountMismatch = "AMOUNT_MISMATCH"
+	EventResultNotPayable     = "NOT_PAYABLE"
+	EventResultUnknownInvoice = "UNKNOWN_INVOICE"
+	EventResultRecorded       = "RECORDED"
+)
@@ -0,0 +1,67 @@
+package company
+
+import (
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/region"
+	"gorm.io/gorm"
+)
+
+const (
+	AddressTypeMain           = "MAIN"
+	AddressTypeBranch         = "BRANCH"
+	AddressTypeCorrespondence = "CORRESPONDENCE"
+	AddressTypeRegional       = "REGIONAL"
+	AddressTypeWarehouse      = "WAREHOUSE"
+)
+
+// Address is a company location. A company with addresses has exactly one
+// primary address, and it is of type MAIN.
+//
+// Latitude and Longitude place the address for attendance geofencing;
+// GeofenceRadius, in meters, overrides the company's
+// hr.attendance.radius_meters for it.
+type Address struct {
+	ID             int64   `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID      int64   `gorm:"not null;index"`
+	Type           string  `gorm:"type:varchar(20);not null"`
+	AddressLine1   string  `gorm:"type:text;not null"`
+	AddressLine2   *string `gorm:"type:text"`
+	ProvinceID     int     `gorm:"not null"`
+	CityID         int     `gorm:"not null"`
+	DistrictID     int     `gorm:"not null"`
+	VillageID      *int
+	PostalCode     *string `gorm:"type:varchar(10)"`
+	Phone          *string `gorm:"type:varchar(50)"`
+	PICName        *string `gorm:"column:pic_name;type:varchar(255)"`
+	PICPhone       *string `gorm:"column:pic_phone;type:varchar(50)"`
+	Latitude       *float64
+	Longitude      *float64
+	GeofenceRadius *int
+	IsPrimary      bool             `gorm:"not null;default:false"`
+	IsActive       bool             `gorm:"not null;default:true"`
+	Province       *region.Province `gorm:"foreignKey:ProvinceID"`
+	City           *region.City     `gorm:"foreignKey:CityID"`
+	District       *region.District `gorm:"foreignKey:DistrictID"`
+	Village        *region.Village  `gorm:"foreignKey:VillageID"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      gorm.DeletedAt `gorm:"index"`
+}
+
+func (Address) TableName() string {
+	return "company_addresses"
+}
+
+func IsAddressType(t string) bool {
+	switch t {
+	case AddressTypeMain, AddressTypeBranch, AddressTypeCorrespondence, AddressTypeRegional, AddressTypeWarehouse:
+		return true
+	}
+	return false
+}
+
+// HasCoordinates reports whether the address can be geofenced.
+func (a *Address) HasCoordinates() bool {
+	return a.Latitude != nil && a.Longitude != nil
+}
@@ -0,0 +1,58 @@
+package company
+
+import "time"
+
+// Industry and CompanyType are platform-wide catalogues referenced by
+// companies. Entries are deactivated rather than deleted so companies that
+// already reference them keep a valid row.
+
+type Industry struct {
+	ID          int64   `gorm:"primaryKey;autoIncrement:false"`
+	Name        string  `gorm:"uniqueIndex;type:varchar(100);not null"`
+	Code        string  `gorm:"uniqueIndex;type:varchar(20);not null"`
+	Description *string `gorm:"type:text"`
+	IsActive    bool    `gorm:"not null;default:true"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (Industry) TableName() string {
+	return "industries"
+}
+
+type CompanyType struct {
+	ID          int64   `gorm:"primaryKey;autoIncrement:false"`
+	Name        string  `gorm:"uniqueIndex;type:varchar(100);not null"`
+	Code        string  `gorm:"uniqueIndex;type:varchar(20);not null"`
+	Description *string `gorm:"type:text"`
+	IsActive    bool    `gorm:"not null;default:true"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (CompanyType) TableName() string {
+	return "company_types"
+}
+
+// DefaultIndustries returns the industries seeded on a fresh database.
+func DefaultIndustries() []Industry {
+	return []Industry{
+		{Code: "IT", Name: "Teknologi Informasi", IsActive: true},
+		{Code: "MFG", Name: "Manufaktur", IsActive: true},
+		{Code: "FIN", Name: "Keuangan", IsActive: true},
+		{Code: "RTL", Name: "Ritel", IsActive: true},
+	}
+}
+
+// DefaultCompanyTypes returns the legal entity types seeded on a fresh
+// database.
+func DefaultCompanyTypes() []CompanyType {
+	return []CompanyType{
+		{Code: "PT", Name: "Perseroan Terbatas", IsActive: true},
+		{Code: "CV", Name: "Persekutuan Komanditer (CV)", IsActive: true},
+		{Code: "FIRMA", Name: "Firma", IsActive: true},
+		{Code: "UD", Name: "Usaha Dagang", IsActive: true},
+		{Code: "KOPERASI", Name: "Koperasi", IsActive: true},
+		{Code: "PERORANGAN", Name: "Perseroan Perorangan", IsActive: true},
+	}
+}
@@ -0,0 +1,58 @@
+package company
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultTimezone = "Asia/Jakarta"
+	DefaultLocale   = "id-ID"
+	DefaultCurrency = "IDR"
+)
+
+type Company struct {
+	ID            int64   `gorm:"primaryKey;autoIncrement:false"`
+	Name          string  `gorm:"type:varchar(255);not null"`
+	LegalName     string  `gorm:"type:varchar(255);not null"`
+	Code          string  `gorm:"uniqueIndex;type:varchar(50);not null"`
+	Email         *string `gorm:"type:varchar(255)"`
+	Phone         *string `gorm:"type:varchar(50)"`
+	IndustryID    *int64  `gorm:"index"`
+	CompanyTypeID *int64  `gorm:"index"`
+	TaxID         *string `gorm:"type:varchar(30)"`
+	LogoKey       *string `gorm:"type:varchar(500)"`
+	OwnerID       int64   `gorm:"not null;index"`
+	Timezone      string  `gorm:"type:varchar(50);not null;default:'Asia/Jakarta'"`
+	Locale        string  `gorm:"type:varchar(10);not null;default:'id-ID'"`
+	Currency      string  `gorm:"type:varchar(3);not null;default:'IDR'"`
+	IsActive      bool    `gorm:"not null;default:true"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     gorm.DeletedAt `gorm:"index"`
+}
+
+func (Company) TableName() string {
+	return "companies"
+}
+
+// Location is the company's timezone, WIB when it cannot be loaded.
+func (c *Company) Location() *time.Location {
+	return LoadLocation(c.Timezone)
+}
+
+// Today is the current date in the company's timezone, as midnight UTC.
+func (c *Company) Today() time.Time {
+	y, m, d := time.Now().In(c.Location()).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// LoadLocation loads a timezone by name, falling back to WIB.
+func LoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.FixedZone("WIB", 7*60*60)
+	}
+	return loc
+}
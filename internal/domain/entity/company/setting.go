@@ -0,0 +1,30 @@
+package company
+
+import "time"
+
+const (
+	SettingTypeString  = "STRING"
+	SettingTypeInteger = "INTEGER"
+	SettingTypeBoolean = "BOOLEAN"
+	SettingTypeJSON    = "JSON"
+
+	SettingModuleGeneral = "general"
+)
+
+// Setting stores a company's value for a registered key. Keys without a row
+// use the registered default. Value holds the canonical text form: decimal
+// integers, "true"/"false", or compact JSON.
+type Setting struct {
+	ID        int64  `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID int64  `gorm:"not null;uniqueIndex:idx_company_settings_company_key"`
+	Key       string `gorm:"type:varchar(100);not null;uniqueIndex:idx_company_settings_company_key"`
+	Value     string `gorm:"type:text;not null"`
+	ValueType string `gorm:"type:varchar(10);not null"`
+	Module    string `gorm:"type:varchar(50);not null;index"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (Setting) TableName() string {
+	return "company_settings"
+}
@@ -0,0 +1,25 @@
+package company
+
+import (
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/rbac"
+	"gorm.io/gorm"
+)
+
+type UserCompany struct {
+	ID        int64      `gorm:"primaryKey;autoIncrement:false"`
+	UserID    int64      `gorm:"not null;uniqueIndex:idx_user_companies_user_company"`
+	CompanyID int64      `gorm:"not null;index;uniqueIndex:idx_user_companies_user_company"`
+	RoleID    int64      `gorm:"not null;index"`
+	Role      *rbac.Role `gorm:"foreignKey:RoleID"`
+	IsActive  bool       `gorm:"not null;default:true"`
+	JoinedAt  time.Time  `gorm:"not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (UserCompany) TableName() string {
+	return "user_companies"
+}
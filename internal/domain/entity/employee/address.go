@@ -0,0 +1,48 @@
+package employee
+
+import (
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/region"
+	"gorm.io/gorm"
+)
+
+const (
+	AddressTypeDomicile = "DOMICILE"
+	AddressTypeKTP      = "KTP"
+	AddressTypeHometown = "HOMETOWN"
+)
+
+// Address is where an employee lives (DOMICILE), the address on their KTP,
+// or their hometown. An employee has at most one primary address of each
+// type.
+type Address struct {
+	ID           int64   `gorm:"primaryKey;autoIncrement:false"`
+	EmployeeID   int64   `gorm:"not null;index;uniqueIndex:idx_employee_addresses_primary,where:is_primary = true AND deleted_at IS NULL"`
+	Type         string  `gorm:"type:varchar(20);not null;uniqueIndex:idx_employee_addresses_primary"`
+	AddressLine1 string  `gorm:"type:text;not null"`
+	AddressLine2 *string `gorm:"type:text"`
+	ProvinceID   int     `gorm:"not null"`
+	CityID       int     `gorm:"not null"`
+	DistrictID   int     `gorm:"not null"`
+	VillageID    *int
+	PostalCode   *string          `gorm:"type:varchar(10)"`
+	IsPrimary    bool             `gorm:"not null;default:false"`
+	Province     *region.Province `gorm:"foreignKey:ProvinceID"`
+	City         *region.City     `gorm:"foreignKey:CityID"`
+	District     *region.District `gorm:"foreignKey:DistrictID"`
+	Village      *region.Village  `gorm:"foreignKey:VillageID"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
+}
+
+func (Address) TableName() string {
+	return "employee_addresses"
+}
+
+func (a *Address) Key() int64    { return a.ID }
+func (a *Address) Primary() bool { return a.IsPrimary }
+
+// Demote makes the record no longer the primary one.
+func (a *Address) Demote() { a.IsPrimary = false }
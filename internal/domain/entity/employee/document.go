@@ -0,0 +1,74 @@
+package employee
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	DocumentTypeKTP         = "KTP"
+	DocumentTypeNPWP        = "NPWP"
+	DocumentTypeContract    = "CONTRACT"
+	DocumentTypeDegree      = "DEGREE"
+	DocumentTypeCertificate = "CERTIFICATE"
+	DocumentTypeOther       = "OTHER"
+
+	DocumentStatusPending  = "PENDING"
+	DocumentStatusVerified = "VERIFIED"
+	DocumentStatusRejected = "REJECTED"
+
+	// MaxDocumentSize is the largest file accepted for upload.
+	MaxDocumentSize = 10 << 20
+
+	// DocumentExpirySetting is the company setting holding how many days
+	// before valid_until reminders go out; 0 disables them.
+	DocumentExpirySetting = "hr.document_expiry_reminder_days"
+)
+
+// DocumentTypes lists the accepted document types.
+func DocumentTypes() []string {
+	return []string{
+		DocumentTypeKTP, DocumentTypeNPWP, DocumentTypeContract,
+		DocumentTypeDegree, DocumentTypeCertificate, DocumentTypeOther,
+	}
+}
+
+// DocumentContentTypes maps the accepted content types to the extension
+// files are stored with.
+var DocumentContentTypes = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+}
+
+// Document is a file kept for an employee. Status starts PENDING until HR
+// verifies or rejects it; VerifiedAt and VerifiedBy record that last review
+// whatever its outcome, and IsVerified is true only while VERIFIED.
+// ReminderSentAt is set once the expiry reminder has gone out.
+type Document struct {
+	ID              int64      `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID       int64      `gorm:"not null;index"`
+	EmployeeID      int64      `gorm:"not null;index"`
+	StorageKey      string     `gorm:"type:varchar(500);not null"`
+	Type            string     `gorm:"type:varchar(20);not null"`
+	Name            string     `gorm:"type:varchar(255);not null"`
+	FileName        string     `gorm:"type:varchar(255);not null"`
+	ContentType     string     `gorm:"type:varchar(100);not null"`
+	Size            int64      `gorm:"not null"`
+	ValidFrom       *time.Time `gorm:"type:date"`
+	ValidUntil      *time.Time `gorm:"type:date;index"`
+	Status          string     `gorm:"type:varchar(20);not null;default:'PENDING'"`
+	IsVerified      bool       `gorm:"not null;default:false"`
+	VerifiedAt      *time.Time
+	VerifiedBy      *int64
+	RejectionReason *string `gorm:"type:varchar(500)"`
+	ReminderSentAt  *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
+}
+
+func (Document) TableName() string {
+	return "employee_documents"
+}
@@ -0,0 +1,45 @@
+package employee
+
+import "time"
+
+const (
+	RelationshipSpouse   = "SPOUSE"
+	RelationshipParent   = "PARENT"
+	RelationshipChild    = "CHILD"
+	RelationshipSibling  = "SIBLING"
+	RelationshipRelative = "RELATIVE"
+	RelationshipFriend   = "FRIEND"
+	RelationshipOther    = "OTHER"
+)
+
+// EmergencyContact is someone to call about an employee. An employee has
+// at most one primary contact. Phone numbers are stored in E.164 form and
+// encrypted at rest.
+type EmergencyContact struct {
+	ID               int64   `gorm:"primaryKey;autoIncrement:false"`
+	EmployeeID       int64   `gorm:"not null;index;uniqueIndex:idx_employee_emergency_contacts_primary,where:is_primary = true"`
+	Name             string  `gorm:"type:varchar(255);not null"`
+	Relationship     string  `gorm:"type:varchar(20);not null"`
+	Phone            string  `gorm:"type:text;not null;serializer:encrypted"`
+	AlternativePhone *string `gorm:"type:text;serializer:encrypted"`
+	IsPrimary        bool    `gorm:"not null;default:false"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (EmergencyContact) TableName() string {
+	return "employee_emergency_contacts"
+}
+
+func (c *EmergencyContact) Key() int64    { return c.ID }
+func (c *EmergencyContact) Primary() bool { return c.IsPrimary }
+
+// Demote makes the record no longer the primary one.
+func (c *EmergencyContact) Demote() { c.IsPrimary = false }
+
+// Masked returns a copy whose phone numbers are masked, for the audit log.
+func (c EmergencyContact) Masked() EmergencyContact {
+	c.Phone = mask(c.Phone)
+	c.AlternativePhone = maskPtr(c.AlternativePhone)
+	return c
+}
@@ -0,0 +1,98 @@
+package employee
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	EmploymentStatusActive     = "ACTIVE"
+	EmploymentStatusTerminated = "TERMINATED"
+	EmploymentStatusSuspended  = "SUSPENDED"
+	EmploymentStatusOnLeave    = "ON_LEAVE"
+
+	EmploymentTypeFullTime = "FULL_TIME"
+	EmploymentTypePartTime = "PART_TIME"
+	EmploymentTypeContract = "CONTRACT"
+	EmploymentTypeIntern   = "INTERN"
+
+	MaritalStatusSingle   = "SINGLE"
+	MaritalStatusMarried  = "MARRIED"
+	MaritalStatusDivorced = "DIVORCED"
+	MaritalStatusWidowed  = "WIDOWED"
+
+	GenderMale   = "MALE"
+	GenderFemale = "FEMALE"
+)
+
+// Employee keeps Phone, NationalID and TaxID encrypted at rest. The
+// repository fills NationalIDIndex and TaxIDIndex, blind indexes that allow
+// exact-match lookups on them.
+type Employee struct {
+	ID               int64      `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID        int64      `gorm:"not null;index;uniqueIndex:idx_employees_company_number"`
+	UserID           *int64     `gorm:"index"`
+	DivisionID       *int64     `gorm:"index"`
+	DepartmentID     *int64     `gorm:"index"`
+	EmployeeNumber   *string    `gorm:"type:varchar(50);uniqueIndex:idx_employees_company_number"`
+	Email            string     `gorm:"type:varchar(255);not null;index"`
+	Name             string     `gorm:"type:varchar(255);not null"`
+	Phone            *string    `gorm:"type:text;serializer:encrypted"`
+	Gender           *string    `gorm:"type:varchar(10)"`
+	AvatarKey        *string    `gorm:"type:varchar(500)"`
+	DateOfBirth      *time.Time `gorm:"type:date"`
+	PlaceOfBirth     *string    `gorm:"type:varchar(100)"`
+	NationalID       *string    `gorm:"type:text;serializer:encrypted"`
+	NationalIDIndex  *string    `gorm:"type:varchar(64);index" json:"-"`
+	TaxID            *string    `gorm:"type:text;serializer:encrypted"`
+	TaxIDIndex       *string    `gorm:"type:varchar(64);index" json:"-"`
+	MaritalStatus    *string    `gorm:"type:varchar(20)"`
+	HireDate         *time.Time `gorm:"type:date"`
+	TerminationDate  *time.Time `gorm:"type:date"`
+	EmploymentStatus string     `gorm:"type:varchar(20);not null;default:'ACTIVE'"`
+	StatusChangedOn  *time.Time `gorm:"type:date"`
+	EmploymentType   string     `gorm:"type:varchar(20);not null;default:'FULL_TIME'"`
+	DependentsCount  int        `gorm:"not null;default:0"`
+	IsActive         bool       `gorm:"not null;default:true"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
+}
+
+func (Employee) TableName() string {
+	return "employees"
+}
+
+// HasAccess reports whether the status lets a linked user into the
+// company. Employees on leave keep access; suspended and terminated ones
+// do not.
+func (e *Employee) HasAccess() bool {
+	return e.EmploymentStatus == EmploymentStatusActive || e.EmploymentStatus == EmploymentStatusOnLeave
+}
+
+// Masked returns a copy whose phone and identity numbers are masked, for
+// the audit log.
+func (e Employee) Masked() Employee {
+	e.Phone = maskPtr(e.Phone)
+	e.NationalID = maskPtr(e.NationalID)
+	e.TaxID = maskPtr(e.TaxID)
+	return e
+}
+
+func maskPtr(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	m := mask(*s)
+	return &m
+}
+
+// mask keeps the last four characters, e.g. "************0001".
+func mask(s string) string {
+	if len(s) <= 4 {
+		return strings.Repeat("*", len(s))
+	}
+	return strings.Repeat("*", len(s)-4) + s[len(s)-4:]
+}
@@ -0,0 +1,55 @@
+package employee
+
+import "time"
+
+const (
+	ImportStatusValidated = "VALIDATED"
+	ImportStatusInvalid   = "INVALID"
+	ImportStatusQueued    = "QUEUED"
+	ImportStatusRunning   = "RUNNING"
+	ImportStatusCompleted = "COMPLETED"
+
+	// MaxImportSize is the largest import file accepted for upload.
+	MaxImportSize = 5 << 20
+
+	// MaxImportRows is the most data rows one import may carry.
+	MaxImportRows = 5000
+)
+
+// ImportRowError reports a problem with one row of an import; row 1 is
+// the header. Column is empty when the problem is not tied to one column.
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	Column  string `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// Import is an uploaded employee file. It is VALIDATED or INVALID after
+// the dry run; a VALIDATED import is QUEUED once confirmed, then RUNNING
+// and COMPLETED as the worker creates the employees. Rows holds the parsed
+// rows, encrypted, until the import completes.
+type Import struct {
+	ID              int64            `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID       int64            `gorm:"not null;index"`
+	CreatedBy       int64            `gorm:"not null"`
+	FileName        string           `gorm:"type:varchar(255);not null"`
+	Status          string           `gorm:"type:varchar(20);not null"`
+	TotalRows       int              `gorm:"not null;default:0"`
+	Errors          []ImportRowError `gorm:"type:jsonb;serializer:json"`
+	Rows            string           `gorm:"type:text;serializer:encrypted" json:"-"`
+	SendInvitations bool             `gorm:"not null;default:false"`
+	RoleID          *int64
+	Lang            string `gorm:"type:varchar(5);not null;default:'en'"`
+	ProcessedRows   int    `gorm:"not null;default:0"`
+	CreatedCount    int    `gorm:"not null;default:0"`
+	InvitedCount    int    `gorm:"not null;default:0"`
+	FailedCount     int    `gorm:"not null;default:0"`
+	StartedAt       *time.Time
+	FinishedAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (Import) TableName() string {
+	return "employee_imports"
+}
@@ -0,0 +1,38 @@
+package employee
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	InvitationStatusPending   = "PENDING"
+	InvitationStatusAccepted  = "ACCEPTED"
+	InvitationStatusExpired   = "EXPIRED"
+	InvitationStatusCancelled = "CANCELLED"
+
+	InvitationExpiry = 7 * 24 * time.Hour
+)
+
+type Invitation struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID    int64     `gorm:"not null;index"`
+	InvitedBy    *int64    `gorm:"index"`
+	EmployeeID   int64     `gorm:"not null;index"`
+	DivisionID   *int64    `gorm:"index"`
+	DepartmentID *int64    `gorm:"index"`
+	RoleID       int64     `gorm:"not null"`
+	Email        string    `gorm:"type:varchar(255);not null;index"`
+	Name         string    `gorm:"type:varchar(255);not null"`
+	Token        string    `gorm:"uniqueIndex;type:varchar(255);not null"`
+	Status       string    `gorm:"type:varchar(20);not null;default:'PENDING';index"`
+	ExpiresAt    time.Time `gorm:"not null;index"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
+}
+
+func (Invitation) TableName() string {
+	return "employee_invitations"
+}
@@ -0,0 +1,103 @@
+package employee
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// NumberPatternSetting is the company setting holding the pattern.
+	NumberPatternSetting = "hr.employee_number_pattern"
+
+	// DefaultNumberPattern numbers employees EMP-0001, EMP-0002, ...
+	DefaultNumberPattern = "EMP-{SEQ:4}"
+)
+
+// Employee number patterns are literal text with tokens:
+//
+//	{COMPANY}  company code
+//	{YYYY}     four-digit year
+//	{YY}       two-digit year
+//	{MM}       two-digit month
+//	{SEQ}      running number, {SEQ:n} zero-padded to n digits (1-10)
+//
+// A pattern has exactly one sequence token. The sequence counts per
+// rendering of the other tokens, so "EMP/{YYYY}/{SEQ:3}" restarts every
+// year.
+var numberToken = regexp.MustCompile(`\{([A-Z]+)(?::(\d+))?\}`)
+
+// NumberSequence holds the last running number used for a company and
+// scope. It is incremented in the transaction that creates the employee.
+type NumberSequence struct {
+	CompanyID  int64  `gorm:"primaryKey;autoIncrement:false"`
+	Scope      string `gorm:"primaryKey;type:varchar(100)"`
+	LastNumber int64  `gorm:"not null;default:0"`
+}
+
+func (NumberSequence) TableName() string {
+	return "employee_number_sequences"
+}
+
+// ValidateNumberPattern checks the tokens of an employee number pattern.
+func ValidateNumberPattern(pattern string) error {
+	if strings.TrimSpace(pattern) == "" {
+		return errors.New("pattern is empty")
+	}
+
+	sequences := 0
+	for _, m := range numberToken.FindAllStringSubmatch(pattern, -1) {
+		switch m[1] {
+		case "COMPANY", "YYYY", "YY", "MM":
+			if m[2] != "" {
+				return fmt.Errorf("token {%s} takes no width", m[1])
+			}
+		case "SEQ":
+			if m[2] != "" {
+				if w, _ := strconv.Atoi(m[2]); w < 1 || w > 10 {
+					return errors.New("sequence width must be between 1 and 10")
+				}
+			}
+			sequences++
+		default:
+			return fmt.Errorf("unknown token {%s}", m[1])
+		}
+	}
+	if sequences != 1 {
+		return errors.New("pattern must contain exactly one {SEQ} token")
+	}
+	return nil
+}
+
+// NumberScope renders every token of pattern except the sequence. The
+// result identifies the sequence the next number is drawn from.
+func NumberScope(pattern, companyCode string, at time.Time) string {
+	return numberToken.ReplaceAllStringFunc(pattern, func(tok string) string {
+		switch numberToken.FindStringSubmatch(tok)[1] {
+		case "COMPANY":
+			return companyCode
+		case "YYYY":
+			return at.Format("2006")
+		case "YY":
+			return at.Format("06")
+		case "MM":
+			return at.Format("01")
+		}
+		return tok
+	})
+}
+
+// FormatNumber fills the sequence token of a scope with n.
+func FormatNumber(scope string, n int64) string {
+	return numberToken.ReplaceAllStringFunc(scope, func(tok string) string {
+		m := numberToken.FindStringSubmatch(tok)
+		if m[1] != "SEQ" {
+			return tok
+		}
+		width, _ := strconv.Atoi(m[2])
+		return fmt.Sprintf("%0*d", width, n)
+	})
+}
@@ -0,0 +1,36 @@
+package employee
+
+import "time"
+
+// Period is the span of a position or work location assignment. Both
+// dates are inclusive; EndDate is nil while the assignment is open.
+type Period struct {
+	StartDate time.Time  `gorm:"type:date;not null"`
+	EndDate   *time.Time `gorm:"type:date"`
+}
+
+// ActiveOn reports whether the period covers date.
+func (p *Period) ActiveOn(date time.Time) bool {
+	return !p.StartDate.After(date) && (p.EndDate == nil || !p.EndDate.Before(date))
+}
+
+// Overlaps reports whether the period shares a day with o.
+func (p *Period) Overlaps(o *Period) bool {
+	if o.EndDate != nil && p.StartDate.After(*o.EndDate) {
+		return false
+	}
+	if p.EndDate != nil && p.EndDate.Before(o.StartDate) {
+		return false
+	}
+	return true
+}
+
+// Dated is an assignment held for a Period. An employee holds a target,
+// the position or address assigned, at most once on any date, and at most
+// one primary assignment of a kind.
+type Dated interface {
+	Key() int64
+	Target() int64
+	Primary() bool
+	Dates() *Period
+}
@@ -0,0 +1,41 @@
+package employee
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ChangeTypeAssignment = "ASSIGNMENT"
+	ChangeTypePromotion  = "PROMOTION"
+	ChangeTypeTransfer   = "TRANSFER"
+	ChangeTypeDemotion   = "DEMOTION"
+)
+
+// Position assigns an employee to an organization position for a period.
+// An employee has at most one primary assignment on any date and never
+// holds the same position twice on one date. ChangeType records how the
+// assignment began.
+type Position struct {
+	ID         int64   `gorm:"primaryKey;autoIncrement:false"`
+	EmployeeID int64   `gorm:"not null;index"`
+	PositionID int64   `gorm:"not null;index"`
+	IsPrimary  bool    `gorm:"not null;default:false"`
+	ChangeType string  `gorm:"type:varchar(20);not null;default:'ASSIGNMENT'"`
+	Reason     *string `gorm:"type:varchar(500)"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeletedAt  gorm.DeletedAt `gorm:"index"`
+
+	Period
+}
+
+func (Position) TableName() string {
+	return "employee_positions"
+}
+
+func (p *Position) Key() int64     { return p.ID }
+func (p *Position) Target() int64  { return p.PositionID }
+func (p *Position) Primary() bool  { return p.IsPrimary }
+func (p *Position) Dates() *Period { return &p.Period }
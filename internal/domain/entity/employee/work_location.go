@@ -0,0 +1,32 @@
+package employee
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// WorkLocation assigns an employee to one of the company's addresses for a
+// period. An employee has at most one primary location on any date and is
+// never assigned to the same address twice on one date.
+type WorkLocation struct {
+	ID               int64   `gorm:"primaryKey;autoIncrement:false"`
+	EmployeeID       int64   `gorm:"not null;index"`
+	CompanyAddressID int64   `gorm:"not null;index"`
+	IsPrimary        bool    `gorm:"not null;default:false"`
+	Reason           *string `gorm:"type:varchar(500)"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
+
+	Period
+}
+
+func (WorkLocation) TableName() string {
+	return "employee_work_locations"
+}
+
+func (w *WorkLocation) Key() int64     { return w.ID }
+func (w *WorkLocation) Target() int64  { return w.CompanyAddressID }
+func (w *WorkLocation) Primary() bool  { return w.IsPrimary }
+func (w *WorkLocation) Dates() *Period { return &w.Period }
@@ -0,0 +1,74 @@
+package leave
+
+import "time"
+
+// Balance is an employee's entitlement to one leave type in one year.
+// Entitled is the year's entitlement, prorated for employees hired during
+// the year; under monthly accrual it is earned a twelfth at a time.
+// CarriedOver days come from the previous year and are spent first;
+// whatever is left of them lapses after CarryOverExpiresOn. Adjustment
+// holds HR corrections. Pending counts the days of requests awaiting
+// approval, Used those of approved ones.
+type Balance struct {
+	ID                 int64      `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID          int64      `gorm:"not null;index"`
+	EmployeeID         int64      `gorm:"not null;uniqueIndex:idx_leave_balances_employee_type_year"`
+	LeaveTypeID        int64      `gorm:"not null;uniqueIndex:idx_leave_balances_employee_type_year"`
+	Year               int        `gorm:"not null;uniqueIndex:idx_leave_balances_employee_type_year"`
+	Entitled           int        `gorm:"not null;default:0"`
+	AccrualStart       time.Time  `gorm:"type:date;not null"`
+	CarriedOver        int        `gorm:"not null;default:0"`
+	CarryOverUsed      int        `gorm:"not null;default:0"`
+	CarryOverExpiresOn *time.Time `gorm:"type:date"`
+	Adjustment         int        `gorm:"not null;default:0"`
+	Used               int        `gorm:"not null;default:0"`
+	Pending            int        `gorm:"not null;default:0"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+func (Balance) TableName() string {
+	return "leave_balances"
+}
+
+// Accrued is the part of the entitlement earned by date: all of it under
+// annual accrual, a twelfth of the full-year entitlement per month started
+// since AccrualStart under monthly accrual.
+func (b *Balance) Accrued(accrual string, annualDays int, date time.Time) int {
+	if accrual != AccrualMonthly {
+		return b.Entitled
+	}
+	if date.Before(b.AccrualStart) {
+		return 0
+	}
+	months := int(date.Month()-b.AccrualStart.Month()) + 1
+	if date.Year() > b.Year {
+		months = int(12-b.AccrualStart.Month()) + 1
+	}
+	earned := annualDays * months / 12
+	if earned > b.Entitled {
+		earned = b.Entitled
+	}
+	return earned
+}
+
+// Expired counts the carried-over days that lapsed unused by date.
+func (b *Balance) Expired(date time.Time) int {
+	if b.CarryOverExpiresOn == nil || !date.After(*b.CarryOverExpiresOn) {
+		return 0
+	}
+	return b.CarriedOver - b.CarryOverUsed
+}
+
+// CarryOverLeft counts the carried-over days still available on date.
+func (b *Balance) CarryOverLeft(date time.Time) int {
+	if b.CarryOverExpiresOn != nil && date.After(*b.CarryOverExpiresOn) {
+		return 0
+	}
+	return b.CarriedOver - b.CarryOverUsed
+}
+
+// Available counts the days that can still be requested on date.
+func (b *Balance) Available(accrual string, annualDays int, date time.Time) int {
+	return b.Accrued(accrual, annualDays, date) + b.CarriedOver - b.Expired(date) + b.Adjustment - b.Used - b.Pending
+}
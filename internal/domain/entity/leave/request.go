@@ -0,0 +1,83 @@
+package leave
+
+import "time"
+
+const (
+	RequestStatusPending   = "PENDING"
+	RequestStatusApproved  = "APPROVED"
+	RequestStatusRejected  = "REJECTED"
+	RequestStatusCancelled = "CANCELLED"
+
+	ApprovalStatusPending  = "PENDING"
+	ApprovalStatusApproved = "APPROVED"
+	ApprovalStatusRejected = "REJECTED"
+)
+
+// Request is a leave request. Days counts its working days, weekends and
+// public holidays left out; CarryOverDays of them come from the days
+// carried over from the previous year.
+//
+// Approvals is the chain the request goes through in Level order: the
+// head of the employee's department, then the head of its division. An
+// empty chain leaves the decision to the company's owners and admins, who
+// can also decide any step themselves. StatusApplied marks requests that
+// put the employee ON_LEAVE, so that only those set them back to ACTIVE.
+type Request struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID     int64     `gorm:"not null;index"`
+	EmployeeID    int64     `gorm:"not null;index"`
+	LeaveTypeID   int64     `gorm:"not null;index"`
+	BalanceID     *int64    `gorm:"index"`
+	StartDate     time.Time `gorm:"type:date;not null"`
+	EndDate       time.Time `gorm:"type:date;not null"`
+	Days          int       `gorm:"not null"`
+	CarryOverDays int       `gorm:"not null;default:0"`
+	Reason        *string   `gorm:"type:varchar(500)"`
+	Status        string    `gorm:"type:varchar(20);not null;default:'PENDING';index"`
+	RequestedBy   int64     `gorm:"not null"`
+	DecidedAt     *time.Time
+	DecisionNote  *string    `gorm:"type:varchar(500)"`
+	StatusApplied bool       `gorm:"not null;default:false"`
+	Approvals     []Approval `gorm:"foreignKey:RequestID"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (Request) TableName() string {
+	return "leave_requests"
+}
+
+// ActiveOn reports whether the request covers date.
+func (r *Request) ActiveOn(date time.Time) bool {
+	return !date.Before(r.StartDate) && !date.After(r.EndDate)
+}
+
+// CurrentStep returns the first approval still pending, nil when the chain
+// is empty or done.
+func (r *Request) CurrentStep() *Approval {
+	for i := range r.Approvals {
+		if r.Approvals[i].Status == ApprovalStatusPending {
+			return &r.Approvals[i]
+		}
+	}
+	return nil
+}
+
+// Approval is one step of a request's approval chain. DecidedBy is the
+// user who decided it, the approver or an owner or admin on their behalf.
+type Approval struct {
+	ID         int64  `gorm:"primaryKey;autoIncrement:false"`
+	RequestID  int64  `gorm:"not null;index"`
+	Level      int    `gorm:"not null"`
+	ApproverID int64  `gorm:"not null;index"`
+	Status     string `gorm:"type:varchar(20);not null;default:'PENDING'"`
+	DecidedBy  *int64
+	DecidedAt  *time.Time
+	Note       *string `gorm:"type:varchar(500)"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (Approval) TableName() string {
+	return "leave_approvals"
+}
@@ -0,0 +1,55 @@
+package leave
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// AccrualAnnual grants the year's entitlement on its first day.
+	AccrualAnnual = "ANNUAL"
+	// AccrualMonthly grants a twelfth of it at the start of each month.
+	AccrualMonthly = "MONTHLY"
+	// AccrualNone keeps no balance; the days taken are only counted.
+	AccrualNone = "NONE"
+
+	// MaxDaysSetting is the company setting holding the default annual
+	// entitlement of accruing types without their own.
+	MaxDaysSetting = "hr.leave.max_days"
+)
+
+// Type is a kind of leave a company grants, such as annual or sick leave.
+// Codes are unique per company among rows that are not deleted.
+//
+// AnnualDays is the yearly entitlement, the company's hr.leave.max_days
+// when nil. Up to MaxCarryOverDays unused days move into the next year
+// and lapse at the end of its CarryOverMonths-th month; 0 keeps them for
+// the whole year.
+type Type struct {
+	ID                int64   `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID         int64   `gorm:"not null;uniqueIndex:idx_leave_types_company_code,where:deleted_at IS NULL"`
+	Code              string  `gorm:"type:varchar(50);not null;uniqueIndex:idx_leave_types_company_code,where:deleted_at IS NULL"`
+	Name              string  `gorm:"type:varchar(255);not null"`
+	Description       *string `gorm:"type:text"`
+	IsPaid            bool    `gorm:"not null;default:true"`
+	Accrual           string  `gorm:"type:varchar(20);not null;default:'ANNUAL'"`
+	AnnualDays        *int
+	MaxCarryOverDays  int `gorm:"not null;default:0"`
+	CarryOverMonths   int `gorm:"not null;default:0"`
+	MaxDaysPerRequest *int
+	RequiresApproval  bool `gorm:"not null;default:true"`
+	IsActive          bool `gorm:"not null;default:true"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         gorm.DeletedAt `gorm:"index"`
+}
+
+func (Type) TableName() string {
+	return "leave_types"
+}
+
+// HasBalance reports whether requests of the type draw on a balance.
+func (t *Type) HasBalance() bool {
+	return t.Accrual != AccrualNone
+}
@@ -0,0 +1,25 @@
+package notification
+
+import "time"
+
+// Notification is an in-app message for one user. Data holds a JSON object
+// of string values.
+type Notification struct {
+	ID        int64   `gorm:"primaryKey;autoIncrement:false"`
+	UserID    int64   `gorm:"not null;index:idx_notifications_user_created"`
+	CompanyID *int64  `gorm:"index"`
+	Type      string  `gorm:"type:varchar(50);not null"`
+	Title     string  `gorm:"type:varchar(255);not null"`
+	Body      string  `gorm:"type:text;not null"`
+	Data      *string `gorm:"type:jsonb"`
+	ReadAt    *time.Time
+	CreatedAt time.Time `gorm:"not null;index:idx_notifications_user_created"`
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
@@ -0,0 +1,27 @@
+package organization
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Department optionally belongs to a division. Codes are unique per company
+// among rows that are not deleted.
+type Department struct {
+	ID             int64   `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID      int64   `gorm:"not null;uniqueIndex:idx_departments_company_code,where:deleted_at IS NULL"`
+	DivisionID     *int64  `gorm:"index"`
+	Name           string  `gorm:"type:varchar(255);not null"`
+	Code           string  `gorm:"type:varchar(50);not null;uniqueIndex:idx_departments_company_code,where:deleted_at IS NULL"`
+	Description    *string `gorm:"type:text"`
+	HeadEmployeeID *int64  `gorm:"index"`
+	IsActive       bool    `gorm:"not null;default:true"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      gorm.DeletedAt `gorm:"index"`
+}
+
+func (Department) TableName() string {
+	return "departments"
+}
@@ -0,0 +1,26 @@
+package organization
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Division is the top level of a company's structure. Codes are unique per
+// company among rows that are not deleted.
+type Division struct {
+	ID             int64   `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID      int64   `gorm:"not null;uniqueIndex:idx_divisions_company_code,where:deleted_at IS NULL"`
+	Name           string  `gorm:"type:varchar(255);not null"`
+	Code           string  `gorm:"type:varchar(50);not null;uniqueIndex:idx_divisions_company_code,where:deleted_at IS NULL"`
+	Description    *string `gorm:"type:text"`
+	HeadEmployeeID *int64  `gorm:"index"`
+	IsActive       bool    `gorm:"not null;default:true"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      gorm.DeletedAt `gorm:"index"`
+}
+
+func (Division) TableName() string {
+	return "divisions"
+}
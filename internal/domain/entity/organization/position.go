@@ -0,0 +1,41 @@
+package organization
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	LevelJunior   = "JUNIOR"
+	LevelMid      = "MID"
+	LevelSenior   = "SENIOR"
+	LevelLead     = "LEAD"
+	LevelManager  = "MANAGER"
+	LevelDirector = "DIRECTOR"
+)
+
+// Position is a role within a department. Codes are unique per company
+// among rows that are not deleted.
+type Position struct {
+	ID           int64   `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID    int64   `gorm:"not null;uniqueIndex:idx_positions_company_code,where:deleted_at IS NULL"`
+	DepartmentID int64   `gorm:"not null;index"`
+	Title        string  `gorm:"type:varchar(255);not null"`
+	Code         string  `gorm:"type:varchar(50);not null;uniqueIndex:idx_positions_company_code,where:deleted_at IS NULL"`
+	Level        string  `gorm:"type:varchar(20);not null"`
+	Description  *string `gorm:"type:text"`
+	IsActive     bool    `gorm:"not null;default:true"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
+}
+
+func (Position) TableName() string {
+	return "positions"
+}
+
+// Levels lists position levels from most junior to most senior.
+func Levels() []string {
+	return []string{LevelJunior, LevelMid, LevelSenior, LevelLead, LevelManager, LevelDirector}
+}
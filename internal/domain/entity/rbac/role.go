@@ -0,0 +1,39 @@
+package rbac
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	RoleOwner    = "OWNER"
+	RoleAdmin    = "ADMIN"
+	RoleManager  = "MANAGER"
+	RoleEmployee = "EMPLOYEE"
+)
+
+type Role struct {
+	ID        int64  `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID *int64 `gorm:"index"`
+	Name      string `gorm:"type:varchar(100);not null"`
+	Code      string `gorm:"type:varchar(50);not null"`
+	IsSystem  bool   `gorm:"not null;default:false"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (Role) TableName() string {
+	return "roles"
+}
+
+// SystemRoles returns the system-wide roles shared by every company.
+func SystemRoles() []Role {
+	return []Role{
+		{Name: "Owner", Code: RoleOwner, IsSystem: true},
+		{Name: "Admin", Code: RoleAdmin, IsSystem: true},
+		{Name: "Manager", Code: RoleManager, IsSystem: true},
+		{Name: "Employee", Code: RoleEmployee, IsSystem: true},
+	}
+}
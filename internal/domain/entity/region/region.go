@@ -0,0 +1,100 @@
+package region
+
+import "strings"
+
+// Regions follow the Kemendagri administrative codes. full_code joins the
+// codes of every level with dots: province "31", city "31.71", district
+// "31.71.10", village "31.71.10.1001".
+
+const (
+	CityTypeKota      = "Kota"
+	CityTypeKabupaten = "Kabupaten"
+)
+
+type Province struct {
+	ID   int    `gorm:"primaryKey"`
+	Name string `gorm:"type:varchar(100);not null"`
+	Code string `gorm:"uniqueIndex;type:varchar(2);not null"`
+}
+
+func (Province) TableName() string {
+	return "provinces"
+}
+
+type City struct {
+	ID         int       `gorm:"primaryKey"`
+	Type       string    `gorm:"type:varchar(20);not null"`
+	Name       string    `gorm:"type:varchar(100);not null"`
+	Code       string    `gorm:"type:varchar(2);not null"`
+	FullCode   string    `gorm:"uniqueIndex;type:varchar(5);not null"`
+	ProvinceID int       `gorm:"not null;index"`
+	Province   *Province `gorm:"foreignKey:ProvinceID"`
+}
+
+func (City) TableName() string {
+	return "cities"
+}
+
+type District struct {
+	ID       int    `gorm:"primaryKey"`
+	Name     string `gorm:"type:varchar(100);not null"`
+	Code     string `gorm:"type:varchar(2);not null"`
+	FullCode string `gorm:"uniqueIndex;type:varchar(8);not null"`
+	CityID   int    `gorm:"not null;index"`
+	City     *City  `gorm:"foreignKey:CityID"`
+}
+
+func (District) TableName() string {
+	return "districts"
+}
+
+type Village struct {
+	ID         int       `gorm:"primaryKey"`
+	Name       string    `gorm:"type:varchar(100);not null;index"`
+	Code       string    `gorm:"type:varchar(4);not null"`
+	FullCode   string    `gorm:"uniqueIndex;type:varchar(13);not null"`
+	PosCode    *string   `gorm:"type:varchar(5);index"`
+	DistrictID int       `gorm:"not null;index"`
+	District   *District `gorm:"foreignKey:DistrictID"`
+}
+
+func (Village) TableName() string {
+	return "villages"
+}
+
+// NormalizeCode accepts a region code with or without dots ("3171101001" or
+// "31.71.10.1001") and returns the dotted full_code, or "" when the code
+// does not have the length of any level.
+func NormalizeCode(code string) string {
+	digits := strings.ReplaceAll(strings.TrimSpace(code), ".", "")
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return ""
+		}
+	}
+	switch len(digits) {
+	case 2:
+		return digits
+	case 4:
+		return digits[:2] + "." + digits[2:]
+	case 6:
+		return digits[:2] + "." + digits[2:4] + "." + digits[4:]
+	case 10:
+		return digits[:2] + "." + digits[2:4] + "." + digits[4:6] + "." + digits[6:]
+	}
+	return ""
+}
+
+// ParentCode returns the full_code of the level above, e.g. "31.71" for
+// "31.71.10".
+func ParentCode(fullCode string) string {
+	if i := strings.LastIndex(fullCode, "."); i > 0 {
+		return fullCode[:i]
+	}
+	return ""
+}
+
+// LocalCode returns the last segment of a full_code.
+func LocalCode(fullCode string) string {
+	return fullCode[strings.LastIndex(fullCode, ".")+1:]
+}
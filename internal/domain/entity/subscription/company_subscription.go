@@ -0,0 +1,88 @@
+package subscription
+
+import "time"
+
+const (
+	StatusTrial     = "TRIAL"
+	StatusActive    = "ACTIVE"
+	StatusPastDue   = "PAST_DUE"
+	StatusCancelled = "CANCELLED"
+	StatusExpired   = "EXPIRED"
+
+	BillingCycleMonthly = "MONTHLY"
+	BillingCycleYearly  = "YEARLY"
+
+	TrialReminderBefore = 3 * 24 * time.Hour
+
+	NoticeTrialEnding = "TRIAL_ENDING"
+	NoticeTrialEnded  = "TRIAL_ENDED"
+	NoticeRenewed     = "RENEWED"
+	NoticePlanChanged = "PLAN_CHANGED"
+	NoticeCancelled   = "CANCELLED"
+	NoticeExpired     = "EXPIRED"
+)
+
+type CompanySubscription struct {
+	ID                  int64  `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID           int64  `gorm:"not null;index"`
+	PlanID              int64  `gorm:"not null;index"`
+	Status              string `gorm:"type:varchar(20);not null;index"`
+	BillingCycle        string `gorm:"type:varchar(10);not null;default:'MONTHLY'"`
+	TrialEndsAt         *time.Time
+	CurrentPeriodStart  time.Time `gorm:"not null"`
+	CurrentPeriodEnd    time.Time `gorm:"not null;index"`
+	CancelledAt         *time.Time
+	PendingPlanID       *int64
+	PendingBillingCycle *string `gorm:"type:varchar(10)"`
+	TrialReminderSentAt *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+func (CompanySubscription) TableName() string {
+	return "company_subscriptions"
+}
+
+// IsUsable reports whether the company may still use its plan: trials,
+// paid periods, unpaid periods in grace, and cancellations that have not yet
+// reached the end of the paid period.
+func (s *CompanySubscription) IsUsable(now time.Time) bool {
+	switch s.Status {
+	case StatusTrial, StatusActive, StatusPastDue:
+		return true
+	case StatusCancelled:
+		return now.Before(s.CurrentPeriodEnd)
+	}
+	return false
+}
+
+// NextPeriodEnd returns the end of a billing period starting at start.
+func NextPeriodEnd(start time.Time, billingCycle string) time.Time {
+	if billingCycle == BillingCycleYearly {
+		return start.AddDate(1, 0, 0)
+	}
+	return start.AddDate(0, 1, 0)
+}
+
+// SubscriptionChange records a plan or billing cycle change together with
+// the proration computed for it. Scheduled changes (downgrades) take effect
+// at the end of the current period.
+type SubscriptionChange struct {
+	ID               int64     `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID        int64     `gorm:"not null;index"`
+	SubscriptionID   int64     `gorm:"not null;index"`
+	FromPlanID       int64     `gorm:"not null"`
+	ToPlanID         int64     `gorm:"not null"`
+	FromBillingCycle string    `gorm:"type:varchar(10);not null"`
+	ToBillingCycle   string    `gorm:"type:varchar(10);not null"`
+	ProrationCredit  int64     `gorm:"not null;default:0"`
+	ProrationCharge  int64     `gorm:"not null;default:0"`
+	AmountDue        int64     `gorm:"not null;default:0"`
+	IsScheduled      bool      `gorm:"not null;default:false"`
+	EffectiveAt      time.Time `gorm:"not null"`
+	CreatedAt        time.Time
+}
+
+func (SubscriptionChange) TableName() string {
+	return "subscription_changes"
+}
@@ -0,0 +1,19 @@
+package subscription
+
+import "time"
+
+// BytesPerGB converts plan storage limits, given in GB, to bytes.
+const BytesPerGB int64 = 1 << 30
+
+// CompanyUsage keeps running totals that are too costly to count on demand.
+// Members and employees are counted directly under a company row lock;
+// stored bytes are tracked here and changed with a single conditional update.
+type CompanyUsage struct {
+	CompanyID    int64 `gorm:"primaryKey;autoIncrement:false"`
+	StorageBytes int64 `gorm:"not null;default:0"`
+	UpdatedAt    time.Time
+}
+
+func (CompanyUsage) TableName() string {
+	return "company_usages"
+}
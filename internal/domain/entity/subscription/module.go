@@ -0,0 +1,69 @@
+package subscription
+
+import "time"
+
+const (
+	ModuleHR        = "hr"
+	ModuleFinance   = "finance"
+	ModuleERP       = "erp"
+	ModuleInventory = "inventory"
+)
+
+type Module struct {
+	ID          int64   `gorm:"primaryKey;autoIncrement:false"`
+	Code        string  `gorm:"uniqueIndex;type:varchar(50);not null"`
+	Name        string  `gorm:"type:varchar(100);not null"`
+	Description *string `gorm:"type:text"`
+	IconKey     *string `gorm:"type:varchar(500)"`
+	IsActive    bool    `gorm:"not null;default:true"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (Module) TableName() string {
+	return "modules"
+}
+
+type PlanModule struct {
+	ID        int64 `gorm:"primaryKey;autoIncrement:false"`
+	PlanID    int64 `gorm:"not null;uniqueIndex:idx_plan_modules_plan_module"`
+	ModuleID  int64 `gorm:"not null;uniqueIndex:idx_plan_modules_plan_module;index"`
+	CreatedAt time.Time
+}
+
+func (PlanModule) TableName() string {
+	return "plan_modules"
+}
+
+// DefaultModules returns the modules seeded on a fresh database.
+func DefaultModules() []Module {
+	return []Module{
+		{Code: ModuleHR, Name: "Human Resources", IsActive: true},
+		{Code: ModuleFinance, Name: "Finance", IsActive: true},
+		{Code: ModuleERP, Name: "ERP", IsActive: true},
+		{Code: ModuleInventory, Name: "Inventory", IsActive: true},
+	}
+}
+
+// CompanyModule overrides the plan for a single company: an active,
+// unexpired row grants the module even when the plan lacks it, an inactive
+// row withholds it even when the plan includes it.
+type CompanyModule struct {
+	ID        int64 `gorm:"primaryKey;autoIncrement:false"`
+	CompanyID int64 `gorm:"not null;uniqueIndex:idx_company_modules_company_module"`
+	ModuleID  int64 `gorm:"not null;uniqueIndex:idx_company_modules_company_module;index"`
+	IsActive  bool  `gorm:"not null;default:true"`
+	EnabledAt *time.Time
+	ExpiresAt *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (CompanyModule) TableName() string {
+	return "company_modules"
+}
+
+// InEffect reports whether the override applies at now.
+func (cm *CompanyModule) InEffect(now time.Time) bool {
+	return cm.ExpiresAt == nil || now.Before(*cm.ExpiresAt)
+}
@@ -0,0 +1,83 @@
+package subscription
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	PlanStarter      = "STARTER"
+	PlanProfessional = "PROFESSIONAL"
+	PlanEnterprise   = "ENTERPRISE"
+)
+
+// Plan prices are whole amounts in the plan currency; IDR has no minor unit
+// in practice, so they are stored as integers.
+type Plan struct {
+	ID           int64  `gorm:"primaryKey;autoIncrement:false"`
+	Name         string `gorm:"type:varchar(100);not null"`
+	Code         string `gorm:"uniqueIndex;type:varchar(50);not null"`
+	Currency     string `gorm:"type:varchar(3);not null;default:'IDR'"`
+	PriceMonthly int64  `gorm:"not null;default:0"`
+	PriceYearly  int64  `gorm:"not null;default:0"`
+	MaxUsers     int    `gorm:"not null;default:0"`
+	MaxEmployees int    `gorm:"not null;default:0"`
+	MaxStorageGB int    `gorm:"not null;default:0"`
+	IsPublic     bool   `gorm:"not null;default:true"`
+	IsActive     bool   `gorm:"not null;default:true"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
+}
+
+func (Plan) TableName() string {
+	return "subscription_plans"
+}
+
+// Price returns the plan price for a billing cycle.
+func (p *Plan) Price(billingCycle string) int64 {
+	if billingCycle == BillingCycleYearly {
+		return p.PriceYearly
+	}
+	return p.PriceMonthly
+}
+
+// PlanSeed is a default plan together with the module codes it includes.
+type PlanSeed struct {
+	Plan    Plan
+	Modules []string
+}
+
+// DefaultPlans returns the plans seeded on a fresh database.
+func DefaultPlans() []PlanSeed {
+	return []PlanSeed{
+		{
+			Plan: Plan{
+				Name: "Starter", Code: PlanStarter, Currency: "IDR",
+				PriceMonthly: 149000, PriceYearly: 1490000,
+				MaxUsers: 10, MaxEmployees: 25, MaxStorageGB: 5,
+				IsPublic: true, IsActive: true,
+			},
+			Modules: []string{ModuleHR},
+		},
+		{
+			Plan: Plan{
+				Name: "Professional", Code: PlanProfessional, Currency: "IDR",
+				PriceMonthly: 499000, PriceYearly: 4990000,
+				MaxUsers: 50, MaxEmployees: 200, MaxStorageGB: 50,
+				IsPublic: true, IsActive: true,
+			},
+			Modules: []string{ModuleHR, ModuleFinance},
+		},
+		{
+			Plan: Plan{
+				Name: "Enterprise", Code: PlanEnterprise, Currency: "IDR",
+				PriceMonthly: 1499000, PriceYearly: 14990000,
+				MaxUsers: 0, MaxEmployees: 0, MaxStorageGB: 500,
+				IsPublic: true, IsActive: true,
+			},
+			Modules: []string{ModuleHR, ModuleFinance, ModuleERP, ModuleInventory},
+		},
+	}
+}
@@ -21,7 +21,7 @@ type User struct {
 	GoogleID        *string `gorm:"uniqueIndex;type:varchar(255)"`
 	Password        *string `gorm:"type:varchar(255)"`
 	Name            string  `gorm:"type:varchar(255);not null"`
-	Phone           *string `gorm:"type:varchar(50)"`
+	Phone           *string `gorm:"type:text;serializer:encrypted"`
 	Gender          *string `gorm:"type:varchar(10)"`
 	AvatarKey       *string `gorm:"type:varchar(500)"`
 	Status          string  `gorm:"type:varchar(30);not null;default:'PENDING_VERIFICATION'"`
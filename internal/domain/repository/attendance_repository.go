@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/attendance"
+)
+
+type ShiftRepository interface {
+	Create(ctx context.Context, s *attendance.Shift) error
+	FindByID(ctx context.Context, companyID, id int64) (*attendance.Shift, error)
+	FindByCode(ctx context.Context, companyID int64, code string) (*attendance.Shift, error)
+	// FindDefault returns "shift not found" when the company has no
+	// default shift.
+	FindDefault(ctx context.Context, companyID int64) (*attendance.Shift, error)
+	ListByCompany(ctx context.Context, companyID int64, activeOnly bool) ([]attendance.Shift, error)
+	Update(ctx context.Context, s *attendance.Shift) error
+	// ClearDefault unsets is_default on every shift of the company.
+	ClearDefault(ctx context.Context, companyID int64) error
+	Delete(ctx context.Context, s *attendance.Shift) error
+	// CountReferences counts the assignments and attendance records that
+	// point at a shift.
+	CountReferences(ctx context.Context, id int64) (int64, error)
+}
+
+type ShiftAssignmentRepository interface {
+	Create(ctx context.Context, a *attendance.ShiftAssignment) error
+	// FindByID returns "shift assignment not found" unless the assignment
+	// belongs to employeeID.
+	FindByID(ctx context.Context, employeeID, id int64) (*attendance.ShiftAssignment, error)
+	// FindByStart returns the employee's assignment starting on date,
+	// "shift assignment not found" when there is none.
+	FindByStart(ctx context.Context, employeeID int64, date time.Time) (*attendance.ShiftAssignment, error)
+	// FindOn returns the assignment in force on date, the latest starting
+	// on or before it.
+	FindOn(ctx context.Context, employeeID int64, date time.Time) (*attendance.ShiftAssignment, error)
+	// ListByEmployee returns an employee's assignments, latest start date
+	// first.
+	ListByEmployee(ctx context.Context, employeeID int64) ([]attendance.ShiftAssignment, error)
+	Update(ctx context.Context, a *attendance.ShiftAssignment) error
+	Delete(ctx context.Context, a *attendance.ShiftAssignment) error
+}
+
+// AttendanceRecordFilter narrows records to one company. Zero fields do
+// not filter; From and To bound the record date, both inclusive.
+type AttendanceRecordFilter struct {
+	CompanyID  int64
+	EmployeeID int64
+	From       *time.Time
+	To         *time.Time
+}
+
+type AttendanceRecordRepository interface {
+	Create(ctx context.Context, r *attendance.Record) error
+	FindByID(ctx context.Context, companyID, id int64) (*attendance.Record, error)
+	// FindByDate returns the employee's record of a day, "attendance
+	// record not found" when there is none.
+	FindByDate(ctx context.Context, employeeID int64, date time.Time) (*attendance.Record, error)
+	// FindOpen returns the employee's latest record clocked in since and
+	// not clocked out.
+	FindOpen(ctx context.Context, employeeID int64, since time.Time) (*attendance.Record, error)
+	// List returns a page of matching records, latest date first, and the
+	// total number of matches.
+	List(ctx context.Context, f AttendanceRecordFilter, offset, limit int) ([]attendance.Record, int64, error)
+	// ListByDate returns the company's records of a day.
+	ListByDate(ctx context.Context, companyID int64, date time.Time) ([]attendance.Record, error)
+	Update(ctx context.Context, r *attendance.Record) error
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/audit"
+)
+
+// AuditLogFilter narrows audit log queries to one company. Zero fields do
+// not filter.
+type AuditLogFilter struct {
+	CompanyID int64
+	UserID    int64
+	Module    string
+	Entity    string
+	EntityID  int64
+	Action    string
+	RequestID string
+	From      *time.Time
+	To        *time.Time
+}
+
+type AuditLogRepository interface {
+	// Create inserts l unless a log with its ID exists, so retried writes
+	// do not duplicate entries.
+	Create(ctx context.Context, l *audit.AuditLog) error
+	// List returns a page of matching logs, newest first, and the total
+	// number of matches.
+	List(ctx context.Context, f AuditLogFilter, offset, limit int) ([]audit.AuditLog, int64, error)
+	// Each calls fn with successive batches of matching logs, newest first.
+	Each(ctx context.Context, f AuditLogFilter, fn func([]audit.AuditLog) error) error
+}
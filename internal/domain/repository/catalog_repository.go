@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/company"
+)
+
+type IndustryRepository interface {
+	Create(ctx context.Context, i *company.Industry) error
+	FindByID(ctx context.Context, id int64) (*company.Industry, error)
+	FindByCode(ctx context.Context, code string) (*company.Industry, error)
+	FindByName(ctx context.Context, name string) (*company.Industry, error)
+	List(ctx context.Context, activeOnly bool) ([]company.Industry, error)
+	Update(ctx context.Context, i *company.Industry) error
+	Ensure(ctx context.Context, i *company.Industry) error
+}
+
+type CompanyTypeRepository interface {
+	Create(ctx context.Context, t *company.CompanyType) error
+	FindByID(ctx context.Context, id int64) (*company.CompanyType, error)
+	FindByCode(ctx context.Context, code string) (*company.CompanyType, error)
+	FindByName(ctx context.Context, name string) (*company.CompanyType, error)
+	List(ctx context.Context, activeOnly bool) ([]company.CompanyType, error)
+	Update(ctx context.Context, t *company.CompanyType) error
+	Ensure(ctx context.Context, t *company.CompanyType) error
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/company"
+)
+
+type CompanyAddressRepository interface {
+	Create(ctx context.Context, a *company.Address) error
+	// FindByID loads the address with its regions.
+	FindByID(ctx context.Context, companyID, id int64) (*company.Address, error)
+	ListByCompany(ctx context.Context, companyID int64) ([]company.Address, error)
+	FindPrimary(ctx context.Context, companyID int64) (*company.Address, error)
+	CountByCompany(ctx context.Context, companyID int64) (int64, error)
+	Update(ctx context.Context, a *company.Address) error
+	// ClearPrimary unsets is_primary on every address of the company.
+	ClearPrimary(ctx context.Context, companyID int64) error
+	Delete(ctx context.Context, a *company.Address) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/subscription"
+)
+
+type CompanyModuleRepository interface {
+	FindByCompanyAndModule(ctx context.Context, companyID, moduleID int64) (*subscription.CompanyModule, error)
+	ListByCompany(ctx context.Context, companyID int64) ([]subscription.CompanyModule, error)
+	Save(ctx context.Context, cm *subscription.CompanyModule) error
+	Delete(ctx context.Context, companyID, moduleID int64) error
+}
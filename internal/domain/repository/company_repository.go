@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/company"
+)
+
+type CompanyRepository interface {
+	Create(ctx context.Context, c *company.Company) error
+	FindByID(ctx context.Context, id int64) (*company.Company, error)
+	// LockByID loads the company with a row lock held until the surrounding
+	// transaction ends, serialising limit checks for that company.
+	LockByID(ctx context.Context, id int64) (*company.Company, error)
+	FindByCode(ctx context.Context, code string) (*company.Company, error)
+	ListByUserID(ctx context.Context, userID int64) ([]company.Company, error)
+	Update(ctx context.Context, c *company.Company) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/company"
+)
+
+type CompanySettingRepository interface {
+	ListByCompany(ctx context.Context, companyID int64) ([]company.Setting, error)
+	// Upsert inserts s or replaces the value of the existing company/key row.
+	Upsert(ctx context.Context, s *company.Setting) error
+	Delete(ctx context.Context, companyID int64, key string) error
+}
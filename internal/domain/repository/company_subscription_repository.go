@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/subscription"
+)
+
+type CompanySubscriptionRepository interface {
+	Create(ctx context.Context, s *subscription.CompanySubscription) error
+	FindByID(ctx context.Context, id int64) (*subscription.CompanySubscription, error)
+	FindCurrentByCompany(ctx context.Context, companyID int64) (*subscription.CompanySubscription, error)
+	Update(ctx context.Context, s *subscription.CompanySubscription) error
+	ListPeriodEnded(ctx context.Context, now time.Time) ([]subscription.CompanySubscription, error)
+	ListTrialsEndingBefore(ctx context.Context, before time.Time) ([]subscription.CompanySubscription, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/subscription"
+)
+
+type CompanyUsageRepository interface {
+	// FindByCompany returns zero usage when the company has none recorded.
+	FindByCompany(ctx context.Context, companyID int64) (*subscription.CompanyUsage, error)
+	// AddStorage adds delta bytes, which may be negative, to the company's
+	// storage total. When limit is positive and the new total would exceed it
+	// nothing changes and "storage quota exceeded" is returned.
+	AddStorage(ctx context.Context, companyID, delta, limit int64) error
+}
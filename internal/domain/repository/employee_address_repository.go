@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+type EmployeeAddressRepository interface {
+	Create(ctx context.Context, a *employee.Address) error
+	// FindByID loads the address with its regions.
+	FindByID(ctx context.Context, employeeID, id int64) (*employee.Address, error)
+	// ListByEmployee returns the employee's addresses with their regions,
+	// by type with the primary of each type first.
+	ListByEmployee(ctx context.Context, employeeID int64) ([]employee.Address, error)
+	Update(ctx context.Context, a *employee.Address) error
+	Delete(ctx context.Context, a *employee.Address) error
+}
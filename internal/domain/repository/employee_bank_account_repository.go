@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+type EmployeeBankAccountRepository interface {
+	Create(ctx context.Context, a *employee.BankAccount) error
+	FindByID(ctx context.Context, employeeID, id int64) (*employee.BankAccount, error)
+	// ListByEmployee returns the employee's accounts, the primary first.
+	ListByEmployee(ctx context.Context, employeeID int64) ([]employee.BankAccount, error)
+	Update(ctx context.Context, a *employee.BankAccount) error
+	Delete(ctx context.Context, a *employee.BankAccount) error
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+// EmployeeDocumentFilter narrows document lists to one company. Zero fields
+// do not filter; ExpiringBefore keeps documents valid until that date or
+// earlier.
+type EmployeeDocumentFilter struct {
+	CompanyID      int64
+	EmployeeID     int64
+	Type           string
+	Status         string
+	ExpiringBefore *time.Time
+}
+
+type EmployeeDocumentRepository interface {
+	Create(ctx context.Context, d *employee.Document) error
+	FindByID(ctx context.Context, companyID, id int64) (*employee.Document, error)
+	// List returns a page of matching documents, newest first, and the
+	// total number of matches.
+	List(ctx context.Context, f EmployeeDocumentFilter, offset, limit int) ([]employee.Document, int64, error)
+	Update(ctx context.Context, d *employee.Document) error
+	Delete(ctx context.Context, d *employee.Document) error
+	// ListDueForReminder returns documents not yet reminded about that are
+	// valid until a date from from to to, skipping rejected documents and
+	// those of deleted or terminated employees.
+	ListDueForReminder(ctx context.Context, from, to time.Time) ([]employee.Document, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+type EmployeeEmergencyContactRepository interface {
+	Create(ctx context.Context, c *employee.EmergencyContact) error
+	FindByID(ctx context.Context, employeeID, id int64) (*employee.EmergencyContact, error)
+	// ListByEmployee returns the employee's contacts, the primary first.
+	ListByEmployee(ctx context.Context, employeeID int64) ([]employee.EmergencyContact, error)
+	Update(ctx context.Context, c *employee.EmergencyContact) error
+	Delete(ctx context.Context, c *employee.EmergencyContact) error
+}
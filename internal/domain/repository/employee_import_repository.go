@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+type EmployeeImportRepository interface {
+	Create(ctx context.Context, i *employee.Import) error
+	// FindByID returns "import not found" unless the import belongs to
+	// companyID.
+	FindByID(ctx context.Context, companyID, id int64) (*employee.Import, error)
+	// LockByID loads an import of any company for update.
+	LockByID(ctx context.Context, id int64) (*employee.Import, error)
+	// Claim moves a QUEUED import, or a RUNNING one last updated before
+	// staleBefore, to RUNNING in one conditional update and loads it. It
+	// reports whether this caller took the import.
+	Claim(ctx context.Context, id int64, staleBefore time.Time) (*employee.Import, bool, error)
+	Update(ctx context.Context, i *employee.Import) error
+}
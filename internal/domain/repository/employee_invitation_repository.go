@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+type EmployeeInvitationRepository interface {
+	Create(ctx context.Context, inv *employee.Invitation) error
+	FindByID(ctx context.Context, id int64) (*employee.Invitation, error)
+	FindByToken(ctx context.Context, token string) (*employee.Invitation, error)
+	FindPendingByCompanyAndEmail(ctx context.Context, companyID int64, email string) (*employee.Invitation, error)
+	ListByCompany(ctx context.Context, companyID int64, status string) ([]employee.Invitation, error)
+	Update(ctx context.Context, inv *employee.Invitation) error
+	ExpirePending(ctx context.Context, now time.Time) (int64, error)
+}
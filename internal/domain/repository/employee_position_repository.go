@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+type EmployeePositionRepository interface {
+	Create(ctx context.Context, p *employee.Position) error
+	// FindByID returns "employee position not found" unless the assignment
+	// belongs to employeeID.
+	FindByID(ctx context.Context, employeeID, id int64) (*employee.Position, error)
+	Update(ctx context.Context, p *employee.Position) error
+	Delete(ctx context.Context, p *employee.Position) error
+	// ListByEmployee returns an employee's assignments, oldest start date
+	// first.
+	ListByEmployee(ctx context.Context, employeeID int64) ([]employee.Position, error)
+	// ListByCompanyOn returns the assignments of the company's employees
+	// that cover date, ordered by employee.
+	ListByCompanyOn(ctx context.Context, companyID int64, date time.Time) ([]employee.Position, error)
+	// CountActiveByPosition counts current assignments to a position held
+	// by employees who are not terminated.
+	CountActiveByPosition(ctx context.Context, positionID int64) (int64, error)
+	// ListCurrentPrimaryByCompany returns the current primary assignment of
+	// each of the company's employees, oldest start date first.
+	ListCurrentPrimaryByCompany(ctx context.Context, companyID int64) ([]employee.Position, error)
+}
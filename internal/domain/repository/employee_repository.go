@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+// EmployeeFilter narrows employee lists to one company. Zero fields do not
+// filter; Search matches name, employee number or email. NationalID matches
+// exactly, through its blind index.
+type EmployeeFilter struct {
+	CompanyID        int64
+	Search           string
+	NationalID       *string
+	DivisionID       int64
+	DepartmentID     int64
+	EmploymentStatus string
+	EmploymentType   string
+}
+
+type EmployeeRepository interface {
+	Create(ctx context.Context, e *employee.Employee) error
+	FindByID(ctx context.Context, id int64) (*employee.Employee, error)
+	// LockByID loads the employee with a row lock held until the
+	// surrounding transaction ends, serialising changes to their
+	// assignments.
+	LockByID(ctx context.Context, id int64) (*employee.Employee, error)
+	// ListByIDs returns the employees with the given IDs, terminated ones
+	// included.
+	ListByIDs(ctx context.Context, ids []int64) ([]employee.Employee, error)
+	FindByCompanyAndEmail(ctx context.Context, companyID int64, email string) (*employee.Employee, error)
+	FindByCompanyAndUser(ctx context.Context, companyID, userID int64) (*employee.Employee, error)
+	// FindByCompanyAndNumber includes deleted employees, whose numbers are
+	// never reused.
+	FindByCompanyAndNumber(ctx context.Context, companyID int64, number string) (*employee.Employee, error)
+	// FindByCompanyAndNationalID looks the NIK up by its blind index.
+	FindByCompanyAndNationalID(ctx context.Context, companyID int64, nationalID string) (*employee.Employee, error)
+	// List returns a page of matching employees ordered by name and the
+	// total number of matches.
+	List(ctx context.Context, f EmployeeFilter, offset, limit int) ([]employee.Employee, int64, error)
+	Update(ctx context.Context, e *employee.Employee) error
+	Delete(ctx context.Context, e *employee.Employee) error
+	// NextNumber reserves the next running number of a company's number
+	// scope. The sequence row stays locked until the surrounding
+	// transaction ends.
+	NextNumber(ctx context.Context, companyID int64, scope string) (int64, error)
+	// CountActiveByCompany counts employees that are not terminated.
+	CountActiveByCompany(ctx context.Context, companyID int64) (int64, error)
+	// ListActiveByCompany returns the company's employees who are not
+	// terminated.
+	ListActiveByCompany(ctx context.Context, companyID int64) ([]employee.Employee, error)
+	// CountActiveByDivision and CountActiveByDepartment count employees
+	// assigned there who are not terminated.
+	CountActiveByDivision(ctx context.Context, divisionID int64) (int64, error)
+	CountActiveByDepartment(ctx context.Context, departmentID int64) (int64, error)
+}
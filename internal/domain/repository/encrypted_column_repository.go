@@ -0,0 +1,30 @@
+package repository
+
+import "context"
+
+// EncryptedColumn names a column written through the encrypted serializer
+// and, when it has one, the column holding its blind index.
+type EncryptedColumn struct {
+	Table       string
+	Column      string
+	IndexColumn string
+}
+
+// EncryptedValue is a stored value of an encrypted column, sealed or still
+// plaintext.
+type EncryptedValue struct {
+	ID    int64
+	Value string
+}
+
+// EncryptedColumnRepository reads and rewrites encrypted columns directly,
+// bypassing models and soft deletes, for key rotation.
+type EncryptedColumnRepository interface {
+	// ListStale returns up to limit non-empty values after afterID, by ID,
+	// that do not start with prefix.
+	ListStale(ctx context.Context, col EncryptedColumn, prefix string, afterID int64, limit int) ([]EncryptedValue, error)
+	// Rewrite replaces the value of row id, and its blind index when the
+	// column has one, unless the row changed since old was read. It reports
+	// whether the row was rewritten.
+	Rewrite(ctx context.Context, col EncryptedColumn, id int64, old, value string, index *string) (bool, error)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/billing"
+)
+
+type InvoiceRepository interface {
+	// Create inserts the invoice together with its items.
+	Create(ctx context.Context, inv *billing.Invoice) error
+	FindByID(ctx context.Context, id int64) (*billing.Invoice, error)
+	// LockByID loads the invoice, without items, locked for update until
+	// the surrounding transaction ends.
+	LockByID(ctx context.Context, id int64) (*billing.Invoice, error)
+	ListByCompany(ctx context.Context, companyID int64, status string) ([]billing.Invoice, error)
+	ListIssuedDueBefore(ctx context.Context, now time.Time) ([]billing.Invoice, error)
+	Update(ctx context.Context, inv *billing.Invoice) error
+	// UpdatePayment sets only the payment method and reference; nil clears
+	// them.
+	UpdatePayment(ctx context.Context, id int64, method, reference *string) error
+	// NextNumber reserves the next invoice number of year. The sequence row
+	// stays locked until the surrounding transaction ends.
+	NextNumber(ctx context.Context, year int) (int64, error)
+}
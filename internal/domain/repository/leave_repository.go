@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/leave"
+)
+
+type LeaveTypeRepository interface {
+	Create(ctx context.Context, t *leave.Type) error
+	FindByID(ctx context.Context, companyID, id int64) (*leave.Type, error)
+	FindByCode(ctx context.Context, companyID int64, code string) (*leave.Type, error)
+	ListByCompany(ctx context.Context, companyID int64, activeOnly bool) ([]leave.Type, error)
+	Update(ctx context.Context, t *leave.Type) error
+	Delete(ctx context.Context, t *leave.Type) error
+}
+
+type LeaveBalanceRepository interface {
+	Create(ctx context.Context, b *leave.Balance) error
+	// Find returns "leave balance not found" when the employee has no
+	// balance of the type for the year.
+	Find(ctx context.Context, employeeID, leaveTypeID int64, year int) (*leave.Balance, error)
+	// Lock is Find with a row lock held until the surrounding transaction
+	// ends.
+	Lock(ctx context.Context, employeeID, leaveTypeID int64, year int) (*leave.Balance, error)
+	LockByID(ctx context.Context, id int64) (*leave.Balance, error)
+	ListByEmployee(ctx context.Context, employeeID int64, year int) ([]leave.Balance, error)
+	Update(ctx context.Context, b *leave.Balance) error
+}
+
+type LeaveRequestFilter struct {
+	CompanyID   int64
+	EmployeeID  int64
+	LeaveTypeID int64
+	Status      string
+	// From and To keep requests overlapping the period when set.
+	From *time.Time
+	To   *time.Time
+}
+
+type LeaveRequestRepository interface {
+	// Create stores the request with its approval chain.
+	Create(ctx context.Context, r *leave.Request) error
+	// FindByID loads a request with its approvals. It returns "leave
+	// request not found" unless the request belongs to companyID.
+	FindByID(ctx context.Context, companyID, id int64) (*leave.Request, error)
+	// LockByID is FindByID with the request row locked until the
+	// surrounding transaction ends.
+	LockByID(ctx context.Context, companyID, id int64) (*leave.Request, error)
+	// Update saves the request itself; approvals change through
+	// UpdateApproval.
+	Update(ctx context.Context, r *leave.Request) error
+	UpdateApproval(ctx context.Context, a *leave.Approval) error
+	// SetStatusApplied changes only the request's StatusApplied mark, so
+	// the status sync cannot overwrite a decision made meanwhile.
+	SetStatusApplied(ctx context.Context, id int64, applied bool) error
+	// List returns matching requests with their approvals, latest start
+	// first.
+	List(ctx context.Context, f LeaveRequestFilter, offset, limit int) ([]leave.Request, int64, error)
+	// ListAwaiting returns the pending requests whose current step is
+	// approverID's, oldest first.
+	ListAwaiting(ctx context.Context, companyID, approverID int64) ([]leave.Request, error)
+	// ListOverlapping returns an employee's pending and approved requests
+	// overlapping start to end.
+	ListOverlapping(ctx context.Context, employeeID int64, start, end time.Time) ([]leave.Request, error)
+	// ListForStatusSync returns the approved requests overlapping from to
+	// to, and every request that has put its employee on leave, only those
+	// of one employee when employeeID is not 0.
+	ListForStatusSync(ctx context.Context, employeeID int64, from, to time.Time) ([]leave.Request, error)
+	// ListApprovedOn returns the company's approved requests covering
+	// date.
+	ListApprovedOn(ctx context.Context, companyID int64, date time.Time) ([]leave.Request, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/subscription"
+)
+
+type ModuleRepository interface {
+	List(ctx context.Context) ([]subscription.Module, error)
+	FindByID(ctx context.Context, id int64) (*subscription.Module, error)
+	FindByCode(ctx context.Context, code string) (*subscription.Module, error)
+	Update(ctx context.Context, m *subscription.Module) error
+	ListByPlanID(ctx context.Context, planID int64) ([]subscription.Module, error)
+	ReplacePlanModules(ctx context.Context, planID int64, pms []subscription.PlanModule) error
+	Ensure(ctx context.Context, m *subscription.Module) error
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/notification"
+)
+
+type NotificationRepository interface {
+	Create(ctx context.Context, n *notification.Notification) error
+	// ListByUser returns a page of the user's notifications, newest first,
+	// and the total number of matches.
+	ListByUser(ctx context.Context, userID int64, unreadOnly bool, offset, limit int) ([]notification.Notification, int64, error)
+	CountUnread(ctx context.Context, userID int64) (int64, error)
+	// MarkRead sets read_at on one notification of the user. Notifications
+	// already read keep their original time.
+	MarkRead(ctx context.Context, userID, id int64, at time.Time) error
+	// MarkAllRead marks every unread notification of the user and returns
+	// how many changed.
+	MarkAllRead(ctx context.Context, userID int64, at time.Time) (int64, error)
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/organization"
+)
+
+type DivisionRepository interface {
+	Create(ctx context.Context, d *organization.Division) error
+	FindByID(ctx context.Context, companyID, id int64) (*organization.Division, error)
+	FindByCode(ctx context.Context, companyID int64, code string) (*organization.Division, error)
+	ListByCompany(ctx context.Context, companyID int64, activeOnly bool) ([]organization.Division, error)
+	Update(ctx context.Context, d *organization.Division) error
+	Delete(ctx context.Context, d *organization.Division) error
+}
+
+type DepartmentRepository interface {
+	Create(ctx context.Context, d *organization.Department) error
+	FindByID(ctx context.Context, companyID, id int64) (*organization.Department, error)
+	FindByCode(ctx context.Context, companyID int64, code string) (*organization.Department, error)
+	// ListByCompany returns the company's departments, only those of one
+	// division when divisionID is not 0.
+	ListByCompany(ctx context.Context, companyID, divisionID int64, activeOnly bool) ([]organization.Department, error)
+	CountByDivision(ctx context.Context, divisionID int64) (int64, error)
+	Update(ctx context.Context, d *organization.Department) error
+	Delete(ctx context.Context, d *organization.Department) error
+}
+
+type PositionRepository interface {
+	Create(ctx context.Context, p *organization.Position) error
+	FindByID(ctx context.Context, companyID, id int64) (*organization.Position, error)
+	FindByCode(ctx context.Context, companyID int64, code string) (*organization.Position, error)
+	// ListByCompany returns the company's positions, only those of one
+	// department when departmentID is not 0.
+	ListByCompany(ctx context.Context, companyID, departmentID int64, activeOnly bool) ([]organization.Position, error)
+	CountByDepartment(ctx context.Context, departmentID int64) (int64, error)
+	Update(ctx context.Context, p *organization.Position) error
+	Delete(ctx context.Context, p *organization.Position) error
+}
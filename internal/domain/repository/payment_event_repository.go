@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/billing"
+)
+
+type PaymentEventRepository interface {
+	// CreateIfAbsent inserts the event and reports false when an event with
+	// the same provider and event ID was already stored.
+	CreateIfAbsent(ctx context.Context, e *billing.PaymentEvent) (bool, error)
+}
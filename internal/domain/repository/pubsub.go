@@ -0,0 +1,12 @@
+package repository
+
+import "context"
+
+// PubSub broadcasts messages to every process subscribed to a channel.
+type PubSub interface {
+	// Publish sends message, marshalled to JSON, to channel.
+	Publish(ctx context.Context, channel string, message interface{}) error
+	// Subscribe returns the raw messages published to channel until ctx is
+	// done, when the returned channel is closed.
+	Subscribe(ctx context.Context, channel string) (<-chan []byte, error)
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/region"
+)
+
+type RegionRepository interface {
+	ListProvinces(ctx context.Context) ([]region.Province, error)
+	FindProvinceByID(ctx context.Context, id int) (*region.Province, error)
+	FindCityByID(ctx context.Context, id int) (*region.City, error)
+	FindDistrictByID(ctx context.Context, id int) (*region.District, error)
+	FindVillageByID(ctx context.Context, id int) (*region.Village, error)
+	ListCitiesByProvince(ctx context.Context, provinceID int) ([]region.City, error)
+	ListDistrictsByCity(ctx context.Context, cityID int) ([]region.District, error)
+	ListVillagesByDistrict(ctx context.Context, districtID int) ([]region.Village, error)
+	// SearchVillages matches name (case-insensitive substring) and/or postal
+	// code, with district, city and province preloaded.
+	SearchVillages(ctx context.Context, name, posCode string, limit int) ([]region.Village, error)
+	// FindVillageByFullCode loads the village with its whole hierarchy.
+	FindVillageByFullCode(ctx context.Context, fullCode string) (*region.Village, error)
+	FindDistrictByFullCode(ctx context.Context, fullCode string) (*region.District, error)
+
+	// Upsert* insert or update by code/full_code. Parents must already exist.
+	UpsertProvinces(ctx context.Context, provinces []region.Province) error
+	UpsertCities(ctx context.Context, cities []region.City) error
+	UpsertDistricts(ctx context.Context, districts []region.District) error
+	UpsertVillages(ctx context.Context, villages []region.Village) error
+	// *IDs map full_code to ID for resolving parents during import.
+	ProvinceIDs(ctx context.Context) (map[string]int, error)
+	CityIDs(ctx context.Context) (map[string]int, error)
+	DistrictIDs(ctx context.Context) (map[string]int, error)
+}
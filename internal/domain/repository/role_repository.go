@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/rbac"
+)
+
+type RoleRepository interface {
+	FindByID(ctx context.Context, id int64) (*rbac.Role, error)
+	FindSystemByCode(ctx context.Context, code string) (*rbac.Role, error)
+	ListForCompany(ctx context.Context, companyID int64) ([]rbac.Role, error)
+	EnsureSystem(ctx context.Context, r *rbac.Role) error
+}
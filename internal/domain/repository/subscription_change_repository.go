@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/subscription"
+)
+
+type SubscriptionChangeRepository interface {
+	Create(ctx context.Context, c *subscription.SubscriptionChange) error
+	ListBySubscription(ctx context.Context, subscriptionID int64) ([]subscription.SubscriptionChange, error)
+}
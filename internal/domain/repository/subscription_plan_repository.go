@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/subscription"
+)
+
+type SubscriptionPlanRepository interface {
+	Create(ctx context.Context, p *subscription.Plan) error
+	FindByID(ctx context.Context, id int64) (*subscription.Plan, error)
+	FindByCode(ctx context.Context, code string) (*subscription.Plan, error)
+	List(ctx context.Context, publicOnly bool) ([]subscription.Plan, error)
+	Update(ctx context.Context, p *subscription.Plan) error
+	Ensure(ctx context.Context, p *subscription.Plan) error
+}
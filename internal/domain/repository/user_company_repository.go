@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/company"
+)
+
+type UserCompanyRepository interface {
+	Create(ctx context.Context, uc *company.UserCompany) error
+	FindByUserAndCompany(ctx context.Context, userID, companyID int64) (*company.UserCompany, error)
+	Update(ctx context.Context, uc *company.UserCompany) error
+	CountActiveByCompany(ctx context.Context, companyID int64) (int64, error)
+	// ListActiveByRoles returns the company's active members holding one of
+	// the role codes.
+	ListActiveByRoles(ctx context.Context, companyID int64, codes []string) ([]company.UserCompany, error)
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+type WorkLocationRepository interface {
+	Create(ctx context.Context, w *employee.WorkLocation) error
+	// FindByID returns "work location not found" unless the assignment
+	// belongs to employeeID.
+	FindByID(ctx context.Context, employeeID, id int64) (*employee.WorkLocation, error)
+	Update(ctx context.Context, w *employee.WorkLocation) error
+	Delete(ctx context.Context, w *employee.WorkLocation) error
+	// ListByEmployee returns an employee's assignments, oldest start date
+	// first.
+	ListByEmployee(ctx context.Context, employeeID int64) ([]employee.WorkLocation, error)
+	// ListByCompanyOn returns the assignments of the company's employees
+	// that cover date, ordered by employee, only those to addressID when
+	// it is set.
+	ListByCompanyOn(ctx context.Context, companyID int64, date time.Time, addressID int64) ([]employee.WorkLocation, error)
+	// CountByAddress counts assignments, current or past, to a company
+	// address.
+	CountByAddress(ctx context.Context, addressID int64) (int64, error)
+}
@@ -12,6 +12,10 @@ type Server struct {
 	server *asynq.Server
 }
 
+type Scheduler struct {
+	scheduler *asynq.Scheduler
+}
+
 func NewClient(redisAddr string) *Client {
 	client := asynq.NewClient(asynq.RedisClientOpt{
 		Addr: redisAddr,
@@ -42,6 +46,19 @@ func NewServer(redisAddr string, concurrency int) *Server {
 	}
 }
 
+func NewScheduler(redisAddr string) *Scheduler {
+	scheduler := asynq.NewScheduler(
+		asynq.RedisClientOpt{
+			Addr: redisAddr,
+		},
+		nil,
+	)
+
+	return &Scheduler{
+		scheduler: scheduler,
+	}
+}
+
 func (c *Client) Enqueue(task *asynq.Task, opts ...asynq.Option) error {
 	_, err := c.client.Enqueue(task, opts...)
 	return err
@@ -62,3 +79,16 @@ func (s *Server) Stop() {
 func (s *Server) Shutdown() {
 	s.server.Shutdown()
 }
+
+func (s *Scheduler) Register(cronspec string, task *asynq.Task, opts ...asynq.Option) error {
+	_, err := s.scheduler.Register(cronspec, task, opts...)
+	return err
+}
+
+func (s *Scheduler) Start() error {
+	return s.scheduler.Start()
+}
+
+func (s *Scheduler) Shutdown() {
+	s.scheduler.Shutdown()
+}
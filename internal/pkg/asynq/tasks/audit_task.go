@@ -0,0 +1,37 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+const TypeWriteAuditLog = "audit:write"
+
+// WriteAuditLogPayload is a complete audit log row, ID included, so retries
+// write it at most once.
+type WriteAuditLogPayload struct {
+	ID        int64           `json:"id"`
+	CompanyID *int64          `json:"company_id,omitempty"`
+	UserID    *int64          `json:"user_id,omitempty"`
+	RequestID *string         `json:"request_id,omitempty"`
+	Module    string          `json:"module"`
+	Entity    string          `json:"entity"`
+	EntityID  *int64          `json:"entity_id,omitempty"`
+	Action    string          `json:"action"`
+	OldValues json.RawMessage `json:"old_values,omitempty"`
+	NewValues json.RawMessage `json:"new_values,omitempty"`
+	IPAddress *string         `json:"ip_address,omitempty"`
+	UserAgent *string         `json:"user_agent,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+func NewWriteAuditLogTask(p WriteAuditLogPayload) (*asynq.Task, error) {
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	return asynq.NewTask(TypeWriteAuditLog, payload), nil
+}
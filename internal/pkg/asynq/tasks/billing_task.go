@@ -0,0 +1,29 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	TypeRenderInvoice       = "billing:render_invoice"
+	TypeMarkInvoicesOverdue = "billing:mark_overdue"
+)
+
+type RenderInvoicePayload struct {
+	InvoiceID int64 `json:"invoice_id"`
+}
+
+func NewRenderInvoiceTask(invoiceID int64) (*asynq.Task, error) {
+	payload, err := json.Marshal(RenderInvoicePayload{InvoiceID: invoiceID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	return asynq.NewTask(TypeRenderInvoice, payload), nil
+}
+
+func NewMarkInvoicesOverdueTask() *asynq.Task {
+	return asynq.NewTask(TypeMarkInvoicesOverdue, nil)
+}
@@ -0,0 +1,11 @@
+package tasks
+
+import "github.com/hibiken/asynq"
+
+const (
+	TypeRemindExpiringDocuments = "document:remind_expiring"
+)
+
+func NewRemindExpiringDocumentsTask() *asynq.Task {
+	return asynq.NewTask(TypeRemindExpiringDocuments, nil)
+}
@@ -3,12 +3,16 @@ package tasks
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/hibiken/asynq"
 )
 
 const (
-	TypeSendOTPEmail = "email:send_otp"
+	TypeSendOTPEmail          = "email:send_otp"
+	TypeSendInvitationEmail   = "email:send_invitation"
+	TypeSendSubscriptionEmail = "email:send_subscription_notice"
+	TypeSendDocumentExpiry    = "email:send_document_expiry"
 )
 
 type SendOTPEmailPayload struct {
@@ -32,3 +36,81 @@ func NewSendOTPEmailTask(to, name, otp, purpose, lang string) (*asynq.Task, erro
 	}
 	return asynq.NewTask(TypeSendOTPEmail, payload), nil
 }
+
+type SendInvitationEmailPayload struct {
+	To          string `json:"to"`
+	Name        string `json:"name"`
+	CompanyName string `json:"company_name"`
+	InviterName string `json:"inviter_name"`
+	AcceptURL   string `json:"accept_url"`
+	Lang        string `json:"lang"`
+}
+
+func NewSendInvitationEmailTask(to, name, companyName, inviterName, acceptURL, lang string) (*asynq.Task, error) {
+	payload, err := json.Marshal(SendInvitationEmailPayload{
+		To:          to,
+		Name:        name,
+		CompanyName: companyName,
+		InviterName: inviterName,
+		AcceptURL:   acceptURL,
+		Lang:        lang,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	return asynq.NewTask(TypeSendInvitationEmail, payload), nil
+}
+
+type SendSubscriptionEmailPayload struct {
+	To          string    `json:"to"`
+	Name        string    `json:"name"`
+	CompanyName string    `json:"company_name"`
+	Event       string    `json:"event"`
+	PlanName    string    `json:"plan_name"`
+	At          time.Time `json:"at"`
+	Lang        string    `json:"lang"`
+}
+
+func NewSendSubscriptionEmailTask(to, name, companyName, event, planName string, at time.Time, lang string) (*asynq.Task, error) {
+	payload, err := json.Marshal(SendSubscriptionEmailPayload{
+		To:          to,
+		Name:        name,
+		CompanyName: companyName,
+		Event:       event,
+		PlanName:    planName,
+		At:          at,
+		Lang:        lang,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	return asynq.NewTask(TypeSendSubscriptionEmail, payload), nil
+}
+
+type SendDocumentExpiryPayload struct {
+	To           string    `json:"to"`
+	Name         string    `json:"name"`
+	CompanyName  string    `json:"company_name"`
+	EmployeeName string    `json:"employee_name"`
+	DocumentName string    `json:"document_name"`
+	ValidUntil   time.Time `json:"valid_until"`
+	Self         bool      `json:"self"`
+	Lang         string    `json:"lang"`
+}
+
+func NewSendDocumentExpiryTask(to, name, companyName, employeeName, documentName string, validUntil time.Time, self bool, lang string) (*asynq.Task, error) {
+	payload, err := json.Marshal(SendDocumentExpiryPayload{
+		To:           to,
+		Name:         name,
+		CompanyName:  companyName,
+		EmployeeName: employeeName,
+		DocumentName: documentName,
+		ValidUntil:   validUntil,
+		Self:         self,
+		Lang:         lang,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	return asynq.NewTask(TypeSendDocumentExpiry, payload), nil
+}
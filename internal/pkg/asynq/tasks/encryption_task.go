@@ -0,0 +1,11 @@
+package tasks
+
+import "github.com/hibiken/asynq"
+
+const (
+	TypeReencryptFields = "encryption:reencrypt"
+)
+
+func NewReencryptFieldsTask() *asynq.Task {
+	return asynq.NewTask(TypeReencryptFields, nil)
+}
@@ -0,0 +1,24 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	TypeImportEmployees = "employee:import"
+)
+
+type ImportEmployeesPayload struct {
+	ImportID int64 `json:"import_id"`
+}
+
+func NewImportEmployeesTask(importID int64) (*asynq.Task, error) {
+	payload, err := json.Marshal(ImportEmployeesPayload{ImportID: importID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	return asynq.NewTask(TypeImportEmployees, payload), nil
+}
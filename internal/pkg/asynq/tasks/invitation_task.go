@@ -0,0 +1,11 @@
+package tasks
+
+import "github.com/hibiken/asynq"
+
+const (
+	TypeExpireInvitations = "invitation:expire"
+)
+
+func NewExpireInvitationsTask() *asynq.Task {
+	return asynq.NewTask(TypeExpireInvitations, nil)
+}
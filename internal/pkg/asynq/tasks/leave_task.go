@@ -0,0 +1,11 @@
+package tasks
+
+import "github.com/hibiken/asynq"
+
+const (
+	TypeSyncLeaveStatus = "leave:sync_status"
+)
+
+func NewSyncLeaveStatusTask() *asynq.Task {
+	return asynq.NewTask(TypeSyncLeaveStatus, nil)
+}
@@ -0,0 +1,11 @@
+package tasks
+
+import "github.com/hibiken/asynq"
+
+const (
+	TypeProcessSubscriptions = "subscription:process_lifecycle"
+)
+
+func NewProcessSubscriptionsTask() *asynq.Task {
+	return asynq.NewTask(TypeProcessSubscriptions, nil)
+}
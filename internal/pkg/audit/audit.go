@@ -0,0 +1,79 @@
+// Package audit carries request metadata through context and defines the
+// entries usecases record about changes they make. The audit usecase turns
+// entries into audit_logs rows.
+package audit
+
+import "context"
+
+const (
+	ActionCreate      = "CREATE"
+	ActionUpdate      = "UPDATE"
+	ActionDelete      = "DELETE"
+	ActionLogin       = "LOGIN"
+	ActionLoginFailed = "LOGIN_FAILED"
+	ActionExport      = "EXPORT"
+
+	ModuleGeneral = "general"
+	ModuleHR      = "hr"
+)
+
+// Entry describes one change. CompanyID is 0 for changes outside a company,
+// such as signing in. UserID overrides the actor taken from the context,
+// for requests that are not authenticated yet. Old and New are marshalled to
+// JSON; when both are set only the fields that differ are kept.
+type Entry struct {
+	CompanyID int64
+	UserID    int64
+	Module    string
+	Entity    string
+	EntityID  int64
+	Action    string
+	Old       interface{}
+	New       interface{}
+}
+
+// Recorder records entries without blocking the caller on storage.
+type Recorder interface {
+	Record(ctx context.Context, e Entry)
+}
+
+// RecordHR records, through r, a change to an HR record of a company.
+func RecordHR(ctx context.Context, r Recorder, companyID int64, entity string, id int64, action string, oldValue, newValue interface{}) {
+	r.Record(ctx, Entry{
+		CompanyID: companyID,
+		Module:    ModuleHR,
+		Entity:    entity,
+		EntityID:  id,
+		Action:    action,
+		Old:       oldValue,
+		New:       newValue,
+	})
+}
+
+// Request is the metadata of the HTTP request a change happens in.
+type Request struct {
+	ID        string
+	IP        string
+	UserAgent string
+	UserID    int64
+}
+
+type requestKey struct{}
+
+// WithRequest returns ctx carrying r.
+func WithRequest(ctx context.Context, r Request) context.Context {
+	return context.WithValue(ctx, requestKey{}, r)
+}
+
+// WithActor returns ctx with the authenticated user set on its request.
+func WithActor(ctx context.Context, userID int64) context.Context {
+	r := FromContext(ctx)
+	r.UserID = userID
+	return WithRequest(ctx, r)
+}
+
+// FromContext returns the request carried by ctx, or the zero Request.
+func FromContext(ctx context.Context) Request {
+	r, _ := ctx.Value(requestKey{}).(Request)
+	return r
+}
@@ -4,24 +4,31 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	App       AppConfig
-	Database  DatabaseConfig
-	Redis     RedisConfig
-	JWT       JWTConfig
-	Snowflake SnowflakeConfig
-	Asynq     AsynqConfig
-	Mailer    MailerConfig
+	App        AppConfig
+	Database   DatabaseConfig
+	Redis      RedisConfig
+	JWT        JWTConfig
+	Snowflake  SnowflakeConfig
+	Asynq      AsynqConfig
+	Mailer     MailerConfig
+	Admin      AdminConfig
+	Billing    BillingConfig
+	Storage    StorageConfig
+	Payment    PaymentConfig
+	Encryption EncryptionConfig
 }
 
 type AppConfig struct {
-	Name string
-	Env  string
-	Port string
+	Name        string
+	Env         string
+	Port        string
+	FrontendURL string
 }
 
 type DatabaseConfig struct {
@@ -63,6 +70,38 @@ type MailerConfig struct {
 	Password string
 }
 
+type AdminConfig struct {
+	// Emails lists the users allowed to manage platform-wide data such as
+	// subscription plans.
+	Emails []string
+}
+
+type BillingConfig struct {
+	TrialDays      int
+	TrialPlanCode  string
+	TaxRate        int
+	InvoiceDueDays int
+	IssuerName     string
+	IssuerAddress  string
+	IssuerTaxID    string
+}
+
+type PaymentConfig struct {
+	Provider      string
+	WebhookSecret string
+}
+
+type EncryptionConfig struct {
+	// KeyringFile is the JSON keyring sealing sensitive columns, managed
+	// with cmd/keyring.
+	KeyringFile string
+}
+
+type StorageConfig struct {
+	Driver   string
+	LocalDir string
+}
+
 func Load(envFile string) (*Config, error) {
 	_ = godotenv.Load(envFile)
 
@@ -71,6 +110,7 @@ func Load(envFile string) (*Config, error) {
 	cfg.App.Name = getEnv("APP_NAME", "Haily Backend")
 	cfg.App.Env = getEnv("APP_ENV", "development")
 	cfg.App.Port = getEnv("APP_PORT", "8080")
+	cfg.App.FrontendURL = getEnv("APP_FRONTEND_URL", "http://localhost:3000")
 
 	cfg.Database.Host = getEnv("DB_HOST", "localhost")
 	cfg.Database.Port = getEnv("DB_PORT", "5432")
@@ -105,6 +145,34 @@ func Load(envFile string) (*Config, error) {
 	cfg.Mailer.Username = getEnv("MAIL_USERNAME", "")
 	cfg.Mailer.Password = getEnv("MAIL_PASSWORD", "")
 
+	for _, email := range strings.Split(getEnv("ADMIN_EMAILS", ""), ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			cfg.Admin.Emails = append(cfg.Admin.Emails, email)
+		}
+	}
+
+	cfg.Billing.TrialPlanCode = getEnv("BILLING_TRIAL_PLAN", "PROFESSIONAL")
+	if days, err := strconv.Atoi(getEnv("BILLING_TRIAL_DAYS", "14")); err == nil {
+		cfg.Billing.TrialDays = days
+	}
+	if rate, err := strconv.Atoi(getEnv("BILLING_TAX_RATE", "11")); err == nil {
+		cfg.Billing.TaxRate = rate
+	}
+	if days, err := strconv.Atoi(getEnv("BILLING_INVOICE_DUE_DAYS", "7")); err == nil {
+		cfg.Billing.InvoiceDueDays = days
+	}
+	cfg.Billing.IssuerName = getEnv("BILLING_ISSUER_NAME", "PT Haily Teknologi Indonesia")
+	cfg.Billing.IssuerAddress = getEnv("BILLING_ISSUER_ADDRESS", "Jakarta, Indonesia")
+	cfg.Billing.IssuerTaxID = getEnv("BILLING_ISSUER_NPWP", "")
+
+	cfg.Payment.Provider = getEnv("PAYMENT_PROVIDER", "fake")
+	cfg.Payment.WebhookSecret = getEnv("PAYMENT_WEBHOOK_SECRET", "change-me-in-production")
+
+	cfg.Storage.Driver = getEnv("STORAGE_DRIVER", "local")
+	cfg.Storage.LocalDir = getEnv("STORAGE_LOCAL_DIR", "./storage")
+
+	cfg.Encryption.KeyringFile = getEnv("ENCRYPTION_KEYRING_FILE", "./keyring.json")
+
 	return cfg, nil
 }
 
@@ -0,0 +1,117 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// prefix marks sealed values. A sealed value reads
+// "enc:v1:{key ID}:{sealed data key}:{sealed plaintext}", both parts
+// base64url encoded with the GCM nonce in front.
+const prefix = "enc:v1:"
+
+var encoding = base64.RawURLEncoding
+
+// Sealed reports whether value was produced by Encrypt. Anything else is
+// treated as plaintext written before encryption was turned on.
+func Sealed(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// Encrypt seals plaintext under a fresh data key, which is itself sealed
+// under the active key. The key ID is authenticated with both.
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", fmt.Errorf("failed to generate data key: %w", err)
+	}
+	aad := []byte(k.active)
+
+	wrapped, err := seal(k.keys[k.active], dataKey, aad)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := seal(dataKey, []byte(plaintext), aad)
+	if err != nil {
+		return "", err
+	}
+	return prefix + k.active + ":" + encoding.EncodeToString(wrapped) + ":" + encoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a value sealed by Encrypt under any key of the keyring.
+// Values that are not sealed are returned as they are, so columns can be
+// encrypted before the re-encryption job has reached every row.
+func (k *Keyring) Decrypt(value string) (string, error) {
+	if !Sealed(value) {
+		return value, nil
+	}
+	parts := strings.Split(strings.TrimPrefix(value, prefix), ":")
+	if len(parts) != 3 {
+		return "", errors.New("malformed encrypted value")
+	}
+	keyID := parts[0]
+	key, ok := k.keys[keyID]
+	if !ok {
+		return "", fmt.Errorf("unknown encryption key %q", keyID)
+	}
+	wrapped, err := encoding.DecodeString(parts[1])
+	if err != nil {
+		return "", errors.New("malformed encrypted value")
+	}
+	sealed, err := encoding.DecodeString(parts[2])
+	if err != nil {
+		return "", errors.New("malformed encrypted value")
+	}
+
+	aad := []byte(keyID)
+	dataKey, err := open(key, wrapped, aad)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(dataKey, sealed, aad)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+func seal(key, plaintext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return gcm.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(key, sealed, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("malformed encrypted value")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return nil, errors.New("failed to decrypt value")
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
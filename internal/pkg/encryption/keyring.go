@@ -0,0 +1,192 @@
+// Package encryption seals sensitive column values with envelope
+// encryption. Every value gets its own data key; the data key is encrypted
+// with AES-GCM under the keyring's active key and stored next to the value
+// together with that key's ID, so values sealed under older keys still open
+// after a rotation. Blind indexes are keyed HMACs of the plaintext that
+// allow exact-match lookups on encrypted columns.
+package encryption
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+)
+
+const keySize = 32
+
+var keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9-]{1,32}$`)
+
+// Keyring holds the key encryption keys by ID and the blind index key.
+// New values are sealed under the active key.
+type Keyring struct {
+	active   string
+	keys     map[string][]byte
+	indexKey []byte
+}
+
+// keyringFile is the JSON layout of a keyring file. Keys are base64
+// encoded 256-bit AES keys. The index key is never rotated: changing it
+// would orphan every blind index already stored.
+type keyringFile struct {
+	ActiveKey string    `json:"active_key"`
+	IndexKey  string    `json:"index_key"`
+	Keys      []keyFile `json:"keys"`
+}
+
+type keyFile struct {
+	ID        string    `json:"id"`
+	Key       string    `json:"key"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// LoadKeyring reads a keyring file.
+func LoadKeyring(path string) (*Keyring, error) {
+	f, err := readKeyringFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	k := &Keyring{active: f.ActiveKey, keys: make(map[string][]byte, len(f.Keys))}
+	if k.indexKey, err = decodeKey(f.IndexKey); err != nil {
+		return nil, fmt.Errorf("invalid index key: %w", err)
+	}
+	for _, entry := range f.Keys {
+		if !keyIDPattern.MatchString(entry.ID) {
+			return nil, fmt.Errorf("invalid key ID %q", entry.ID)
+		}
+		if _, ok := k.keys[entry.ID]; ok {
+			return nil, fmt.Errorf("duplicate key ID %q", entry.ID)
+		}
+		if k.keys[entry.ID], err = decodeKey(entry.Key); err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", entry.ID, err)
+		}
+	}
+	if _, ok := k.keys[k.active]; !ok {
+		return nil, fmt.Errorf("active key %q not in keyring", k.active)
+	}
+	return k, nil
+}
+
+// CreateKeyring writes a new keyring file with one key and an index key.
+// It refuses to overwrite an existing file.
+func CreateKeyring(path string) error {
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("%s already exists", path)
+	}
+	indexKey, err := newKey()
+	if err != nil {
+		return err
+	}
+	f := &keyringFile{IndexKey: indexKey}
+	if _, err := addKey(f); err != nil {
+		return err
+	}
+	return writeKeyringFile(path, f)
+}
+
+// RotateKey adds a new key to the keyring file and makes it active. Older
+// keys stay so existing values keep opening until they are re-encrypted.
+func RotateKey(path string) (string, error) {
+	f, err := readKeyringFile(path)
+	if err != nil {
+		return "", err
+	}
+	id, err := addKey(f)
+	if err != nil {
+		return "", err
+	}
+	if err := writeKeyringFile(path, f); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// ActiveKeyID is the ID of the key new values are sealed under.
+func (k *Keyring) ActiveKeyID() string {
+	return k.active
+}
+
+// ActivePrefix is the prefix of values sealed under the active key.
+func (k *Keyring) ActivePrefix() string {
+	return prefix + k.active + ":"
+}
+
+// BlindIndex returns the hex HMAC-SHA256 of value under the index key.
+// Callers normalise value first; equal plaintexts give equal indexes.
+func (k *Keyring) BlindIndex(value string) string {
+	mac := hmac.New(sha256.New, k.indexKey)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func addKey(f *keyringFile) (string, error) {
+	key, err := newKey()
+	if err != nil {
+		return "", err
+	}
+	suffix := make([]byte, 3)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("failed to generate key ID: %w", err)
+	}
+	now := time.Now().UTC()
+	id := now.Format("20060102") + "-" + hex.EncodeToString(suffix)
+	for _, entry := range f.Keys {
+		if entry.ID == id {
+			return "", fmt.Errorf("key %q already exists", id)
+		}
+	}
+	f.Keys = append(f.Keys, keyFile{ID: id, Key: key, CreatedAt: now})
+	f.ActiveKey = id
+	return id, nil
+}
+
+func newKey() (string, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("failed to generate key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+func decodeKey(s string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != keySize {
+		return nil, errors.New("key must be 32 bytes")
+	}
+	return key, nil
+}
+
+func readKeyringFile(path string) (*keyringFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keyring: %w", err)
+	}
+	var f keyringFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse keyring: %w", err)
+	}
+	return &f, nil
+}
+
+func writeKeyringFile(path string, f *keyringFile) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write keyring: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
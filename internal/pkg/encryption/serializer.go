@@ -0,0 +1,115 @@
+package encryption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+
+	"gorm.io/gorm/schema"
+)
+
+// SerializerName is the GORM serializer that seals string and *string
+// fields: `gorm:"serializer:encrypted;type:text"`. Nil pointers stay NULL
+// and empty strings stay empty.
+const SerializerName = "encrypted"
+
+var defaultKeyring atomic.Pointer[Keyring]
+
+func init() {
+	schema.RegisterSerializer(SerializerName, Serializer{})
+}
+
+// Init sets the keyring used by the serializer and by BlindIndex. It must
+// run before the first query touching an encrypted field.
+func Init(k *Keyring) {
+	defaultKeyring.Store(k)
+}
+
+// Default returns the keyring set by Init.
+func Default() (*Keyring, error) {
+	k := defaultKeyring.Load()
+	if k == nil {
+		return nil, errors.New("encryption keyring not loaded")
+	}
+	return k, nil
+}
+
+// BlindIndex returns the blind index of value under the default keyring,
+// or nil for nil.
+func BlindIndex(value *string) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	k, err := Default()
+	if err != nil {
+		return nil, err
+	}
+	index := k.BlindIndex(*value)
+	return &index, nil
+}
+
+// Serializer implements schema.SerializerInterface with the default
+// keyring.
+type Serializer struct{}
+
+func (Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	fieldValue := reflect.New(field.FieldType)
+	if dbValue != nil {
+		var raw string
+		switch v := dbValue.(type) {
+		case string:
+			raw = v
+		case []byte:
+			raw = string(v)
+		default:
+			return fmt.Errorf("unsupported encrypted value %T for %s", dbValue, field.Name)
+		}
+
+		k, err := Default()
+		if err != nil {
+			return err
+		}
+		plaintext, err := k.Decrypt(raw)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt %s: %w", field.Name, err)
+		}
+
+		elem := fieldValue.Elem()
+		if elem.Kind() == reflect.Ptr {
+			elem.Set(reflect.New(elem.Type().Elem()))
+			elem = elem.Elem()
+		}
+		if elem.Kind() != reflect.String {
+			return fmt.Errorf("unsupported encrypted field type %s for %s", field.FieldType, field.Name)
+		}
+		elem.SetString(plaintext)
+	}
+	field.ReflectValueOf(ctx, dst).Set(fieldValue.Elem())
+	return nil
+}
+
+func (Serializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	var plaintext string
+	switch v := fieldValue.(type) {
+	case string:
+		plaintext = v
+	case *string:
+		if v == nil {
+			return nil, nil
+		}
+		plaintext = *v
+	default:
+		return nil, fmt.Errorf("unsupported encrypted field type %T for %s", fieldValue, field.Name)
+	}
+	if plaintext == "" {
+		return "", nil
+	}
+
+	k, err := Default()
+	if err != nil {
+		return nil, err
+	}
+	return k.Encrypt(plaintext)
+}
@@ -0,0 +1,24 @@
+// Package geo measures distances between points on the earth's surface,
+// which attendance uses to check clock-ins against work locations.
+package geo
+
+import "math"
+
+// earthRadius is the mean radius of the earth in meters.
+const earthRadius = 6371008.8
+
+// Distance returns the great-circle distance in meters between two points
+// given in decimal degrees, by the haversine formula.
+func Distance(lat1, lng1, lat2, lng2 float64) float64 {
+	phi1 := radians(lat1)
+	phi2 := radians(lat2)
+	dPhi := radians(lat2 - lat1)
+	dLambda := radians(lng2 - lng1)
+
+	h := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -0,0 +1,101 @@
+// Package holiday is the calendar of Indonesian public holidays leave is
+// counted against. The dates come from the joint ministerial decree (SKB
+// 3 Menteri) of each year and are bundled in id.json; add the next year's
+// holidays there once the decree is published. Cuti bersama is not
+// included, as companies decide themselves whether to observe it.
+package holiday
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+//go:embed id.json
+var indonesia []byte
+
+// Holiday is one public holiday. Date is midnight UTC.
+type Holiday struct {
+	Date time.Time
+	Name string
+}
+
+// Calendar holds a set of holidays and counts working days around them.
+type Calendar struct {
+	days  map[time.Time]string
+	years map[int]bool
+}
+
+// Indonesia returns the bundled calendar of Indonesian public holidays.
+func Indonesia() (*Calendar, error) {
+	var entries []struct {
+		Date string `json:"date"`
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(indonesia, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse holiday calendar: %w", err)
+	}
+
+	c := &Calendar{days: make(map[time.Time]string, len(entries)), years: make(map[int]bool)}
+	for _, e := range entries {
+		d, err := time.Parse(dateLayout, e.Date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid holiday date %q: %w", e.Date, err)
+		}
+		c.days[d] = e.Name
+		c.years[d.Year()] = true
+	}
+	return c, nil
+}
+
+// Covers reports whether the calendar lists the holidays of year.
+func (c *Calendar) Covers(year int) bool {
+	return c.years[year]
+}
+
+// Holiday returns the name of the holiday on date, if any.
+func (c *Calendar) Holiday(date time.Time) (string, bool) {
+	name, ok := c.days[day(date)]
+	return name, ok
+}
+
+// Year returns the holidays of a year in date order.
+func (c *Calendar) Year(year int) []Holiday {
+	var list []Holiday
+	for d, name := range c.days {
+		if d.Year() == year {
+			list = append(list, Holiday{Date: d, Name: name})
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Date.Before(list[j].Date) })
+	return list
+}
+
+// IsWorkingDay reports whether date is a weekday that is not a holiday.
+func (c *Calendar) IsWorkingDay(date time.Time) bool {
+	if wd := date.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+	_, holiday := c.Holiday(date)
+	return !holiday
+}
+
+// WorkingDays counts the working days from start to end, both inclusive.
+func (c *Calendar) WorkingDays(start, end time.Time) int {
+	n := 0
+	for d := day(start); !d.After(day(end)); d = d.AddDate(0, 0, 1) {
+		if c.IsWorkingDay(d) {
+			n++
+		}
+	}
+	return n
+}
+
+func day(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
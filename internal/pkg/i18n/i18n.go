@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 )
 
 type contextKey string
@@ -44,20 +45,20 @@ func FromContext(ctx context.Context) string {
 	return lang
 }
 
-type OTPEmailContent struct {
+type EmailContent struct {
 	Subject string
 	Body    string
 }
 
-func OTPEmail(name, otp, purpose, lang string) OTPEmailContent {
+func OTPEmail(name, otp, purpose, lang string) EmailContent {
 	if lang == LangID {
 		return otpEmailID(name, otp, purpose)
 	}
 	return otpEmailEN(name, otp, purpose)
 }
 
-func otpEmailEN(name, otp, purpose string) OTPEmailContent {
-	return OTPEmailContent{
+func otpEmailEN(name, otp, purpose string) EmailContent {
+	return EmailContent{
 		Subject: otpSubjectEN(purpose),
 		Body: fmt.Sprintf(
 			"Hi %s,\n\nYour verification code is:\n\n%s\n\nThis code will expire in 10 minutes.\n\nIf you did not request this, please ignore this email.\n\nRegards,\nHaily Team",
@@ -66,8 +67,8 @@ func otpEmailEN(name, otp, purpose string) OTPEmailContent {
 	}
 }
 
-func otpEmailID(name, otp, purpose string) OTPEmailContent {
-	return OTPEmailContent{
+func otpEmailID(name, otp, purpose string) EmailContent {
+	return EmailContent{
 		Subject: otpSubjectID(purpose),
 		Body: fmt.Sprintf(
 			"Halo %s,\n\nKode verifikasi kamu adalah:\n\n%s\n\nKode ini akan kedaluwarsa dalam 10 menit.\n\nJika kamu tidak merasa meminta kode ini, abaikan email ini.\n\nSalam,\nTim Haily",
@@ -98,6 +99,267 @@ func otpSubjectID(purpose string) string {
 	}
 }
 
+func InvitationEmail(name, companyName, inviterName, acceptURL, lang string) EmailContent {
+	if lang == LangID {
+		return EmailContent{
+			Subject: fmt.Sprintf("Undangan bergabung dengan %s", companyName),
+			Body: fmt.Sprintf(
+				"Halo %s,\n\n%s mengundang kamu untuk bergabung dengan %s di Haily.\n\nTerima undangan melalui tautan berikut:\n\n%s\n\nUndangan ini berlaku selama 7 hari.\n\nSalam,\nTim Haily",
+				name, inviterName, companyName, acceptURL,
+			),
+		}
+	}
+	return EmailContent{
+		Subject: fmt.Sprintf("You're invited to join %s", companyName),
+		Body: fmt.Sprintf(
+			"Hi %s,\n\n%s has invited you to join %s on Haily.\n\nAccept the invitation using the link below:\n\n%s\n\nThis invitation is valid for 7 days.\n\nRegards,\nHaily Team",
+			name, inviterName, companyName, acceptURL,
+		),
+	}
+}
+
+var monthsID = [...]string{
+	"Januari", "Februari", "Maret", "April", "Mei", "Juni",
+	"Juli", "Agustus", "September", "Oktober", "November", "Desember",
+}
+
+// FormatDate renders t as a long date, e.g. "2 January 2026" or
+// "2 Januari 2026".
+func FormatDate(t time.Time, lang string) string {
+	if lang == LangID {
+		return fmt.Sprintf("%d %s %d", t.Day(), monthsID[t.Month()-1], t.Year())
+	}
+	return t.Format("2 January 2006")
+}
+
+// SubscriptionEmail builds a subscription lifecycle notice. event is one of
+// TRIAL_ENDING, TRIAL_ENDED, RENEWED, PLAN_CHANGED, CANCELLED or EXPIRED; at
+// is the date the event refers to (trial end, next renewal, or expiry).
+func SubscriptionEmail(event, name, companyName, planName string, at time.Time, lang string) EmailContent {
+	date := FormatDate(at, lang)
+	if lang == LangID {
+		return EmailContent{
+			Subject: subscriptionSubjectID(event, companyName),
+			Body: fmt.Sprintf(
+				"Halo %s,\n\n%s\n\nSalam,\nTim Haily",
+				name, subscriptionLineID(event, companyName, planName, date),
+			),
+		}
+	}
+	return EmailContent{
+		Subject: subscriptionSubjectEN(event, companyName),
+		Body: fmt.Sprintf(
+			"Hi %s,\n\n%s\n\nRegards,\nHaily Team",
+			name, subscriptionLineEN(event, companyName, planName, date),
+		),
+	}
+}
+
+type NotificationContent struct {
+	Title string
+	Body  string
+}
+
+// SubscriptionNotification is the in-app counterpart of SubscriptionEmail.
+func SubscriptionNotification(event, companyName, planName string, at time.Time, lang string) NotificationContent {
+	date := FormatDate(at, lang)
+	if lang == LangID {
+		return NotificationContent{
+			Title: subscriptionSubjectID(event, companyName),
+			Body:  subscriptionLineID(event, companyName, planName, date),
+		}
+	}
+	return NotificationContent{
+		Title: subscriptionSubjectEN(event, companyName),
+		Body:  subscriptionLineEN(event, companyName, planName, date),
+	}
+}
+
+func InvitationNotification(companyName, inviterName, lang string) NotificationContent {
+	if lang == LangID {
+		return NotificationContent{
+			Title: fmt.Sprintf("Undangan bergabung dengan %s", companyName),
+			Body:  fmt.Sprintf("%s mengundang kamu untuk bergabung dengan %s.", inviterName, companyName),
+		}
+	}
+	return NotificationContent{
+		Title: fmt.Sprintf("You're invited to join %s", companyName),
+		Body:  fmt.Sprintf("%s has invited you to join %s.", inviterName, companyName),
+	}
+}
+
+// DocumentExpiryEmail reminds a recipient that an employee document is
+// about to expire. self is set when the recipient is that employee.
+func DocumentExpiryEmail(name, companyName, employeeName, documentName string, validUntil time.Time, self bool, lang string) EmailContent {
+	date := FormatDate(validUntil, lang)
+	if lang == LangID {
+		return EmailContent{
+			Subject: fmt.Sprintf("Dokumen %s akan segera berakhir", documentName),
+			Body: fmt.Sprintf(
+				"Halo %s,\n\n%s\n\nSalam,\nTim Haily",
+				name, documentExpiryLineID(companyName, employeeName, documentName, date, self),
+			),
+		}
+	}
+	return EmailContent{
+		Subject: fmt.Sprintf("Document %s is expiring soon", documentName),
+		Body: fmt.Sprintf(
+			"Hi %s,\n\n%s\n\nRegards,\nHaily Team",
+			name, documentExpiryLineEN(companyName, employeeName, documentName, date, self),
+		),
+	}
+}
+
+// DocumentExpiryNotification is the in-app counterpart of
+// DocumentExpiryEmail.
+func DocumentExpiryNotification(companyName, employeeName, documentName string, validUntil time.Time, self bool, lang string) NotificationContent {
+	date := FormatDate(validUntil, lang)
+	if lang == LangID {
+		return NotificationContent{
+			Title: fmt.Sprintf("Dokumen %s akan segera berakhir", documentName),
+			Body:  documentExpiryLineID(companyName, employeeName, documentName, date, self),
+		}
+	}
+	return NotificationContent{
+		Title: fmt.Sprintf("Document %s is expiring soon", documentName),
+		Body:  documentExpiryLineEN(companyName, employeeName, documentName, date, self),
+	}
+}
+
+func documentExpiryLineEN(companyName, employeeName, documentName, date string, self bool) string {
+	if self {
+		return fmt.Sprintf("Your document %s at %s is valid until %s. Please provide a renewed one to HR.", documentName, companyName, date)
+	}
+	return fmt.Sprintf("The document %s of %s at %s is valid until %s.", documentName, employeeName, companyName, date)
+}
+
+func documentExpiryLineID(companyName, employeeName, documentName, date string, self bool) string {
+	if self {
+		return fmt.Sprintf("Dokumen %s kamu di %s berlaku hingga %s. Silakan serahkan dokumen yang diperbarui ke HR.", documentName, companyName, date)
+	}
+	return fmt.Sprintf("Dokumen %s milik %s di %s berlaku hingga %s.", documentName, employeeName, companyName, date)
+}
+
+// LeaveRequestNotification asks an approver, or HR when a request has no
+// approval chain, to decide an employee's leave request.
+func LeaveRequestNotification(companyName, employeeName, typeName string, start, end time.Time, days int, lang string) NotificationContent {
+	from, to := FormatDate(start, lang), FormatDate(end, lang)
+	if lang == LangID {
+		return NotificationContent{
+			Title: fmt.Sprintf("Pengajuan cuti dari %s", employeeName),
+			Body:  fmt.Sprintf("%s di %s mengajukan %s dari %s sampai %s (%d hari kerja).", employeeName, companyName, typeName, from, to, days),
+		}
+	}
+	return NotificationContent{
+		Title: fmt.Sprintf("Leave request from %s", employeeName),
+		Body:  fmt.Sprintf("%s at %s requests %s from %s to %s (%d working days).", employeeName, companyName, typeName, from, to, days),
+	}
+}
+
+// LeaveDecisionNotification tells an employee their leave request was
+// approved or rejected.
+func LeaveDecisionNotification(companyName, typeName string, start, end time.Time, approved bool, lang string) NotificationContent {
+	from, to := FormatDate(start, lang), FormatDate(end, lang)
+	if lang == LangID {
+		if approved {
+			return NotificationContent{
+				Title: "Pengajuan cuti kamu disetujui",
+				Body:  fmt.Sprintf("%s kamu di %s dari %s sampai %s telah disetujui.", typeName, companyName, from, to),
+			}
+		}
+		return NotificationContent{
+			Title: "Pengajuan cuti kamu ditolak",
+			Body:  fmt.Sprintf("%s kamu di %s dari %s sampai %s ditolak.", typeName, companyName, from, to),
+		}
+	}
+	if approved {
+		return NotificationContent{
+			Title: "Your leave request was approved",
+			Body:  fmt.Sprintf("Your %s at %s from %s to %s has been approved.", typeName, companyName, from, to),
+		}
+	}
+	return NotificationContent{
+		Title: "Your leave request was rejected",
+		Body:  fmt.Sprintf("Your %s at %s from %s to %s has been rejected.", typeName, companyName, from, to),
+	}
+}
+
+func subscriptionSubjectEN(event, companyName string) string {
+	switch event {
+	case "TRIAL_ENDING":
+		return fmt.Sprintf("Your %s trial is ending soon", companyName)
+	case "TRIAL_ENDED":
+		return fmt.Sprintf("Your %s trial has ended", companyName)
+	case "RENEWED":
+		return fmt.Sprintf("Your %s subscription has been renewed", companyName)
+	case "PLAN_CHANGED":
+		return fmt.Sprintf("Your %s plan has changed", companyName)
+	case "CANCELLED":
+		return fmt.Sprintf("Your %s subscription has been cancelled", companyName)
+	case "EXPIRED":
+		return fmt.Sprintf("Your %s subscription has expired", companyName)
+	default:
+		return fmt.Sprintf("%s subscription update", companyName)
+	}
+}
+
+func subscriptionSubjectID(event, companyName string) string {
+	switch event {
+	case "TRIAL_ENDING":
+		return fmt.Sprintf("Masa uji coba %s akan segera berakhir", companyName)
+	case "TRIAL_ENDED":
+		return fmt.Sprintf("Masa uji coba %s telah berakhir", companyName)
+	case "RENEWED":
+		return fmt.Sprintf("Langganan %s telah diperpanjang", companyName)
+	case "PLAN_CHANGED":
+		return fmt.Sprintf("Paket langganan %s telah berubah", companyName)
+	case "CANCELLED":
+		return fmt.Sprintf("Langganan %s telah dibatalkan", companyName)
+	case "EXPIRED":
+		return fmt.Sprintf("Langganan %s telah berakhir", companyName)
+	default:
+		return fmt.Sprintf("Pembaruan langganan %s", companyName)
+	}
+}
+
+func subscriptionLineEN(event, companyName, planName, date string) string {
+	switch event {
+	case "TRIAL_ENDING":
+		return fmt.Sprintf("The %s trial for %s ends on %s. Your subscription will continue on the %s plan after that.", planName, companyName, date, planName)
+	case "TRIAL_ENDED":
+		return fmt.Sprintf("The trial for %s has ended and your %s subscription is now active. The next renewal is on %s.", companyName, planName, date)
+	case "RENEWED":
+		return fmt.Sprintf("The %s subscription for %s has been renewed. The next renewal is on %s.", planName, companyName, date)
+	case "PLAN_CHANGED":
+		return fmt.Sprintf("%s is now on the %s plan, effective %s.", companyName, planName, date)
+	case "CANCELLED":
+		return fmt.Sprintf("The %s subscription for %s has been cancelled. You can keep using it until %s.", planName, companyName, date)
+	case "EXPIRED":
+		return fmt.Sprintf("The %s subscription for %s expired on %s. Choose a plan to keep using Haily.", planName, companyName, date)
+	default:
+		return fmt.Sprintf("There is an update to the %s subscription for %s.", planName, companyName)
+	}
+}
+
+func subscriptionLineID(event, companyName, planName, date string) string {
+	switch event {
+	case "TRIAL_ENDING":
+		return fmt.Sprintf("Masa uji coba paket %s untuk %s berakhir pada %s. Setelah itu langganan kamu akan berlanjut dengan paket %s.", planName, companyName, date, planName)
+	case "TRIAL_ENDED":
+		return fmt.Sprintf("Masa uji coba %s telah berakhir dan langganan paket %s kini aktif. Perpanjangan berikutnya pada %s.", companyName, planName, date)
+	case "RENEWED":
+		return fmt.Sprintf("Langganan paket %s untuk %s telah diperpanjang. Perpanjangan berikutnya pada %s.", planName, companyName, date)
+	case "PLAN_CHANGED":
+		return fmt.Sprintf("%s kini menggunakan paket %s, berlaku mulai %s.", companyName, planName, date)
+	case "CANCELLED":
+		return fmt.Sprintf("Langganan paket %s untuk %s telah dibatalkan. Kamu masih dapat menggunakannya hingga %s.", planName, companyName, date)
+	case "EXPIRED":
+		return fmt.Sprintf("Langganan paket %s untuk %s berakhir pada %s. Pilih paket untuk terus menggunakan Haily.", planName, companyName, date)
+	default:
+		return fmt.Sprintf("Ada pembaruan pada langganan paket %s untuk %s.", planName, companyName)
+	}
+}
+
 func RegisterSuccessMessage(lang string) string {
 	if lang == LangID {
 		return "Registrasi berhasil. Silakan cek email kamu untuk kode OTP verifikasi."
@@ -4,12 +4,16 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/smtp"
+	"time"
 
 	"github.com/haily-id/engine/internal/pkg/i18n"
 )
 
 type Mailer interface {
 	SendOTP(to, name, otp, purpose, lang string) error
+	SendInvitation(to, name, companyName, inviterName, acceptURL, lang string) error
+	SendSubscriptionNotice(to, name, companyName, event, planName string, at time.Time, lang string) error
+	SendDocumentExpiry(to, name, companyName, employeeName, documentName string, validUntil time.Time, self bool, lang string) error
 }
 
 type Config struct {
@@ -40,14 +44,46 @@ func (m *consoleMailer) SendOTP(to, name, otp, purpose, lang string) error {
 	return nil
 }
 
+func (m *consoleMailer) SendInvitation(to, name, companyName, inviterName, acceptURL, lang string) error {
+	content := i18n.InvitationEmail(name, companyName, inviterName, acceptURL, lang)
+	fmt.Printf("[MAILER] To: %s | Lang: %s | Subject: %s | URL: %s\n", to, lang, content.Subject, acceptURL)
+	return nil
+}
+
+func (m *consoleMailer) SendSubscriptionNotice(to, name, companyName, event, planName string, at time.Time, lang string) error {
+	content := i18n.SubscriptionEmail(event, name, companyName, planName, at, lang)
+	fmt.Printf("[MAILER] To: %s | Lang: %s | Subject: %s\n", to, lang, content.Subject)
+	return nil
+}
+
+func (m *consoleMailer) SendDocumentExpiry(to, name, companyName, employeeName, documentName string, validUntil time.Time, self bool, lang string) error {
+	content := i18n.DocumentExpiryEmail(name, companyName, employeeName, documentName, validUntil, self, lang)
+	fmt.Printf("[MAILER] To: %s | Lang: %s | Subject: %s\n", to, lang, content.Subject)
+	return nil
+}
+
 // smtpMailer — sends real emails via SMTP
 type smtpMailer struct {
 	cfg Config
 }
 
 func (m *smtpMailer) SendOTP(to, name, otp, purpose, lang string) error {
-	content := i18n.OTPEmail(name, otp, purpose, lang)
+	return m.send(to, i18n.OTPEmail(name, otp, purpose, lang))
+}
+
+func (m *smtpMailer) SendInvitation(to, name, companyName, inviterName, acceptURL, lang string) error {
+	return m.send(to, i18n.InvitationEmail(name, companyName, inviterName, acceptURL, lang))
+}
+
+func (m *smtpMailer) SendSubscriptionNotice(to, name, companyName, event, planName string, at time.Time, lang string) error {
+	return m.send(to, i18n.SubscriptionEmail(event, name, companyName, planName, at, lang))
+}
+
+func (m *smtpMailer) SendDocumentExpiry(to, name, companyName, employeeName, documentName string, validUntil time.Time, self bool, lang string) error {
+	return m.send(to, i18n.DocumentExpiryEmail(name, companyName, employeeName, documentName, validUntil, self, lang))
+}
 
+func (m *smtpMailer) send(to string, content i18n.EmailContent) error {
 	fromHeader := fmt.Sprintf("%s <%s>", m.cfg.FromName, m.cfg.From)
 	msg := []byte(fmt.Sprintf(
 		"From: %s\r\nTo: %s\r\nSubject: %s\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s",
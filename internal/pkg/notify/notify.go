@@ -0,0 +1,30 @@
+// Package notify defines the in-app notices usecases send to users. The
+// notification usecase stores them and pushes them to connected clients.
+package notify
+
+import "context"
+
+const (
+	TypeInvitationReceived = "INVITATION_RECEIVED"
+	TypeSubscription       = "SUBSCRIPTION"
+	TypeDocumentExpiring   = "DOCUMENT_EXPIRING"
+	TypeLeaveRequest       = "LEAVE_REQUEST"
+	TypeLeaveDecision      = "LEAVE_DECISION"
+)
+
+// Notice is one message for one user. CompanyID is 0 for notices that do
+// not belong to a company. Data carries identifiers the client needs to act
+// on the notice, such as an invitation token.
+type Notice struct {
+	UserID    int64
+	CompanyID int64
+	Type      string
+	Title     string
+	Body      string
+	Data      map[string]string
+}
+
+// Notifier delivers notices without failing the caller; errors are logged.
+type Notifier interface {
+	Notify(ctx context.Context, n Notice)
+}
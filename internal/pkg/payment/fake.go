@@ -0,0 +1,110 @@
+package payment
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Card tokens understood by the fake provider.
+const (
+	FakeCardSuccess = "tok_success"
+	FakeCardDecline = "tok_decline"
+)
+
+// Fake is an in-process provider for development and end-to-end testing.
+// It never touches the network: virtual accounts are derived from the
+// reference, card tokens decide the charge outcome, and payments into a
+// virtual account are produced with SimulatePayment as signed webhooks.
+type Fake struct {
+	secret string
+}
+
+func NewFake(secret string) *Fake {
+	return &Fake{secret: secret}
+}
+
+func (f *Fake) Name() string {
+	return "fake"
+}
+
+func (f *Fake) CreateVirtualAccount(ctx context.Context, req VirtualAccountRequest) (*VirtualAccount, error) {
+	number := req.Reference
+	if len(number) > 12 {
+		number = number[len(number)-12:]
+	}
+	return &VirtualAccount{
+		ProviderReference: "fakeva_" + req.Reference,
+		BankCode:          req.BankCode,
+		Number:            "8808" + number,
+		Amount:            req.Amount,
+		ExpiresAt:         req.ExpiresAt,
+	}, nil
+}
+
+func (f *Fake) ChargeCard(ctx context.Context, req CardChargeRequest) (*Charge, error) {
+	id, err := randomID()
+	if err != nil {
+		return nil, err
+	}
+	if req.Token == FakeCardDecline {
+		return &Charge{ProviderReference: "fakech_" + id, Status: ChargeFailed, FailureReason: "card declined"}, nil
+	}
+	return &Charge{ProviderReference: "fakech_" + id, Status: ChargeSucceeded}, nil
+}
+
+func (f *Fake) VerifyWebhook(body []byte, header http.Header) error {
+	return VerifyHMAC(f.secret, body, header, time.Now())
+}
+
+func (f *Fake) ParseEvent(body []byte) (*Event, error) {
+	var e Event
+	if err := json.Unmarshal(body, &e); err != nil {
+		return nil, fmt.Errorf("failed to parse event: %w", err)
+	}
+	if e.ID == "" || e.Type == "" || e.Reference == "" {
+		return nil, fmt.Errorf("incomplete event")
+	}
+	return &e, nil
+}
+
+// SimulatePayment builds the signed webhook the provider would send after
+// the customer paid amount for reference.
+func (f *Fake) SimulatePayment(reference string, amount int64, method string) ([]byte, http.Header, error) {
+	id, err := randomID()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	body, err := json.Marshal(Event{
+		ID:                "evt_" + id,
+		Type:              EventPaymentSucceeded,
+		Reference:         reference,
+		Method:            method,
+		Amount:            amount,
+		ProviderReference: "fakepay_" + id,
+		OccurredAt:        now.UTC(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header := http.Header{}
+	header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	header.Set(HeaderSignature, Sign(f.secret, now.Unix(), body))
+	return body, header, nil
+}
+
+func randomID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,133 @@
+// Package payment abstracts the payment gateway used to settle invoices.
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	EventPaymentSucceeded = "PAYMENT_SUCCEEDED"
+	EventPaymentFailed    = "PAYMENT_FAILED"
+	EventPaymentExpired   = "PAYMENT_EXPIRED"
+
+	ChargeSucceeded = "SUCCEEDED"
+	ChargePending   = "PENDING"
+	ChargeFailed    = "FAILED"
+
+	// HeaderTimestamp and HeaderSignature carry the webhook signature:
+	// hex(HMAC-SHA256(secret, timestamp + "." + body)).
+	HeaderTimestamp = "X-Payment-Timestamp"
+	HeaderSignature = "X-Payment-Signature"
+
+	// SignatureTolerance bounds how old a signed webhook may be, so captured
+	// requests cannot be replayed later.
+	SignatureTolerance = 5 * time.Minute
+)
+
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// Provider is a payment gateway. Reference is always our invoice ID, so
+// events can be matched back to invoices.
+type Provider interface {
+	Name() string
+	CreateVirtualAccount(ctx context.Context, req VirtualAccountRequest) (*VirtualAccount, error)
+	ChargeCard(ctx context.Context, req CardChargeRequest) (*Charge, error)
+	// VerifyWebhook checks the signature of a webhook request body.
+	VerifyWebhook(body []byte, header http.Header) error
+	ParseEvent(body []byte) (*Event, error)
+}
+
+// Simulator is implemented by providers that can produce webhooks for
+// payments made outside the API, such as the fake provider.
+type Simulator interface {
+	SimulatePayment(reference string, amount int64, method string) (body []byte, header http.Header, err error)
+}
+
+type VirtualAccountRequest struct {
+	Reference string
+	BankCode  string
+	Name      string
+	Amount    int64
+	ExpiresAt time.Time
+}
+
+type VirtualAccount struct {
+	ProviderReference string
+	BankCode          string
+	Number            string
+	Amount            int64
+	ExpiresAt         time.Time
+}
+
+type CardChargeRequest struct {
+	Reference string
+	Token     string
+	Amount    int64
+}
+
+type Charge struct {
+	ProviderReference string
+	Status            string
+	FailureReason     string
+}
+
+type Event struct {
+	ID                string    `json:"id"`
+	Type              string    `json:"type"`
+	Reference         string    `json:"reference"`
+	Method            string    `json:"method"`
+	Amount            int64     `json:"amount"`
+	ProviderReference string    `json:"provider_reference"`
+	OccurredAt        time.Time `json:"occurred_at"`
+}
+
+type Config struct {
+	Provider      string // fake
+	WebhookSecret string
+}
+
+func New(cfg Config) (Provider, error) {
+	switch cfg.Provider {
+	case "fake", "":
+		return NewFake(cfg.WebhookSecret), nil
+	}
+	return nil, fmt.Errorf("unknown payment provider %q", cfg.Provider)
+}
+
+// Sign computes the webhook signature of body sent at timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyHMAC checks the HeaderTimestamp/HeaderSignature pair against body.
+func VerifyHMAC(secret string, body []byte, header http.Header, now time.Time) error {
+	ts, err := strconv.ParseInt(header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if d := now.Sub(time.Unix(ts, 0)); d > SignatureTolerance || d < -SignatureTolerance {
+		return ErrInvalidSignature
+	}
+
+	got, err := hex.DecodeString(header.Get(HeaderSignature))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	want, _ := hex.DecodeString(Sign(secret, ts, body))
+	if !hmac.Equal(got, want) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+// Package pdf writes simple single-font-family PDF documents (text and
+// lines on A4 pages) without external dependencies. It covers what the
+// application renders itself, such as invoices.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A4 page size in points.
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Font selects one of the standard Type 1 fonts every PDF reader provides.
+type Font string
+
+const (
+	Helvetica     Font = "F1"
+	HelveticaBold Font = "F2"
+	// Courier is monospaced, which makes right-aligned amounts easy.
+	Courier Font = "F3"
+)
+
+var fontNames = []struct {
+	res  Font
+	name string
+}{
+	{Helvetica, "Helvetica"},
+	{HelveticaBold, "Helvetica-Bold"},
+	{Courier, "Courier"},
+}
+
+type Document struct {
+	pages []*Page
+}
+
+type Page struct {
+	content bytes.Buffer
+}
+
+func New() *Document {
+	return &Document{}
+}
+
+// AddPage appends an A4 page. Coordinates on the page are in points from
+// the top-left corner.
+func (d *Document) AddPage() *Page {
+	p := &Page{}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+// Text draws s with its baseline starting at (x, y).
+func (p *Page) Text(x, y float64, font Font, size float64, s string) {
+	fmt.Fprintf(&p.content, "BT /%s %.2f Tf %.2f %.2f Td (%s) Tj ET\n",
+		font, size, x, PageHeight-y, escape(s))
+}
+
+// TextRight draws s in Courier so that it ends at x.
+func (p *Page) TextRight(x, y, size float64, s string) {
+	width := float64(len([]rune(s))) * size * 0.6
+	p.Text(x-width, y, Courier, size, s)
+}
+
+// Line draws a straight line of the given width.
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%.2f w %.2f %.2f m %.2f %.2f l S\n",
+		width, x1, PageHeight-y1, x2, PageHeight-y2)
+}
+
+// WriteTo writes the document in PDF 1.4 format.
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	var offsets []int
+
+	obj := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	pages := d.pages
+	if len(pages) == 0 {
+		pages = []*Page{{}}
+	}
+
+	// Object layout: 1 catalog, 2 page tree, fonts, then a page and its
+	// content stream for every page.
+	firstFont := 3
+	firstPage := firstFont + len(fontNames)
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	obj("<< /Type /Catalog /Pages 2 0 R >>")
+
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+i*2)
+	}
+	obj(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+
+	var fonts []string
+	for i, f := range fontNames {
+		obj(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", f.name))
+		fonts = append(fonts, fmt.Sprintf("/%s %d 0 R", f.res, firstFont+i))
+	}
+
+	for i, p := range pages {
+		obj(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << %s >> >> /Contents %d 0 R >>",
+			PageWidth, PageHeight, strings.Join(fonts, " "), firstPage+i*2+1,
+		))
+		obj(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", p.content.Len(), p.content.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.WriteTo(w)
+}
+
+// Bytes returns the encoded document.
+func (d *Document) Bytes() []byte {
+	var buf bytes.Buffer
+	_, _ = d.WriteTo(&buf)
+	return buf.Bytes()
+}
+
+// escape converts s to a WinAnsi string literal body. Characters outside
+// Latin-1 are replaced with '?'.
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(byte(r))
+		case r == '\n' || r == '\r' || r == '\t':
+			b.WriteByte(' ')
+		case r < 0x20 || r > 0xff:
+			b.WriteByte('?')
+		case r < 0x80:
+			b.WriteByte(byte(r))
+		default:
+			fmt.Fprintf(&b, "\\%03o", r)
+		}
+	}
+	return b.String()
+}
@@ -49,12 +49,212 @@ const (
 	ErrAlreadyCompanyMember     = "ALREADY_COMPANY_MEMBER"
 	ErrNotCompanyMember         = "NOT_COMPANY_MEMBER"
 	ErrCannotLeaveOwnCompany    = "CANNOT_LEAVE_OWN_COMPANY"
+
+	ErrAddressNotFound          = "ADDRESS_NOT_FOUND"
+	ErrInvalidAddressID         = "INVALID_ADDRESS_ID"
+	ErrAddressInUse             = "ADDRESS_IN_USE"
+	ErrPrimaryAddressMustBeMain = "PRIMARY_ADDRESS_MUST_BE_MAIN"
+	ErrPrimaryAddressRequired   = "PRIMARY_ADDRESS_REQUIRED"
+
+	ErrSettingNotFound     = "SETTING_NOT_FOUND"
+	ErrInvalidSettingValue = "INVALID_SETTING_VALUE"
+
+	ErrDivisionNotFound             = "DIVISION_NOT_FOUND"
+	ErrDivisionNotAvailable         = "DIVISION_NOT_AVAILABLE"
+	ErrDivisionCodeAlreadyExists    = "DIVISION_CODE_ALREADY_EXISTS"
+	ErrDivisionHasActiveEmployees   = "DIVISION_HAS_ACTIVE_EMPLOYEES"
+	ErrDivisionInUse                = "DIVISION_IN_USE"
+	ErrInvalidDivisionID            = "INVALID_DIVISION_ID"
+	ErrDepartmentNotFound           = "DEPARTMENT_NOT_FOUND"
+	ErrDepartmentNotAvailable       = "DEPARTMENT_NOT_AVAILABLE"
+	ErrDepartmentCodeAlreadyExists  = "DEPARTMENT_CODE_ALREADY_EXISTS"
+	ErrDepartmentHasActiveEmployees = "DEPARTMENT_HAS_ACTIVE_EMPLOYEES"
+	ErrDepartmentInUse              = "DEPARTMENT_IN_USE"
+	ErrInvalidDepartmentID          = "INVALID_DEPARTMENT_ID"
+	ErrPositionNotFound             = "POSITION_NOT_FOUND"
+	ErrPositionCodeAlreadyExists    = "POSITION_CODE_ALREADY_EXISTS"
+	ErrPositionHasActiveEmployees   = "POSITION_HAS_ACTIVE_EMPLOYEES"
+	ErrInvalidPositionID            = "INVALID_POSITION_ID"
+	ErrEmployeeNotActive            = "EMPLOYEE_NOT_ACTIVE"
+
+	ErrNotificationNotFound  = "NOTIFICATION_NOT_FOUND"
+	ErrInvalidNotificationID = "INVALID_NOTIFICATION_ID"
+
+	ErrIndustryNotFound             = "INDUSTRY_NOT_FOUND"
+	ErrIndustryNotAvailable         = "INDUSTRY_NOT_AVAILABLE"
+	ErrIndustryCodeAlreadyExists    = "INDUSTRY_CODE_ALREADY_EXISTS"
+	ErrIndustryNameAlreadyExists    = "INDUSTRY_NAME_ALREADY_EXISTS"
+	ErrInvalidIndustryID            = "INVALID_INDUSTRY_ID"
+	ErrCompanyTypeNotFound          = "COMPANY_TYPE_NOT_FOUND"
+	ErrCompanyTypeNotAvailable      = "COMPANY_TYPE_NOT_AVAILABLE"
+	ErrCompanyTypeCodeAlreadyExists = "COMPANY_TYPE_CODE_ALREADY_EXISTS"
+	ErrCompanyTypeNameAlreadyExists = "COMPANY_TYPE_NAME_ALREADY_EXISTS"
+	ErrInvalidCompanyTypeID         = "INVALID_COMPANY_TYPE_ID"
+
+	ErrRoleNotFound      = "ROLE_NOT_FOUND"
+	ErrRoleNotAssignable = "ROLE_NOT_ASSIGNABLE"
+
+	ErrEmployeeNotFound            = "EMPLOYEE_NOT_FOUND"
+	ErrEmployeeAlreadyLinked       = "EMPLOYEE_ALREADY_LINKED"
+	ErrInvalidEmployeeID           = "INVALID_EMPLOYEE_ID"
+	ErrEmployeeEmailAlreadyExists  = "EMPLOYEE_EMAIL_ALREADY_EXISTS"
+	ErrEmployeeNumberAlreadyExists = "EMPLOYEE_NUMBER_ALREADY_EXISTS"
+	ErrEmployeeNumberRequired      = "EMPLOYEE_NUMBER_REQUIRED"
+	ErrEmployeeNumberNotAvailable  = "EMPLOYEE_NUMBER_NOT_AVAILABLE"
+	ErrDepartmentNotInDivision     = "DEPARTMENT_NOT_IN_DIVISION"
+	ErrTerminationBeforeHire       = "TERMINATION_BEFORE_HIRE"
+	ErrInvalidStatusTransition     = "INVALID_STATUS_TRANSITION"
+	ErrCannotChangeOwnerStatus     = "CANNOT_CHANGE_OWNER_STATUS"
+	ErrNationalIDRegionNotFound    = "NATIONAL_ID_REGION_NOT_FOUND"
+	ErrNationalIDBirthDateMismatch = "NATIONAL_ID_BIRTH_DATE_MISMATCH"
+	ErrNationalIDGenderMismatch    = "NATIONAL_ID_GENDER_MISMATCH"
+	ErrNationalIDAlreadyExists     = "NATIONAL_ID_ALREADY_EXISTS"
+	ErrTaxIDNationalIDMismatch     = "TAX_ID_NATIONAL_ID_MISMATCH"
+	ErrInvalidEmployeePositionID   = "INVALID_EMPLOYEE_POSITION_ID"
+	ErrEmployeePositionNotFound    = "EMPLOYEE_POSITION_NOT_FOUND"
+	ErrPositionNotAvailable        = "POSITION_NOT_AVAILABLE"
+	ErrNoPrimaryPosition           = "NO_PRIMARY_POSITION"
+	ErrPositionUnchanged           = "POSITION_UNCHANGED"
+	ErrInvalidEffectiveDate        = "INVALID_EFFECTIVE_DATE"
+	ErrPositionLevelNotHigher      = "POSITION_LEVEL_NOT_HIGHER"
+	ErrPositionLevelNotLower       = "POSITION_LEVEL_NOT_LOWER"
+	ErrPositionAlreadyAssigned     = "POSITION_ALREADY_ASSIGNED"
+	ErrPrimaryPositionOverlaps     = "PRIMARY_POSITION_OVERLAPS"
+	ErrEndDateBeforeStartDate      = "END_DATE_BEFORE_START_DATE"
+	ErrBankAccountNotFound         = "BANK_ACCOUNT_NOT_FOUND"
+	ErrInvalidBankAccountID        = "INVALID_BANK_ACCOUNT_ID"
+	ErrBankAccountAlreadyExists    = "BANK_ACCOUNT_ALREADY_EXISTS"
+	ErrBankNotFound                = "BANK_NOT_FOUND"
+	ErrInvalidAccountNumber        = "INVALID_ACCOUNT_NUMBER"
+	ErrEmergencyContactNotFound    = "EMERGENCY_CONTACT_NOT_FOUND"
+	ErrInvalidEmergencyContactID   = "INVALID_EMERGENCY_CONTACT_ID"
+	ErrInvalidPhoneNumber          = "INVALID_PHONE_NUMBER"
+	ErrEmployeeAddressNotFound     = "EMPLOYEE_ADDRESS_NOT_FOUND"
+	ErrInvalidEmployeeAddressID    = "INVALID_EMPLOYEE_ADDRESS_ID"
+	ErrWorkLocationNotFound        = "WORK_LOCATION_NOT_FOUND"
+	ErrInvalidWorkLocationID       = "INVALID_WORK_LOCATION_ID"
+	ErrAddressNotAvailable         = "ADDRESS_NOT_AVAILABLE"
+	ErrNoPrimaryWorkLocation       = "NO_PRIMARY_WORK_LOCATION"
+	ErrWorkLocationUnchanged       = "WORK_LOCATION_UNCHANGED"
+	ErrWorkLocationAlreadyAssigned = "WORK_LOCATION_ALREADY_ASSIGNED"
+	ErrPrimaryWorkLocationOverlaps = "PRIMARY_WORK_LOCATION_OVERLAPS"
+
+	ErrDocumentNotFound        = "DOCUMENT_NOT_FOUND"
+	ErrInvalidDocumentID       = "INVALID_DOCUMENT_ID"
+	ErrDocumentFileNotFound    = "DOCUMENT_FILE_NOT_FOUND"
+	ErrDocumentAlreadyVerified = "DOCUMENT_ALREADY_VERIFIED"
+	ErrDocumentAlreadyRejected = "DOCUMENT_ALREADY_REJECTED"
+	ErrFileRequired            = "FILE_REQUIRED"
+	ErrFileEmpty               = "FILE_EMPTY"
+	ErrFileTooLarge            = "FILE_TOO_LARGE"
+	ErrUnsupportedFileType     = "UNSUPPORTED_FILE_TYPE"
+	ErrInvalidValidityPeriod   = "INVALID_VALIDITY_PERIOD"
+
+	ErrImportNotFound    = "IMPORT_NOT_FOUND"
+	ErrInvalidImportID   = "INVALID_IMPORT_ID"
+	ErrImportNotReady    = "IMPORT_NOT_READY"
+	ErrImportEmpty       = "IMPORT_EMPTY"
+	ErrTooManyRows       = "TOO_MANY_ROWS"
+	ErrInvalidImportFile = "INVALID_IMPORT_FILE"
+	ErrUnknownColumn     = "UNKNOWN_COLUMN"
+
+	ErrLeaveTypeNotFound          = "LEAVE_TYPE_NOT_FOUND"
+	ErrInvalidLeaveTypeID         = "INVALID_LEAVE_TYPE_ID"
+	ErrLeaveTypeCodeAlreadyExists = "LEAVE_TYPE_CODE_ALREADY_EXISTS"
+	ErrLeaveTypeInactive          = "LEAVE_TYPE_INACTIVE"
+	ErrLeaveTypeInUse             = "LEAVE_TYPE_IN_USE"
+	ErrLeaveTypeHasNoBalance      = "LEAVE_TYPE_HAS_NO_BALANCE"
+	ErrLeaveRequestNotFound       = "LEAVE_REQUEST_NOT_FOUND"
+	ErrInvalidLeaveRequestID      = "INVALID_LEAVE_REQUEST_ID"
+	ErrLeaveRequestNotPending     = "LEAVE_REQUEST_NOT_PENDING"
+	ErrLeaveRequestNotCancellable = "LEAVE_REQUEST_NOT_CANCELLABLE"
+	ErrLeaveAlreadyStarted        = "LEAVE_ALREADY_STARTED"
+	ErrLeaveOverlaps              = "LEAVE_OVERLAPS"
+	ErrLeaveSpansYears            = "LEAVE_SPANS_YEARS"
+	ErrHolidayCalendarUnavailable = "HOLIDAY_CALENDAR_UNAVAILABLE"
+	ErrNoWorkingDaysInLeave       = "NO_WORKING_DAYS_IN_LEAVE"
+	ErrLeaveExceedsMaxDays        = "LEAVE_EXCEEDS_MAX_DAYS"
+	ErrInsufficientLeaveBalance   = "INSUFFICIENT_LEAVE_BALANCE"
+	ErrNotAnApprover              = "NOT_AN_APPROVER"
+	ErrLeaveAccessDenied          = "LEAVE_ACCESS_DENIED"
+
+	ErrShiftNotFound             = "SHIFT_NOT_FOUND"
+	ErrInvalidShiftID            = "INVALID_SHIFT_ID"
+	ErrShiftCodeAlreadyExists    = "SHIFT_CODE_ALREADY_EXISTS"
+	ErrShiftInactive             = "SHIFT_INACTIVE"
+	ErrShiftInUse                = "SHIFT_IN_USE"
+	ErrDefaultShiftMustBeActive  = "DEFAULT_SHIFT_MUST_BE_ACTIVE"
+	ErrShiftAssignmentNotFound   = "SHIFT_ASSIGNMENT_NOT_FOUND"
+	ErrInvalidShiftAssignmentID  = "INVALID_SHIFT_ASSIGNMENT_ID"
+	ErrAttendanceRecordNotFound  = "ATTENDANCE_RECORD_NOT_FOUND"
+	ErrInvalidAttendanceRecordID = "INVALID_ATTENDANCE_RECORD_ID"
+	ErrAlreadyClockedIn          = "ALREADY_CLOCKED_IN"
+	ErrAlreadyClockedOut         = "ALREADY_CLOCKED_OUT"
+	ErrNotClockedIn              = "NOT_CLOCKED_IN"
+	ErrOutsideWorkLocation       = "OUTSIDE_WORK_LOCATION"
+	ErrNoGeofencedWorkLocation   = "NO_GEOFENCED_WORK_LOCATION"
+	ErrAttendanceDateInFuture    = "ATTENDANCE_DATE_IN_FUTURE"
+	ErrClockOutBeforeClockIn     = "CLOCK_OUT_BEFORE_CLOCK_IN"
+	ErrAttendanceAccessDenied    = "ATTENDANCE_ACCESS_DENIED"
+
+	ErrInvitationNotFound       = "INVITATION_NOT_FOUND"
+	ErrInvalidInvitationID      = "INVALID_INVITATION_ID"
+	ErrInvitationAlreadyPending = "INVITATION_ALREADY_PENDING"
+	ErrInvitationNotPending     = "INVITATION_NOT_PENDING"
+	ErrInvitationExpired        = "INVITATION_EXPIRED"
+	ErrInvitationEmailMismatch  = "INVITATION_EMAIL_MISMATCH"
+
+	ErrPlanNotFound               = "PLAN_NOT_FOUND"
+	ErrInvalidPlanID              = "INVALID_PLAN_ID"
+	ErrPlanCodeAlreadyExists      = "PLAN_CODE_ALREADY_EXISTS"
+	ErrPlanNotAvailable           = "PLAN_NOT_AVAILABLE"
+	ErrPlanUnchanged              = "PLAN_UNCHANGED"
+	ErrModuleNotFound             = "MODULE_NOT_FOUND"
+	ErrInvalidModuleID            = "INVALID_MODULE_ID"
+	ErrModuleNotEnabled           = "MODULE_NOT_ENABLED"
+	ErrInvalidExpiry              = "INVALID_EXPIRY"
+	ErrSubscriptionNotFound       = "SUBSCRIPTION_NOT_FOUND"
+	ErrSubscriptionNotChangeable  = "SUBSCRIPTION_NOT_CHANGEABLE"
+	ErrSubscriptionNotCancellable = "SUBSCRIPTION_NOT_CANCELLABLE"
+	ErrSubscriptionNotCancelled   = "SUBSCRIPTION_NOT_CANCELLED"
+	ErrUserLimitReached           = "USER_LIMIT_REACHED"
+	ErrEmployeeLimitReached       = "EMPLOYEE_LIMIT_REACHED"
+	ErrStorageQuotaExceeded       = "STORAGE_QUOTA_EXCEEDED"
+
+	ErrInvoiceNotFound    = "INVOICE_NOT_FOUND"
+	ErrInvalidInvoiceID   = "INVALID_INVOICE_ID"
+	ErrInvoicePDFNotReady = "INVOICE_PDF_NOT_READY"
+	ErrInvoiceNotPayable  = "INVOICE_NOT_PAYABLE"
+
+	ErrPaymentMethodNotSupported    = "PAYMENT_METHOD_NOT_SUPPORTED"
+	ErrPaymentFailed                = "PAYMENT_FAILED"
+	ErrPaymentInProgress            = "PAYMENT_IN_PROGRESS"
+	ErrPaymentSimulationUnavailable = "PAYMENT_SIMULATION_UNAVAILABLE"
+	ErrUnknownPaymentProvider       = "UNKNOWN_PAYMENT_PROVIDER"
+	ErrInvalidSignature             = "INVALID_SIGNATURE"
+	ErrInvalidPaymentEvent          = "INVALID_PAYMENT_EVENT"
+
+	ErrRegionNotFound    = "REGION_NOT_FOUND"
+	ErrInvalidRegionID   = "INVALID_REGION_ID"
+	ErrInvalidRegionCode = "INVALID_REGION_CODE"
+	ErrRegionMismatch    = "REGION_MISMATCH"
 )
 
 type SuccessResponse struct {
 	Data interface{} `json:"data"`
 }
 
+type PageMeta struct {
+	Total  int64 `json:"total"`
+	Offset int   `json:"offset"`
+	Limit  int   `json:"limit"`
+}
+
+type PaginatedResponse struct {
+	Data interface{} `json:"data"`
+	Meta PageMeta    `json:"meta"`
+}
+
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
@@ -65,6 +265,13 @@ func Success(c echo.Context, data interface{}) error {
 	})
 }
 
+func Paginated(c echo.Context, data interface{}, total int64, offset, limit int) error {
+	return c.JSON(http.StatusOK, PaginatedResponse{
+		Data: data,
+		Meta: PageMeta{Total: total, Offset: offset, Limit: limit},
+	})
+}
+
 func Created(c echo.Context, data interface{}) error {
 	return c.JSON(http.StatusCreated, SuccessResponse{
 		Data: data,
@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrNotFound is returned by Open when no object exists under the key.
+var ErrNotFound = errors.New("object not found")
+
+// Storage keeps binary objects such as uploaded documents and rendered
+// invoices under slash-separated keys.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type Config struct {
+	Driver   string // local
+	LocalDir string
+}
+
+func New(cfg Config) (Storage, error) {
+	switch cfg.Driver {
+	case "local", "":
+		return NewLocal(cfg.LocalDir)
+	}
+	return nil, fmt.Errorf("unknown storage driver %q", cfg.Driver)
+}
@@ -0,0 +1,77 @@
+// Package table writes exports as CSV or XLSX through one interface. CSV
+// cells that a spreadsheet would evaluate as a formula are neutralised;
+// XLSX cells are written as text and never evaluated.
+package table
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"github.com/haily-id/engine/internal/pkg/xlsx"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// formulaPrefixes are the leading characters that make spreadsheets read
+// a CSV cell as a formula.
+const formulaPrefixes = "=+-@\t\r"
+
+// Writer writes rows of text cells. Call Close to finish the file.
+type Writer interface {
+	Write(cells []string) error
+	Close() error
+}
+
+// NewWriter starts a table in format, CSV unless it is FormatXLSX.
+// sheetName names the XLSX worksheet.
+func NewWriter(w io.Writer, format, sheetName string) (Writer, error) {
+	if format == FormatXLSX {
+		xw, err := xlsx.NewWriter(w, sheetName)
+		if err != nil {
+			return nil, err
+		}
+		return xw, nil
+	}
+	return &csvWriter{w: csv.NewWriter(w)}, nil
+}
+
+// Escape prefixes a cell that would start a formula with an apostrophe,
+// which spreadsheets show as text.
+func Escape(s string) string {
+	if s != "" && strings.ContainsRune(formulaPrefixes, rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// Unescape reverses Escape, so exported files can be imported again.
+func Unescape(s string) string {
+	if len(s) > 1 && s[0] == '\'' && strings.ContainsRune(formulaPrefixes, rune(s[1])) {
+		return s[1:]
+	}
+	return s
+}
+
+// csvWriter escapes cells and flushes on Close so both formats end the
+// same way.
+type csvWriter struct {
+	w   *csv.Writer
+	row []string
+}
+
+func (w *csvWriter) Write(cells []string) error {
+	w.row = w.row[:0]
+	for _, c := range cells {
+		w.row = append(w.row, Escape(c))
+	}
+	return w.w.Write(w.row)
+}
+
+func (w *csvWriter) Close() error {
+	w.w.Flush()
+	return w.w.Error()
+}
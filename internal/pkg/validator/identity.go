@@ -0,0 +1,148 @@
+package validator
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// NIK is a parsed Nomor Induk Kependudukan, the 16-digit number on a KTP:
+//
+//	PP CC DD  dd mm yy  ssss
+//
+// province, city and district codes, birth date (day plus 40 for women)
+// and a serial. Region codes use the dotted full_code form of the regional
+// tables.
+type NIK struct {
+	ProvinceCode string
+	CityCode     string
+	DistrictCode string
+	BirthDate    time.Time
+	Female       bool
+	Serial       string
+}
+
+// ParseNIK checks the structure of a NIK and decodes it. The two-digit
+// birth year is placed in the latest century that keeps it in the past.
+func ParseNIK(s string) (*NIK, error) {
+	if len(s) != 16 || !digits(s) {
+		return nil, errors.New("NIK must be 16 digits")
+	}
+
+	province, city, district := s[0:2], s[2:4], s[4:6]
+	if province < "11" || city == "00" || district == "00" {
+		return nil, errors.New("invalid NIK region code")
+	}
+
+	day, _ := strconv.Atoi(s[6:8])
+	month, _ := strconv.Atoi(s[8:10])
+	year, _ := strconv.Atoi(s[10:12])
+	female := day > 40
+	if female {
+		day -= 40
+	}
+
+	birth, ok := date(2000+year, month, day)
+	if ok && birth.After(time.Now()) {
+		birth, ok = date(1900+year, month, day)
+	}
+	if !ok {
+		return nil, errors.New("invalid NIK birth date")
+	}
+
+	if s[12:16] == "0000" {
+		return nil, errors.New("invalid NIK serial")
+	}
+
+	return &NIK{
+		ProvinceCode: province,
+		CityCode:     province + "." + city,
+		DistrictCode: province + "." + city + "." + district,
+		BirthDate:    birth,
+		Female:       female,
+		Serial:       s[12:16],
+	}, nil
+}
+
+// NormalizeNPWP strips the dots, dashes and spaces of a formatted NPWP
+// (09.254.294.3-407.000) and returns its digits if they form a valid
+// 15- or 16-digit NPWP. Since 2024 the 16-digit NPWP of an individual is
+// their NIK; other taxpayers prefix the old 15 digits with 0.
+func NormalizeNPWP(s string) (string, bool) {
+	n := strings.NewReplacer(".", "", "-", "", " ", "").Replace(s)
+	if !digits(n) {
+		return "", false
+	}
+	switch len(n) {
+	case 15:
+		return n, true
+	case 16:
+		if n[0] == '0' {
+			return n, true
+		}
+		if _, err := ParseNIK(n); err == nil {
+			return n, true
+		}
+	}
+	return "", false
+}
+
+// OptionalNPWP prepares an NPWP for storage: digits only when valid,
+// trimmed otherwise; nil and blank mean none.
+func OptionalNPWP(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := strings.TrimSpace(*s)
+	if v == "" {
+		return nil
+	}
+	if n, ok := NormalizeNPWP(v); ok {
+		return &n
+	}
+	return &v
+}
+
+// ─── Tags ───────────────────────────────────────────────────────
+
+func registerIdentity(v *validator.Validate) {
+	_ = v.RegisterValidation("nik", func(fl validator.FieldLevel) bool {
+		_, err := ParseNIK(fl.Field().String())
+		return err == nil
+	})
+	_ = v.RegisterValidation("npwp", func(fl validator.FieldLevel) bool {
+		_, ok := NormalizeNPWP(fl.Field().String())
+		return ok
+	})
+	_ = v.RegisterValidation("npwp15", func(fl validator.FieldLevel) bool {
+		n, ok := NormalizeNPWP(fl.Field().String())
+		return ok && len(n) == 15
+	})
+	_ = v.RegisterValidation("npwp16", func(fl validator.FieldLevel) bool {
+		n, ok := NormalizeNPWP(fl.Field().String())
+		return ok && len(n) == 16
+	})
+}
+
+func digits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func date(year, month, day int) (time.Time, bool) {
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if month < 1 || month > 12 || t.Day() != day {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,52 @@
+package validator
+
+import (
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// NormalizePhone returns a phone number in E.164 form (+6281234567890).
+// Spaces, dots, dashes and parentheses are ignored. Numbers without a
+// leading + are Indonesian: a trunk 0 or a bare 8xx mobile prefix becomes
+// +62. Indonesian numbers have 8 to 12 digits after the country code,
+// others 8 to 15 digits in total.
+func NormalizePhone(s string) (string, bool) {
+	n := strings.NewReplacer(" ", "", ".", "", "-", "", "(", "", ")", "").Replace(strings.TrimSpace(s))
+	international := strings.HasPrefix(n, "+")
+	n = strings.TrimPrefix(n, "+")
+	if !digits(n) {
+		return "", false
+	}
+
+	if !international {
+		switch {
+		case strings.HasPrefix(n, "62"):
+		case strings.HasPrefix(n, "0"):
+			n = "62" + n[1:]
+		case strings.HasPrefix(n, "8"):
+			n = "62" + n
+		default:
+			return "", false
+		}
+	}
+
+	if strings.HasPrefix(n, "62") {
+		national := n[2:]
+		if len(national) < 8 || len(national) > 12 || national[0] == '0' {
+			return "", false
+		}
+	} else if len(n) < 8 || len(n) > 15 || n[0] == '0' {
+		return "", false
+	}
+	return "+" + n, true
+}
+
+// ─── Tags ───────────────────────────────────────────────────────
+
+func registerPhone(v *validator.Validate) {
+	_ = v.RegisterValidation("phone", func(fl validator.FieldLevel) bool {
+		_, ok := NormalizePhone(fl.Field().String())
+		return ok
+	})
+}
@@ -12,6 +12,8 @@ var validate *validator.Validate
 
 func Init() {
 	validate = validator.New()
+	registerIdentity(validate)
+	registerPhone(validate)
 }
 
 func Validate(i interface{}) error {
@@ -50,6 +52,12 @@ func formatFieldError(fieldError validator.FieldError) string {
 		return fmt.Sprintf("%s must be greater than or equal to %s", field, fieldError.Param())
 	case "lte":
 		return fmt.Sprintf("%s must be less than or equal to %s", field, fieldError.Param())
+	case "nik":
+		return fmt.Sprintf("%s must be a valid 16-digit NIK", field)
+	case "npwp", "npwp15", "npwp16":
+		return fmt.Sprintf("%s must be a valid NPWP", field)
+	case "phone":
+		return fmt.Sprintf("%s must be a valid phone number", field)
 	default:
 		return fmt.Sprintf("%s is invalid", field)
 	}
@@ -0,0 +1,397 @@
+// Package xlsx reads and writes the cell text of simple Office Open XML
+// spreadsheets without external dependencies. Reading takes the first
+// worksheet only; writing produces a single worksheet of text cells. It
+// covers what the application exchanges with spreadsheets, such as
+// employee imports and exports.
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ContentType is the media type of .xlsx files.
+const ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// maxPartSize bounds how much of one part is decompressed, so a small
+// upload cannot expand without limit.
+const maxPartSize = 64 << 20
+
+// MaxColumns is the widest worksheet Excel allows, column XFD.
+const MaxColumns = 16384
+
+// ErrTooManyRows is returned by Read for worksheets with rows past the
+// limit it was given.
+var ErrTooManyRows = errors.New("too many rows")
+
+// ─── Reading ────────────────────────────────────────────────────
+
+// Read returns the cell text of the first worksheet row by row. Rows and
+// cells left out of the file come back empty, so rows[i] is spreadsheet
+// row i+1 and rows[i][j] is column j+1. Numbers are returned as stored;
+// dates typed as dates arrive as Excel serial numbers. A row numbered
+// above maxRows fails with ErrTooManyRows, and a cell past MaxColumns is
+// invalid, so sparse references cannot inflate the result.
+func Read(r io.ReaderAt, size int64, maxRows int) ([][]string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, errors.New("not an xlsx file")
+	}
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	sheet, err := firstSheet(files)
+	if err != nil {
+		return nil, err
+	}
+	var shared []string
+	if f, ok := files["xl/sharedStrings.xml"]; ok {
+		if shared, err = readSharedStrings(f); err != nil {
+			return nil, err
+		}
+	}
+
+	f, ok := files[sheet]
+	if !ok {
+		return nil, fmt.Errorf("worksheet %s missing", sheet)
+	}
+	return readSheet(f, shared, maxRows)
+}
+
+type xmlText struct {
+	T string `xml:"t"`
+	R []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (x xmlText) String() string {
+	if len(x.R) == 0 {
+		return x.T
+	}
+	var b strings.Builder
+	for _, r := range x.R {
+		b.WriteString(r.T)
+	}
+	return b.String()
+}
+
+type xmlCell struct {
+	Ref    string  `xml:"r,attr"`
+	Type   string  `xml:"t,attr"`
+	Value  string  `xml:"v"`
+	Inline xmlText `xml:"is"`
+}
+
+type xmlRow struct {
+	Num   int       `xml:"r,attr"`
+	Cells []xmlCell `xml:"c"`
+}
+
+// firstSheet resolves the part name of the workbook's first worksheet.
+func firstSheet(files map[string]*zip.File) (string, error) {
+	var workbook struct {
+		Sheets []struct {
+			RID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	if err := decodePart(files, "xl/workbook.xml", &workbook); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", errors.New("workbook has no worksheets")
+	}
+
+	var rels struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	if err := decodePart(files, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return "", err
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].RID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return "", errors.New("worksheet relationship missing")
+}
+
+func readSharedStrings(f *zip.File) ([]string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var shared []string
+	dec := xml.NewDecoder(io.LimitReader(rc, maxPartSize))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return shared, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid shared strings: %w", err)
+		}
+		if se, ok := tok.(xml.StartElement); ok && se.Name.Local == "si" {
+			var si xmlText
+			if err := dec.DecodeElement(&si, &se); err != nil {
+				return nil, fmt.Errorf("invalid shared strings: %w", err)
+			}
+			shared = append(shared, si.String())
+		}
+	}
+}
+
+func readSheet(f *zip.File, shared []string, maxRows int) ([][]string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var rows [][]string
+	dec := xml.NewDecoder(io.LimitReader(rc, maxPartSize))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid worksheet: %w", err)
+		}
+		se, ok := tok.(xml.StartElement)
+		if !ok || se.Name.Local != "row" {
+			continue
+		}
+
+		var row xmlRow
+		if err := dec.DecodeElement(&row, &se); err != nil {
+			return nil, fmt.Errorf("invalid worksheet: %w", err)
+		}
+		num := row.Num
+		if num <= len(rows) {
+			num = len(rows) + 1
+		}
+		if num > maxRows {
+			return nil, ErrTooManyRows
+		}
+		for len(rows) < num-1 {
+			rows = append(rows, nil)
+		}
+
+		var cells []string
+		for _, c := range row.Cells {
+			col := len(cells)
+			if c.Ref != "" {
+				if col, err = columnIndex(c.Ref); err != nil {
+					return nil, err
+				}
+			}
+			for len(cells) < col {
+				cells = append(cells, "")
+			}
+			text, err := cellText(c, shared)
+			if err != nil {
+				return nil, err
+			}
+			if col < len(cells) {
+				cells[col] = text
+			} else {
+				cells = append(cells, text)
+			}
+		}
+		rows = append(rows, cells)
+	}
+}
+
+func cellText(c xmlCell, shared []string) (string, error) {
+	switch c.Type {
+	case "s":
+		i, err := strconv.Atoi(strings.TrimSpace(c.Value))
+		if err != nil || i < 0 || i >= len(shared) {
+			return "", fmt.Errorf("invalid shared string in %s", c.Ref)
+		}
+		return shared[i], nil
+	case "inlineStr":
+		return c.Inline.String(), nil
+	case "b":
+		if c.Value == "1" {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	default:
+		return c.Value, nil
+	}
+}
+
+// columnIndex turns the letters of a cell reference such as "AB12" into a
+// zero-based column index. Columns past MaxColumns are invalid.
+func columnIndex(ref string) (int, error) {
+	col := 0
+	for i := 0; i < len(ref); i++ {
+		ch := ref[i]
+		if ch < 'A' || ch > 'Z' {
+			if i == 0 {
+				break
+			}
+			return col - 1, nil
+		}
+		col = col*26 + int(ch-'A') + 1
+		if col > MaxColumns {
+			break
+		}
+	}
+	return 0, fmt.Errorf("invalid cell reference %q", ref)
+}
+
+// columnName turns a zero-based column index into letters, 0 being "A".
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+func decodePart(files map[string]*zip.File, name string, v interface{}) error {
+	f, ok := files[name]
+	if !ok {
+		return fmt.Errorf("%s missing", name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	if err := xml.NewDecoder(io.LimitReader(rc, maxPartSize)).Decode(v); err != nil {
+		return fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return nil
+}
+
+// ─── Writing ────────────────────────────────────────────────────
+
+// Writer streams rows of text cells into a one-sheet workbook. Call Close
+// to finish the file.
+type Writer struct {
+	zw    *zip.Writer
+	sheet io.Writer
+	row   int
+}
+
+// NewWriter starts a workbook whose only worksheet is named sheetName.
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	zw := zip.NewWriter(w)
+	parts := []struct{ name, body string }{
+		{"[Content_Types].xml", contentTypesXML},
+		{"_rels/.rels", rootRelsXML},
+		{"xl/workbook.xml", fmt.Sprintf(workbookXML, escape(sheetName))},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXML},
+		{"xl/styles.xml", stylesXML},
+	}
+	for _, p := range parts {
+		pw, err := zw.Create(p.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(pw, p.body); err != nil {
+			return nil, err
+		}
+	}
+
+	sheet, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(sheet, sheetHeaderXML); err != nil {
+		return nil, err
+	}
+	return &Writer{zw: zw, sheet: sheet}, nil
+}
+
+// Write appends a row. Every cell is written as text, so values such as
+// NIKs keep their leading zeros and all their digits.
+func (w *Writer) Write(cells []string) error {
+	w.row++
+	var b strings.Builder
+	fmt.Fprintf(&b, `<row r="%d">`, w.row)
+	for i, c := range cells {
+		if c == "" {
+			continue
+		}
+		fmt.Fprintf(&b, `<c r="%s%d" t="inlineStr"><is><t xml:space="preserve">`, columnName(i), w.row)
+		b.WriteString(escape(c))
+		b.WriteString(`</t></is></c>`)
+	}
+	b.WriteString(`</row>`)
+	_, err := io.WriteString(w.sheet, b.String())
+	return err
+}
+
+// Close finishes the worksheet and the file. It does not close the
+// underlying writer.
+func (w *Writer) Close() error {
+	if _, err := io.WriteString(w.sheet, sheetFooterXML); err != nil {
+		return err
+	}
+	return w.zw.Close()
+}
+
+// escape XML-escapes s and drops characters XML cannot carry.
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == utf8.RuneError, r < 0x20 && r != '\t' && r != '\n' && r != '\r':
+			continue
+		case r == '&':
+			b.WriteString("&amp;")
+		case r == '<':
+			b.WriteString("&lt;")
+		case r == '>':
+			b.WriteString("&gt;")
+		case r == '"':
+			b.WriteString("&quot;")
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+const contentTypesXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/><Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/></Types>`
+
+const rootRelsXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+
+const workbookXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+const workbookRelsXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/><Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/></Relationships>`
+
+const stylesXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts><fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills><borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders><cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs><cellXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/></cellXfs></styleSheet>`
+
+const sheetHeaderXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+const sheetFooterXML = `</sheetData></worksheet>`
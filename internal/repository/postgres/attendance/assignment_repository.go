@@ -0,0 +1,64 @@
+package attendance
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/attendance"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type assignmentRepository struct {
+	db *gorm.DB
+}
+
+func NewAssignmentRepository(db *gorm.DB) repository.ShiftAssignmentRepository {
+	return &assignmentRepository{db: db}
+}
+
+func (r *assignmentRepository) Create(ctx context.Context, a *attendance.ShiftAssignment) error {
+	return postgres.Conn(ctx, r.db).Create(a).Error
+}
+
+func (r *assignmentRepository) FindByID(ctx context.Context, employeeID, id int64) (*attendance.ShiftAssignment, error) {
+	return r.find(postgres.Conn(ctx, r.db).Where("employee_id = ? AND id = ?", employeeID, id))
+}
+
+func (r *assignmentRepository) FindByStart(ctx context.Context, employeeID int64, date time.Time) (*attendance.ShiftAssignment, error) {
+	return r.find(postgres.Conn(ctx, r.db).Where("employee_id = ? AND start_date = ?", employeeID, date))
+}
+
+func (r *assignmentRepository) FindOn(ctx context.Context, employeeID int64, date time.Time) (*attendance.ShiftAssignment, error) {
+	return r.find(postgres.Conn(ctx, r.db).
+		Where("employee_id = ? AND start_date <= ?", employeeID, date).
+		Order("start_date DESC"))
+}
+
+func (r *assignmentRepository) ListByEmployee(ctx context.Context, employeeID int64) ([]attendance.ShiftAssignment, error) {
+	var list []attendance.ShiftAssignment
+	err := postgres.Conn(ctx, r.db).
+		Where("employee_id = ?", employeeID).
+		Order("start_date DESC").
+		Find(&list).Error
+	return list, err
+}
+
+func (r *assignmentRepository) Update(ctx context.Context, a *attendance.ShiftAssignment) error {
+	return postgres.Conn(ctx, r.db).Save(a).Error
+}
+
+func (r *assignmentRepository) Delete(ctx context.Context, a *attendance.ShiftAssignment) error {
+	return postgres.Conn(ctx, r.db).Delete(a).Error
+}
+
+func (r *assignmentRepository) find(q *gorm.DB) (*attendance.ShiftAssignment, error) {
+	var a attendance.ShiftAssignment
+	err := q.First(&a).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("shift assignment not found")
+	}
+	return &a, err
+}
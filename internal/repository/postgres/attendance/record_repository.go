@@ -0,0 +1,88 @@
+package attendance
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/attendance"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type recordRepository struct {
+	db *gorm.DB
+}
+
+func NewRecordRepository(db *gorm.DB) repository.AttendanceRecordRepository {
+	return &recordRepository{db: db}
+}
+
+func (r *recordRepository) Create(ctx context.Context, rec *attendance.Record) error {
+	return postgres.Conn(ctx, r.db).Create(rec).Error
+}
+
+func (r *recordRepository) FindByID(ctx context.Context, companyID, id int64) (*attendance.Record, error) {
+	return r.find(postgres.Conn(ctx, r.db).Where("company_id = ? AND id = ?", companyID, id))
+}
+
+func (r *recordRepository) FindByDate(ctx context.Context, employeeID int64, date time.Time) (*attendance.Record, error) {
+	return r.find(postgres.Conn(ctx, r.db).Where("employee_id = ? AND date = ?", employeeID, date))
+}
+
+func (r *recordRepository) FindOpen(ctx context.Context, employeeID int64, since time.Time) (*attendance.Record, error) {
+	return r.find(postgres.Conn(ctx, r.db).
+		Where("employee_id = ? AND clock_in >= ? AND clock_out IS NULL", employeeID, since).
+		Order("clock_in DESC"))
+}
+
+func (r *recordRepository) List(ctx context.Context, f repository.AttendanceRecordFilter, offset, limit int) ([]attendance.Record, int64, error) {
+	var total int64
+	if err := filter(postgres.Conn(ctx, r.db).Model(&attendance.Record{}), f).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var list []attendance.Record
+	err := filter(postgres.Conn(ctx, r.db), f).
+		Order("date DESC, clock_in DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&list).Error
+	return list, total, err
+}
+
+func (r *recordRepository) ListByDate(ctx context.Context, companyID int64, date time.Time) ([]attendance.Record, error) {
+	var list []attendance.Record
+	err := postgres.Conn(ctx, r.db).
+		Where("company_id = ? AND date = ?", companyID, date).
+		Find(&list).Error
+	return list, err
+}
+
+func (r *recordRepository) Update(ctx context.Context, rec *attendance.Record) error {
+	return postgres.Conn(ctx, r.db).Save(rec).Error
+}
+
+func (r *recordRepository) find(q *gorm.DB) (*attendance.Record, error) {
+	var rec attendance.Record
+	err := q.First(&rec).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("attendance record not found")
+	}
+	return &rec, err
+}
+
+func filter(q *gorm.DB, f repository.AttendanceRecordFilter) *gorm.DB {
+	q = q.Where("company_id = ?", f.CompanyID)
+	if f.EmployeeID != 0 {
+		q = q.Where("employee_id = ?", f.EmployeeID)
+	}
+	if f.From != nil {
+		q = q.Where("date >= ?", *f.From)
+	}
+	if f.To != nil {
+		q = q.Where("date <= ?", *f.To)
+	}
+	return q
+}
@@ -0,0 +1,81 @@
+package attendance
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/attendance"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type shiftRepository struct {
+	db *gorm.DB
+}
+
+func NewShiftRepository(db *gorm.DB) repository.ShiftRepository {
+	return &shiftRepository{db: db}
+}
+
+func (r *shiftRepository) Create(ctx context.Context, s *attendance.Shift) error {
+	return postgres.Conn(ctx, r.db).Create(s).Error
+}
+
+func (r *shiftRepository) FindByID(ctx context.Context, companyID, id int64) (*attendance.Shift, error) {
+	return r.find(ctx, "company_id = ? AND id = ?", companyID, id)
+}
+
+func (r *shiftRepository) FindByCode(ctx context.Context, companyID int64, code string) (*attendance.Shift, error) {
+	return r.find(ctx, "company_id = ? AND code = ?", companyID, code)
+}
+
+func (r *shiftRepository) FindDefault(ctx context.Context, companyID int64) (*attendance.Shift, error) {
+	return r.find(ctx, "company_id = ? AND is_default = ?", companyID, true)
+}
+
+func (r *shiftRepository) ListByCompany(ctx context.Context, companyID int64, activeOnly bool) ([]attendance.Shift, error) {
+	var list []attendance.Shift
+	q := postgres.Conn(ctx, r.db).Where("company_id = ?", companyID)
+	if activeOnly {
+		q = q.Where("is_active = ?", true)
+	}
+	err := q.Order("start_time ASC, name ASC").Find(&list).Error
+	return list, err
+}
+
+func (r *shiftRepository) Update(ctx context.Context, s *attendance.Shift) error {
+	return postgres.Conn(ctx, r.db).Save(s).Error
+}
+
+func (r *shiftRepository) ClearDefault(ctx context.Context, companyID int64) error {
+	return postgres.Conn(ctx, r.db).
+		Model(&attendance.Shift{}).
+		Where("company_id = ? AND is_default = ?", companyID, true).
+		Update("is_default", false).Error
+}
+
+func (r *shiftRepository) Delete(ctx context.Context, s *attendance.Shift) error {
+	return postgres.Conn(ctx, r.db).Delete(s).Error
+}
+
+func (r *shiftRepository) CountReferences(ctx context.Context, id int64) (int64, error) {
+	var assignments, records int64
+	db := postgres.Conn(ctx, r.db)
+	if err := db.Model(&attendance.ShiftAssignment{}).Where("shift_id = ?", id).Count(&assignments).Error; err != nil {
+		return 0, err
+	}
+	if err := db.Model(&attendance.Record{}).Where("shift_id = ?", id).Count(&records).Error; err != nil {
+		return 0, err
+	}
+	return assignments + records, nil
+}
+
+func (r *shiftRepository) find(ctx context.Context, query string, args ...interface{}) (*attendance.Shift, error) {
+	var s attendance.Shift
+	err := postgres.Conn(ctx, r.db).Where(query, args...).First(&s).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("shift not found")
+	}
+	return &s, err
+}
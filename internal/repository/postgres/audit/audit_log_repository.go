@@ -0,0 +1,95 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/audit"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const eachBatchSize = 1000
+
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditLogRepository(db *gorm.DB) repository.AuditLogRepository {
+	return &auditLogRepository{db: db}
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, l *audit.AuditLog) error {
+	return postgres.Conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(l).Error
+}
+
+func (r *auditLogRepository) List(ctx context.Context, f repository.AuditLogFilter, offset, limit int) ([]audit.AuditLog, int64, error) {
+	var total int64
+	if err := filter(postgres.Conn(ctx, r.db).Model(&audit.AuditLog{}), f).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var logs []audit.AuditLog
+	err := filter(postgres.Conn(ctx, r.db), f).
+		Order("created_at DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&logs).Error
+	return logs, total, err
+}
+
+func (r *auditLogRepository) Each(ctx context.Context, f repository.AuditLogFilter, fn func([]audit.AuditLog) error) error {
+	lastID := int64(0)
+	for {
+		q := filter(postgres.Conn(ctx, r.db), f)
+		if lastID != 0 {
+			// IDs are snowflakes, so ID order is creation order.
+			q = q.Where("id < ?", lastID)
+		}
+
+		var logs []audit.AuditLog
+		if err := q.Order("id DESC").Limit(eachBatchSize).Find(&logs).Error; err != nil {
+			return err
+		}
+		if len(logs) == 0 {
+			return nil
+		}
+		if err := fn(logs); err != nil {
+			return err
+		}
+		if len(logs) < eachBatchSize {
+			return nil
+		}
+		lastID = logs[len(logs)-1].ID
+	}
+}
+
+func filter(q *gorm.DB, f repository.AuditLogFilter) *gorm.DB {
+	q = q.Where("company_id = ?", f.CompanyID)
+	if f.UserID != 0 {
+		q = q.Where("user_id = ?", f.UserID)
+	}
+	if f.Module != "" {
+		q = q.Where("module = ?", f.Module)
+	}
+	if f.Entity != "" {
+		q = q.Where("entity = ?", f.Entity)
+	}
+	if f.EntityID != 0 {
+		q = q.Where("entity_id = ?", f.EntityID)
+	}
+	if f.Action != "" {
+		q = q.Where("action = ?", f.Action)
+	}
+	if f.RequestID != "" {
+		q = q.Where("request_id = ?", f.RequestID)
+	}
+	if f.From != nil {
+		q = q.Where("created_at >= ?", *f.From)
+	}
+	if f.To != nil {
+		q = q.Where("created_at < ?", *f.To)
+	}
+	return q
+}
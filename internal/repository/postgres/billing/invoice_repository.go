@@ -0,0 +1,86 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/billing"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type invoiceRepository struct {
+	db *gorm.DB
+}
+
+func NewInvoiceRepository(db *gorm.DB) repository.InvoiceRepository {
+	return &invoiceRepository{db: db}
+}
+
+func (r *invoiceRepository) Create(ctx context.Context, inv *billing.Invoice) error {
+	return postgres.Conn(ctx, r.db).Create(inv).Error
+}
+
+func (r *invoiceRepository) FindByID(ctx context.Context, id int64) (*billing.Invoice, error) {
+	var inv billing.Invoice
+	err := postgres.Conn(ctx, r.db).
+		Preload("Items", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC, id ASC") }).
+		Where("id = ?", id).
+		First(&inv).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("invoice not found")
+	}
+	return &inv, err
+}
+
+func (r *invoiceRepository) LockByID(ctx context.Context, id int64) (*billing.Invoice, error) {
+	var inv billing.Invoice
+	err := postgres.Conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&inv).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("invoice not found")
+	}
+	return &inv, err
+}
+
+func (r *invoiceRepository) ListByCompany(ctx context.Context, companyID int64, status string) ([]billing.Invoice, error) {
+	var invoices []billing.Invoice
+	q := postgres.Conn(ctx, r.db).Where("company_id = ?", companyID)
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	err := q.Order("issued_at DESC, id DESC").Find(&invoices).Error
+	return invoices, err
+}
+
+func (r *invoiceRepository) ListIssuedDueBefore(ctx context.Context, now time.Time) ([]billing.Invoice, error) {
+	var invoices []billing.Invoice
+	err := postgres.Conn(ctx, r.db).
+		Where("status = ? AND due_at < ?", billing.InvoiceStatusIssued, now).
+		Find(&invoices).Error
+	return invoices, err
+}
+
+func (r *invoiceRepository) Update(ctx context.Context, inv *billing.Invoice) error {
+	return postgres.Conn(ctx, r.db).Omit("Items").Save(inv).Error
+}
+
+func (r *invoiceRepository) UpdatePayment(ctx context.Context, id int64, method, reference *string) error {
+	return postgres.Conn(ctx, r.db).Model(&billing.Invoice{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"payment_method": method, "payment_reference": reference}).Error
+}
+
+func (r *invoiceRepository) NextNumber(ctx context.Context, year int) (int64, error) {
+	var n int64
+	err := postgres.Conn(ctx, r.db).Raw(`
+		INSERT INTO billing_invoice_sequences (year, last_number) VALUES (?, 1)
+		ON CONFLICT (year) DO UPDATE SET last_number = billing_invoice_sequences.last_number + 1
+		RETURNING last_number`, year).Scan(&n).Error
+	return n, err
+}
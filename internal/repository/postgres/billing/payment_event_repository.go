@@ -0,0 +1,24 @@
+package billing
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/billing"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type paymentEventRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentEventRepository(db *gorm.DB) repository.PaymentEventRepository {
+	return &paymentEventRepository{db: db}
+}
+
+func (r *paymentEventRepository) CreateIfAbsent(ctx context.Context, e *billing.PaymentEvent) (bool, error) {
+	res := postgres.Conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(e)
+	return res.RowsAffected > 0, res.Error
+}
@@ -0,0 +1,82 @@
+package company
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/company"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type addressRepository struct {
+	db *gorm.DB
+}
+
+func NewCompanyAddressRepository(db *gorm.DB) repository.CompanyAddressRepository {
+	return &addressRepository{db: db}
+}
+
+func (r *addressRepository) Create(ctx context.Context, a *company.Address) error {
+	return postgres.Conn(ctx, r.db).Omit("Province", "City", "District", "Village").Create(a).Error
+}
+
+func (r *addressRepository) FindByID(ctx context.Context, companyID, id int64) (*company.Address, error) {
+	var a company.Address
+	err := withRegions(postgres.Conn(ctx, r.db)).
+		Where("company_id = ? AND id = ?", companyID, id).
+		First(&a).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("address not found")
+	}
+	return &a, err
+}
+
+func (r *addressRepository) ListByCompany(ctx context.Context, companyID int64) ([]company.Address, error) {
+	var addresses []company.Address
+	err := withRegions(postgres.Conn(ctx, r.db)).
+		Where("company_id = ?", companyID).
+		Order("is_primary DESC, created_at ASC").
+		Find(&addresses).Error
+	return addresses, err
+}
+
+func (r *addressRepository) FindPrimary(ctx context.Context, companyID int64) (*company.Address, error) {
+	var a company.Address
+	err := postgres.Conn(ctx, r.db).
+		Where("company_id = ? AND is_primary = ?", companyID, true).
+		First(&a).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("address not found")
+	}
+	return &a, err
+}
+
+func (r *addressRepository) CountByCompany(ctx context.Context, companyID int64) (int64, error) {
+	var count int64
+	err := postgres.Conn(ctx, r.db).
+		Model(&company.Address{}).
+		Where("company_id = ?", companyID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *addressRepository) Update(ctx context.Context, a *company.Address) error {
+	return postgres.Conn(ctx, r.db).Omit("Province", "City", "District", "Village").Save(a).Error
+}
+
+func (r *addressRepository) ClearPrimary(ctx context.Context, companyID int64) error {
+	return postgres.Conn(ctx, r.db).
+		Model(&company.Address{}).
+		Where("company_id = ? AND is_primary = ?", companyID, true).
+		Update("is_primary", false).Error
+}
+
+func (r *addressRepository) Delete(ctx context.Context, a *company.Address) error {
+	return postgres.Conn(ctx, r.db).Delete(a).Error
+}
+
+func withRegions(db *gorm.DB) *gorm.DB {
+	return db.Preload("Province").Preload("City").Preload("District").Preload("Village")
+}
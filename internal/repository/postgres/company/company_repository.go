@@ -0,0 +1,68 @@
+package company
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/company"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type companyRepository struct {
+	db *gorm.DB
+}
+
+func NewCompanyRepository(db *gorm.DB) repository.CompanyRepository {
+	return &companyRepository{db: db}
+}
+
+func (r *companyRepository) Create(ctx context.Context, c *company.Company) error {
+	return postgres.Conn(ctx, r.db).Create(c).Error
+}
+
+func (r *companyRepository) FindByID(ctx context.Context, id int64) (*company.Company, error) {
+	var c company.Company
+	err := postgres.Conn(ctx, r.db).Where("id = ?", id).First(&c).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("company not found")
+	}
+	return &c, err
+}
+
+func (r *companyRepository) LockByID(ctx context.Context, id int64) (*company.Company, error) {
+	var c company.Company
+	err := postgres.Conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&c).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("company not found")
+	}
+	return &c, err
+}
+
+func (r *companyRepository) FindByCode(ctx context.Context, code string) (*company.Company, error) {
+	var c company.Company
+	err := postgres.Conn(ctx, r.db).Where("code = ?", code).First(&c).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("company not found")
+	}
+	return &c, err
+}
+
+func (r *companyRepository) ListByUserID(ctx context.Context, userID int64) ([]company.Company, error) {
+	var companies []company.Company
+	err := postgres.Conn(ctx, r.db).
+		Joins("JOIN user_companies ON user_companies.company_id = companies.id AND user_companies.deleted_at IS NULL").
+		Where("user_companies.user_id = ? AND user_companies.is_active = true", userID).
+		Order("companies.created_at ASC").
+		Find(&companies).Error
+	return companies, err
+}
+
+func (r *companyRepository) Update(ctx context.Context, c *company.Company) error {
+	return postgres.Conn(ctx, r.db).Save(c).Error
+}
@@ -0,0 +1,70 @@
+package company
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/company"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type companyTypeRepository struct {
+	db *gorm.DB
+}
+
+func NewCompanyTypeRepository(db *gorm.DB) repository.CompanyTypeRepository {
+	return &companyTypeRepository{db: db}
+}
+
+func (r *companyTypeRepository) Create(ctx context.Context, e *company.CompanyType) error {
+	return postgres.Conn(ctx, r.db).Create(e).Error
+}
+
+func (r *companyTypeRepository) FindByID(ctx context.Context, id int64) (*company.CompanyType, error) {
+	var e company.CompanyType
+	err := postgres.Conn(ctx, r.db).Where("id = ?", id).First(&e).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("company type not found")
+	}
+	return &e, err
+}
+
+func (r *companyTypeRepository) FindByCode(ctx context.Context, code string) (*company.CompanyType, error) {
+	var e company.CompanyType
+	err := postgres.Conn(ctx, r.db).Where("code = ?", code).First(&e).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("company type not found")
+	}
+	return &e, err
+}
+
+func (r *companyTypeRepository) FindByName(ctx context.Context, name string) (*company.CompanyType, error) {
+	var e company.CompanyType
+	err := postgres.Conn(ctx, r.db).Where("LOWER(name) = LOWER(?)", name).First(&e).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("company type not found")
+	}
+	return &e, err
+}
+
+func (r *companyTypeRepository) List(ctx context.Context, activeOnly bool) ([]company.CompanyType, error) {
+	var list []company.CompanyType
+	q := postgres.Conn(ctx, r.db)
+	if activeOnly {
+		q = q.Where("is_active = ?", true)
+	}
+	err := q.Order("name ASC").Find(&list).Error
+	return list, err
+}
+
+func (r *companyTypeRepository) Update(ctx context.Context, e *company.CompanyType) error {
+	return postgres.Conn(ctx, r.db).Save(e).Error
+}
+
+// Ensure inserts e unless an entry with the same code exists. On return e
+// holds the persisted row.
+func (r *companyTypeRepository) Ensure(ctx context.Context, e *company.CompanyType) error {
+	return postgres.Conn(ctx, r.db).Where("code = ?", e.Code).FirstOrCreate(e).Error
+}
@@ -0,0 +1,70 @@
+package company
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/company"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type industryRepository struct {
+	db *gorm.DB
+}
+
+func NewIndustryRepository(db *gorm.DB) repository.IndustryRepository {
+	return &industryRepository{db: db}
+}
+
+func (r *industryRepository) Create(ctx context.Context, e *company.Industry) error {
+	return postgres.Conn(ctx, r.db).Create(e).Error
+}
+
+func (r *industryRepository) FindByID(ctx context.Context, id int64) (*company.Industry, error) {
+	var e company.Industry
+	err := postgres.Conn(ctx, r.db).Where("id = ?", id).First(&e).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("industry not found")
+	}
+	return &e, err
+}
+
+func (r *industryRepository) FindByCode(ctx context.Context, code string) (*company.Industry, error) {
+	var e company.Industry
+	err := postgres.Conn(ctx, r.db).Where("code = ?", code).First(&e).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("industry not found")
+	}
+	return &e, err
+}
+
+func (r *industryRepository) FindByName(ctx context.Context, name string) (*company.Industry, error) {
+	var e company.Industry
+	err := postgres.Conn(ctx, r.db).Where("LOWER(name) = LOWER(?)", name).First(&e).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("industry not found")
+	}
+	return &e, err
+}
+
+func (r *industryRepository) List(ctx context.Context, activeOnly bool) ([]company.Industry, error) {
+	var list []company.Industry
+	q := postgres.Conn(ctx, r.db)
+	if activeOnly {
+		q = q.Where("is_active = ?", true)
+	}
+	err := q.Order("name ASC").Find(&list).Error
+	return list, err
+}
+
+func (r *industryRepository) Update(ctx context.Context, e *company.Industry) error {
+	return postgres.Conn(ctx, r.db).Save(e).Error
+}
+
+// Ensure inserts e unless an entry with the same code exists. On return e
+// holds the persisted row.
+func (r *industryRepository) Ensure(ctx context.Context, e *company.Industry) error {
+	return postgres.Conn(ctx, r.db).Where("code = ?", e.Code).FirstOrCreate(e).Error
+}
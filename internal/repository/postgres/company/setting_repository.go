@@ -0,0 +1,38 @@
+package company
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/company"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type settingRepository struct {
+	db *gorm.DB
+}
+
+func NewCompanySettingRepository(db *gorm.DB) repository.CompanySettingRepository {
+	return &settingRepository{db: db}
+}
+
+func (r *settingRepository) ListByCompany(ctx context.Context, companyID int64) ([]company.Setting, error) {
+	var settings []company.Setting
+	err := postgres.Conn(ctx, r.db).Where("company_id = ?", companyID).Find(&settings).Error
+	return settings, err
+}
+
+func (r *settingRepository) Upsert(ctx context.Context, s *company.Setting) error {
+	return postgres.Conn(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "company_id"}, {Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value", "value_type", "module", "updated_at"}),
+	}).Create(s).Error
+}
+
+func (r *settingRepository) Delete(ctx context.Context, companyID int64, key string) error {
+	return postgres.Conn(ctx, r.db).
+		Where("company_id = ? AND key = ?", companyID, key).
+		Delete(&company.Setting{}).Error
+}
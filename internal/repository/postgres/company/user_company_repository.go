@@ -0,0 +1,59 @@
+package company
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/company"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type userCompanyRepository struct {
+	db *gorm.DB
+}
+
+func NewUserCompanyRepository(db *gorm.DB) repository.UserCompanyRepository {
+	return &userCompanyRepository{db: db}
+}
+
+func (r *userCompanyRepository) Create(ctx context.Context, uc *company.UserCompany) error {
+	return postgres.Conn(ctx, r.db).Omit("Role").Create(uc).Error
+}
+
+func (r *userCompanyRepository) FindByUserAndCompany(ctx context.Context, userID, companyID int64) (*company.UserCompany, error) {
+	var uc company.UserCompany
+	err := postgres.Conn(ctx, r.db).
+		Preload("Role").
+		Where("user_id = ? AND company_id = ?", userID, companyID).
+		First(&uc).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("membership not found")
+	}
+	return &uc, err
+}
+
+func (r *userCompanyRepository) Update(ctx context.Context, uc *company.UserCompany) error {
+	return postgres.Conn(ctx, r.db).Omit("Role").Save(uc).Error
+}
+
+func (r *userCompanyRepository) CountActiveByCompany(ctx context.Context, companyID int64) (int64, error) {
+	var n int64
+	err := postgres.Conn(ctx, r.db).
+		Model(&company.UserCompany{}).
+		Where("company_id = ? AND is_active = true", companyID).
+		Count(&n).Error
+	return n, err
+}
+
+func (r *userCompanyRepository) ListActiveByRoles(ctx context.Context, companyID int64, codes []string) ([]company.UserCompany, error) {
+	var list []company.UserCompany
+	err := postgres.Conn(ctx, r.db).
+		Joins("JOIN roles ON roles.id = user_companies.role_id").
+		Where("user_companies.company_id = ? AND user_companies.is_active = true", companyID).
+		Where("roles.code IN ?", codes).
+		Order("user_companies.joined_at ASC").
+		Find(&list).Error
+	return list, err
+}
@@ -0,0 +1,55 @@
+package employee
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type employeeAddressRepository struct {
+	db *gorm.DB
+}
+
+func NewEmployeeAddressRepository(db *gorm.DB) repository.EmployeeAddressRepository {
+	return &employeeAddressRepository{db: db}
+}
+
+func (r *employeeAddressRepository) Create(ctx context.Context, a *employee.Address) error {
+	return postgres.Conn(ctx, r.db).Omit("Province", "City", "District", "Village").Create(a).Error
+}
+
+func (r *employeeAddressRepository) FindByID(ctx context.Context, employeeID, id int64) (*employee.Address, error) {
+	var a employee.Address
+	err := withRegions(postgres.Conn(ctx, r.db)).
+		Where("id = ? AND employee_id = ?", id, employeeID).
+		First(&a).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("employee address not found")
+	}
+	return &a, err
+}
+
+func (r *employeeAddressRepository) ListByEmployee(ctx context.Context, employeeID int64) ([]employee.Address, error) {
+	var list []employee.Address
+	err := withRegions(postgres.Conn(ctx, r.db)).
+		Where("employee_id = ?", employeeID).
+		Order("type ASC, is_primary DESC, created_at ASC").
+		Find(&list).Error
+	return list, err
+}
+
+func (r *employeeAddressRepository) Update(ctx context.Context, a *employee.Address) error {
+	return postgres.Conn(ctx, r.db).Omit("Province", "City", "District", "Village").Save(a).Error
+}
+
+func (r *employeeAddressRepository) Delete(ctx context.Context, a *employee.Address) error {
+	return postgres.Conn(ctx, r.db).Delete(a).Error
+}
+
+func withRegions(db *gorm.DB) *gorm.DB {
+	return db.Preload("Province").Preload("City").Preload("District").Preload("Village")
+}
@@ -0,0 +1,51 @@
+package employee
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type employeeBankAccountRepository struct {
+	db *gorm.DB
+}
+
+func NewEmployeeBankAccountRepository(db *gorm.DB) repository.EmployeeBankAccountRepository {
+	return &employeeBankAccountRepository{db: db}
+}
+
+func (r *employeeBankAccountRepository) Create(ctx context.Context, a *employee.BankAccount) error {
+	return postgres.Conn(ctx, r.db).Create(a).Error
+}
+
+func (r *employeeBankAccountRepository) FindByID(ctx context.Context, employeeID, id int64) (*employee.BankAccount, error) {
+	var a employee.BankAccount
+	err := postgres.Conn(ctx, r.db).
+		Where("id = ? AND employee_id = ?", id, employeeID).
+		First(&a).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("bank account not found")
+	}
+	return &a, err
+}
+
+func (r *employeeBankAccountRepository) ListByEmployee(ctx context.Context, employeeID int64) ([]employee.BankAccount, error) {
+	var list []employee.BankAccount
+	err := postgres.Conn(ctx, r.db).
+		Where("employee_id = ?", employeeID).
+		Order("is_primary DESC, created_at ASC").
+		Find(&list).Error
+	return list, err
+}
+
+func (r *employeeBankAccountRepository) Update(ctx context.Context, a *employee.BankAccount) error {
+	return postgres.Conn(ctx, r.db).Save(a).Error
+}
+
+func (r *employeeBankAccountRepository) Delete(ctx context.Context, a *employee.BankAccount) error {
+	return postgres.Conn(ctx, r.db).Delete(a).Error
+}
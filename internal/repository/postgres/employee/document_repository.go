@@ -0,0 +1,88 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type employeeDocumentRepository struct {
+	db *gorm.DB
+}
+
+func NewEmployeeDocumentRepository(db *gorm.DB) repository.EmployeeDocumentRepository {
+	return &employeeDocumentRepository{db: db}
+}
+
+func (r *employeeDocumentRepository) Create(ctx context.Context, d *employee.Document) error {
+	return postgres.Conn(ctx, r.db).Create(d).Error
+}
+
+func (r *employeeDocumentRepository) FindByID(ctx context.Context, companyID, id int64) (*employee.Document, error) {
+	var d employee.Document
+	err := postgres.Conn(ctx, r.db).
+		Where("id = ? AND company_id = ?", id, companyID).
+		First(&d).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("document not found")
+	}
+	return &d, err
+}
+
+func (r *employeeDocumentRepository) List(ctx context.Context, f repository.EmployeeDocumentFilter, offset, limit int) ([]employee.Document, int64, error) {
+	var total int64
+	if err := documentFilter(postgres.Conn(ctx, r.db).Model(&employee.Document{}), f).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var list []employee.Document
+	err := documentFilter(postgres.Conn(ctx, r.db), f).
+		Order("created_at DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&list).Error
+	return list, total, err
+}
+
+func (r *employeeDocumentRepository) Update(ctx context.Context, d *employee.Document) error {
+	return postgres.Conn(ctx, r.db).Save(d).Error
+}
+
+func (r *employeeDocumentRepository) Delete(ctx context.Context, d *employee.Document) error {
+	return postgres.Conn(ctx, r.db).Delete(d).Error
+}
+
+func (r *employeeDocumentRepository) ListDueForReminder(ctx context.Context, from, to time.Time) ([]employee.Document, error) {
+	var list []employee.Document
+	err := postgres.Conn(ctx, r.db).
+		Joins("JOIN employees ON employees.id = employee_documents.employee_id AND employees.deleted_at IS NULL").
+		Where("employees.employment_status <> ?", employee.EmploymentStatusTerminated).
+		Where("employee_documents.reminder_sent_at IS NULL").
+		Where("employee_documents.status <> ?", employee.DocumentStatusRejected).
+		Where("employee_documents.valid_until BETWEEN ? AND ?", from, to).
+		Order("employee_documents.company_id ASC, employee_documents.valid_until ASC").
+		Find(&list).Error
+	return list, err
+}
+
+func documentFilter(q *gorm.DB, f repository.EmployeeDocumentFilter) *gorm.DB {
+	q = q.Where("company_id = ?", f.CompanyID)
+	if f.EmployeeID != 0 {
+		q = q.Where("employee_id = ?", f.EmployeeID)
+	}
+	if f.Type != "" {
+		q = q.Where("type = ?", f.Type)
+	}
+	if f.Status != "" {
+		q = q.Where("status = ?", f.Status)
+	}
+	if f.ExpiringBefore != nil {
+		q = q.Where("valid_until <= ?", *f.ExpiringBefore)
+	}
+	return q
+}
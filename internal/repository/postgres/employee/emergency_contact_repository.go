@@ -0,0 +1,51 @@
+package employee
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type emergencyContactRepository struct {
+	db *gorm.DB
+}
+
+func NewEmergencyContactRepository(db *gorm.DB) repository.EmployeeEmergencyContactRepository {
+	return &emergencyContactRepository{db: db}
+}
+
+func (r *emergencyContactRepository) Create(ctx context.Context, c *employee.EmergencyContact) error {
+	return postgres.Conn(ctx, r.db).Create(c).Error
+}
+
+func (r *emergencyContactRepository) FindByID(ctx context.Context, employeeID, id int64) (*employee.EmergencyContact, error) {
+	var c employee.EmergencyContact
+	err := postgres.Conn(ctx, r.db).
+		Where("id = ? AND employee_id = ?", id, employeeID).
+		First(&c).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("emergency contact not found")
+	}
+	return &c, err
+}
+
+func (r *emergencyContactRepository) ListByEmployee(ctx context.Context, employeeID int64) ([]employee.EmergencyContact, error) {
+	var list []employee.EmergencyContact
+	err := postgres.Conn(ctx, r.db).
+		Where("employee_id = ?", employeeID).
+		Order("is_primary DESC, created_at ASC").
+		Find(&list).Error
+	return list, err
+}
+
+func (r *emergencyContactRepository) Update(ctx context.Context, c *employee.EmergencyContact) error {
+	return postgres.Conn(ctx, r.db).Save(c).Error
+}
+
+func (r *emergencyContactRepository) Delete(ctx context.Context, c *employee.EmergencyContact) error {
+	return postgres.Conn(ctx, r.db).Delete(c).Error
+}
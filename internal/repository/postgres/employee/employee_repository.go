@@ -0,0 +1,222 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/encryption"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type employeeRepository struct {
+	db *gorm.DB
+}
+
+func NewEmployeeRepository(db *gorm.DB) repository.EmployeeRepository {
+	return &employeeRepository{db: db}
+}
+
+func (r *employeeRepository) Create(ctx context.Context, e *employee.Employee) error {
+	if err := index(e); err != nil {
+		return err
+	}
+	return postgres.Conn(ctx, r.db).Create(e).Error
+}
+
+func (r *employeeRepository) FindByID(ctx context.Context, id int64) (*employee.Employee, error) {
+	var e employee.Employee
+	err := postgres.Conn(ctx, r.db).Where("id = ?", id).First(&e).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("employee not found")
+	}
+	return &e, err
+}
+
+func (r *employeeRepository) LockByID(ctx context.Context, id int64) (*employee.Employee, error) {
+	var e employee.Employee
+	err := postgres.Conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&e).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("employee not found")
+	}
+	return &e, err
+}
+
+func (r *employeeRepository) ListByIDs(ctx context.Context, ids []int64) ([]employee.Employee, error) {
+	var list []employee.Employee
+	if len(ids) == 0 {
+		return list, nil
+	}
+	err := postgres.Conn(ctx, r.db).Where("id IN ?", ids).Find(&list).Error
+	return list, err
+}
+
+func (r *employeeRepository) FindByCompanyAndEmail(ctx context.Context, companyID int64, email string) (*employee.Employee, error) {
+	var e employee.Employee
+	err := postgres.Conn(ctx, r.db).
+		Where("company_id = ? AND LOWER(email) = LOWER(?)", companyID, email).
+		First(&e).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("employee not found")
+	}
+	return &e, err
+}
+
+func (r *employeeRepository) FindByCompanyAndUser(ctx context.Context, companyID, userID int64) (*employee.Employee, error) {
+	var e employee.Employee
+	err := postgres.Conn(ctx, r.db).
+		Where("company_id = ? AND user_id = ?", companyID, userID).
+		First(&e).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("employee not found")
+	}
+	return &e, err
+}
+
+func (r *employeeRepository) FindByCompanyAndNumber(ctx context.Context, companyID int64, number string) (*employee.Employee, error) {
+	var e employee.Employee
+	err := postgres.Conn(ctx, r.db).
+		Unscoped().
+		Where("company_id = ? AND employee_number = ?", companyID, number).
+		First(&e).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("employee not found")
+	}
+	return &e, err
+}
+
+func (r *employeeRepository) FindByCompanyAndNationalID(ctx context.Context, companyID int64, nationalID string) (*employee.Employee, error) {
+	idx, err := encryption.BlindIndex(&nationalID)
+	if err != nil {
+		return nil, err
+	}
+	var e employee.Employee
+	err = postgres.Conn(ctx, r.db).
+		Where("company_id = ? AND national_id_index = ?", companyID, *idx).
+		First(&e).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("employee not found")
+	}
+	return &e, err
+}
+
+func (r *employeeRepository) List(ctx context.Context, f repository.EmployeeFilter, offset, limit int) ([]employee.Employee, int64, error) {
+	nationalID, err := encryption.BlindIndex(f.NationalID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	if err := filter(postgres.Conn(ctx, r.db).Model(&employee.Employee{}), f, nationalID).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var list []employee.Employee
+	err = filter(postgres.Conn(ctx, r.db), f, nationalID).
+		Order("name ASC, id ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&list).Error
+	return list, total, err
+}
+
+func (r *employeeRepository) Update(ctx context.Context, e *employee.Employee) error {
+	if err := index(e); err != nil {
+		return err
+	}
+	return postgres.Conn(ctx, r.db).Save(e).Error
+}
+
+func (r *employeeRepository) Delete(ctx context.Context, e *employee.Employee) error {
+	return postgres.Conn(ctx, r.db).Delete(e).Error
+}
+
+func (r *employeeRepository) NextNumber(ctx context.Context, companyID int64, scope string) (int64, error) {
+	var n int64
+	err := postgres.Conn(ctx, r.db).Raw(`
+		INSERT INTO employee_number_sequences (company_id, scope, last_number) VALUES (?, ?, 1)
+		ON CONFLICT (company_id, scope) DO UPDATE SET last_number = employee_number_sequences.last_number + 1
+		RETURNING last_number`, companyID, scope).Scan(&n).Error
+	return n, err
+}
+
+func (r *employeeRepository) CountActiveByCompany(ctx context.Context, companyID int64) (int64, error) {
+	var n int64
+	err := postgres.Conn(ctx, r.db).
+		Model(&employee.Employee{}).
+		Where("company_id = ? AND employment_status <> ?", companyID, employee.EmploymentStatusTerminated).
+		Count(&n).Error
+	return n, err
+}
+
+func (r *employeeRepository) ListActiveByCompany(ctx context.Context, companyID int64) ([]employee.Employee, error) {
+	var list []employee.Employee
+	err := postgres.Conn(ctx, r.db).
+		Where("company_id = ? AND employment_status <> ?", companyID, employee.EmploymentStatusTerminated).
+		Order("name ASC").
+		Find(&list).Error
+	return list, err
+}
+
+func (r *employeeRepository) CountActiveByDivision(ctx context.Context, divisionID int64) (int64, error) {
+	return r.count(ctx, "division_id = ? AND employment_status <> ?", divisionID, employee.EmploymentStatusTerminated)
+}
+
+func (r *employeeRepository) CountActiveByDepartment(ctx context.Context, departmentID int64) (int64, error) {
+	return r.count(ctx, "department_id = ? AND employment_status <> ?", departmentID, employee.EmploymentStatusTerminated)
+}
+
+func (r *employeeRepository) count(ctx context.Context, query string, args ...interface{}) (int64, error) {
+	var n int64
+	err := postgres.Conn(ctx, r.db).
+		Model(&employee.Employee{}).
+		Where(query, args...).
+		Count(&n).Error
+	return n, err
+}
+
+// filter applies f; nationalID is the blind index of f.NationalID.
+func filter(q *gorm.DB, f repository.EmployeeFilter, nationalID *string) *gorm.DB {
+	q = q.Where("company_id = ?", f.CompanyID)
+	if nationalID != nil {
+		q = q.Where("national_id_index = ?", *nationalID)
+	}
+	if f.Search != "" {
+		like := "%" + escapeLike(f.Search) + "%"
+		q = q.Where("(name ILIKE ? OR employee_number ILIKE ? OR email ILIKE ?)", like, like, like)
+	}
+	if f.DivisionID != 0 {
+		q = q.Where("division_id = ?", f.DivisionID)
+	}
+	if f.DepartmentID != 0 {
+		q = q.Where("department_id = ?", f.DepartmentID)
+	}
+	if f.EmploymentStatus != "" {
+		q = q.Where("employment_status = ?", f.EmploymentStatus)
+	}
+	if f.EmploymentType != "" {
+		q = q.Where("employment_type = ?", f.EmploymentType)
+	}
+	return q
+}
+
+// index refreshes the blind indexes of the encrypted identity numbers.
+func index(e *employee.Employee) error {
+	var err error
+	if e.NationalIDIndex, err = encryption.BlindIndex(e.NationalID); err != nil {
+		return err
+	}
+	e.TaxIDIndex, err = encryption.BlindIndex(e.TaxID)
+	return err
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -0,0 +1,69 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type importRepository struct {
+	db *gorm.DB
+}
+
+func NewImportRepository(db *gorm.DB) repository.EmployeeImportRepository {
+	return &importRepository{db: db}
+}
+
+func (r *importRepository) Create(ctx context.Context, i *employee.Import) error {
+	return postgres.Conn(ctx, r.db).Create(i).Error
+}
+
+func (r *importRepository) FindByID(ctx context.Context, companyID, id int64) (*employee.Import, error) {
+	var i employee.Import
+	err := postgres.Conn(ctx, r.db).
+		Where("id = ? AND company_id = ?", id, companyID).
+		First(&i).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("import not found")
+	}
+	return &i, err
+}
+
+func (r *importRepository) LockByID(ctx context.Context, id int64) (*employee.Import, error) {
+	var i employee.Import
+	err := postgres.Conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&i).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("import not found")
+	}
+	return &i, err
+}
+
+func (r *importRepository) Claim(ctx context.Context, id int64, staleBefore time.Time) (*employee.Import, bool, error) {
+	res := postgres.Conn(ctx, r.db).Model(&employee.Import{}).
+		Where("id = ? AND (status = ? OR (status = ? AND updated_at < ?))",
+			id, employee.ImportStatusQueued, employee.ImportStatusRunning, staleBefore).
+		Updates(map[string]interface{}{"status": employee.ImportStatusRunning, "updated_at": time.Now()})
+	if res.Error != nil {
+		return nil, false, res.Error
+	}
+
+	var i employee.Import
+	err := postgres.Conn(ctx, r.db).Where("id = ?", id).First(&i).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, errors.New("import not found")
+	}
+	return &i, res.RowsAffected == 1, err
+}
+
+func (r *importRepository) Update(ctx context.Context, i *employee.Import) error {
+	return postgres.Conn(ctx, r.db).Save(i).Error
+}
@@ -0,0 +1,76 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type invitationRepository struct {
+	db *gorm.DB
+}
+
+func NewInvitationRepository(db *gorm.DB) repository.EmployeeInvitationRepository {
+	return &invitationRepository{db: db}
+}
+
+func (r *invitationRepository) Create(ctx context.Context, inv *employee.Invitation) error {
+	return postgres.Conn(ctx, r.db).Create(inv).Error
+}
+
+func (r *invitationRepository) FindByID(ctx context.Context, id int64) (*employee.Invitation, error) {
+	var inv employee.Invitation
+	err := postgres.Conn(ctx, r.db).Where("id = ?", id).First(&inv).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("invitation not found")
+	}
+	return &inv, err
+}
+
+func (r *invitationRepository) FindByToken(ctx context.Context, token string) (*employee.Invitation, error) {
+	var inv employee.Invitation
+	err := postgres.Conn(ctx, r.db).Where("token = ?", token).First(&inv).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("invitation not found")
+	}
+	return &inv, err
+}
+
+func (r *invitationRepository) FindPendingByCompanyAndEmail(ctx context.Context, companyID int64, email string) (*employee.Invitation, error) {
+	var inv employee.Invitation
+	err := postgres.Conn(ctx, r.db).
+		Where("company_id = ? AND LOWER(email) = LOWER(?) AND status = ? AND expires_at > ?",
+			companyID, email, employee.InvitationStatusPending, time.Now()).
+		First(&inv).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("invitation not found")
+	}
+	return &inv, err
+}
+
+func (r *invitationRepository) ListByCompany(ctx context.Context, companyID int64, status string) ([]employee.Invitation, error) {
+	var invs []employee.Invitation
+	q := postgres.Conn(ctx, r.db).Where("company_id = ?", companyID)
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	err := q.Order("created_at DESC").Find(&invs).Error
+	return invs, err
+}
+
+func (r *invitationRepository) Update(ctx context.Context, inv *employee.Invitation) error {
+	return postgres.Conn(ctx, r.db).Save(inv).Error
+}
+
+func (r *invitationRepository) ExpirePending(ctx context.Context, now time.Time) (int64, error) {
+	res := postgres.Conn(ctx, r.db).
+		Model(&employee.Invitation{}).
+		Where("status = ? AND expires_at <= ?", employee.InvitationStatusPending, now).
+		Update("status", employee.InvitationStatusExpired)
+	return res.RowsAffected, res.Error
+}
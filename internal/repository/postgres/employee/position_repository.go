@@ -0,0 +1,88 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type employeePositionRepository struct {
+	db *gorm.DB
+}
+
+func NewEmployeePositionRepository(db *gorm.DB) repository.EmployeePositionRepository {
+	return &employeePositionRepository{db: db}
+}
+
+func (r *employeePositionRepository) Create(ctx context.Context, p *employee.Position) error {
+	return postgres.Conn(ctx, r.db).Create(p).Error
+}
+
+func (r *employeePositionRepository) FindByID(ctx context.Context, employeeID, id int64) (*employee.Position, error) {
+	var p employee.Position
+	err := postgres.Conn(ctx, r.db).
+		Where("id = ? AND employee_id = ?", id, employeeID).
+		First(&p).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("employee position not found")
+	}
+	return &p, err
+}
+
+func (r *employeePositionRepository) Update(ctx context.Context, p *employee.Position) error {
+	return postgres.Conn(ctx, r.db).Save(p).Error
+}
+
+func (r *employeePositionRepository) Delete(ctx context.Context, p *employee.Position) error {
+	return postgres.Conn(ctx, r.db).Delete(p).Error
+}
+
+func (r *employeePositionRepository) ListByEmployee(ctx context.Context, employeeID int64) ([]employee.Position, error) {
+	var list []employee.Position
+	err := postgres.Conn(ctx, r.db).
+		Where("employee_id = ?", employeeID).
+		Order("start_date ASC, created_at ASC").
+		Find(&list).Error
+	return list, err
+}
+
+func (r *employeePositionRepository) ListByCompanyOn(ctx context.Context, companyID int64, date time.Time) ([]employee.Position, error) {
+	var list []employee.Position
+	err := postgres.Conn(ctx, r.db).
+		Joins("JOIN employees ON employees.id = employee_positions.employee_id AND employees.deleted_at IS NULL").
+		Where("employees.company_id = ?", companyID).
+		Where("employee_positions.start_date <= ?", date).
+		Where("employee_positions.end_date IS NULL OR employee_positions.end_date >= ?", date).
+		Order("employee_positions.employee_id ASC, employee_positions.is_primary DESC, employee_positions.start_date ASC").
+		Find(&list).Error
+	return list, err
+}
+
+func (r *employeePositionRepository) CountActiveByPosition(ctx context.Context, positionID int64) (int64, error) {
+	var count int64
+	err := postgres.Conn(ctx, r.db).
+		Model(&employee.Position{}).
+		Joins("JOIN employees ON employees.id = employee_positions.employee_id AND employees.deleted_at IS NULL").
+		Where("employee_positions.position_id = ?", positionID).
+		Where("employee_positions.end_date IS NULL OR employee_positions.end_date >= CURRENT_DATE").
+		Where("employees.employment_status <> ?", employee.EmploymentStatusTerminated).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *employeePositionRepository) ListCurrentPrimaryByCompany(ctx context.Context, companyID int64) ([]employee.Position, error) {
+	var list []employee.Position
+	err := postgres.Conn(ctx, r.db).
+		Joins("JOIN employees ON employees.id = employee_positions.employee_id AND employees.deleted_at IS NULL").
+		Where("employees.company_id = ? AND employee_positions.is_primary = ?", companyID, true).
+		Where("employee_positions.start_date <= CURRENT_DATE").
+		Where("employee_positions.end_date IS NULL OR employee_positions.end_date >= CURRENT_DATE").
+		Order("employee_positions.start_date ASC").
+		Find(&list).Error
+	return list, err
+}
@@ -0,0 +1,78 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type workLocationRepository struct {
+	db *gorm.DB
+}
+
+func NewWorkLocationRepository(db *gorm.DB) repository.WorkLocationRepository {
+	return &workLocationRepository{db: db}
+}
+
+func (r *workLocationRepository) Create(ctx context.Context, w *employee.WorkLocation) error {
+	return postgres.Conn(ctx, r.db).Create(w).Error
+}
+
+func (r *workLocationRepository) FindByID(ctx context.Context, employeeID, id int64) (*employee.WorkLocation, error) {
+	var w employee.WorkLocation
+	err := postgres.Conn(ctx, r.db).
+		Where("id = ? AND employee_id = ?", id, employeeID).
+		First(&w).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("work location not found")
+	}
+	return &w, err
+}
+
+func (r *workLocationRepository) Update(ctx context.Context, w *employee.WorkLocation) error {
+	return postgres.Conn(ctx, r.db).Save(w).Error
+}
+
+func (r *workLocationRepository) Delete(ctx context.Context, w *employee.WorkLocation) error {
+	return postgres.Conn(ctx, r.db).Delete(w).Error
+}
+
+func (r *workLocationRepository) ListByEmployee(ctx context.Context, employeeID int64) ([]employee.WorkLocation, error) {
+	var list []employee.WorkLocation
+	err := postgres.Conn(ctx, r.db).
+		Where("employee_id = ?", employeeID).
+		Order("start_date ASC, created_at ASC").
+		Find(&list).Error
+	return list, err
+}
+
+func (r *workLocationRepository) ListByCompanyOn(ctx context.Context, companyID int64, date time.Time, addressID int64) ([]employee.WorkLocation, error) {
+	q := postgres.Conn(ctx, r.db).
+		Joins("JOIN employees ON employees.id = employee_work_locations.employee_id AND employees.deleted_at IS NULL").
+		Where("employees.company_id = ?", companyID).
+		Where("employee_work_locations.start_date <= ?", date).
+		Where("employee_work_locations.end_date IS NULL OR employee_work_locations.end_date >= ?", date)
+	if addressID != 0 {
+		q = q.Where("employee_work_locations.company_address_id = ?", addressID)
+	}
+
+	var list []employee.WorkLocation
+	err := q.
+		Order("employee_work_locations.employee_id ASC, employee_work_locations.is_primary DESC, employee_work_locations.start_date ASC").
+		Find(&list).Error
+	return list, err
+}
+
+func (r *workLocationRepository) CountByAddress(ctx context.Context, addressID int64) (int64, error) {
+	var count int64
+	err := postgres.Conn(ctx, r.db).
+		Model(&employee.WorkLocation{}).
+		Where("company_address_id = ?", addressID).
+		Count(&count).Error
+	return count, err
+}
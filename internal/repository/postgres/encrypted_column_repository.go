@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type encryptedColumnRepository struct {
+	db *gorm.DB
+}
+
+func NewEncryptedColumnRepository(db *gorm.DB) repository.EncryptedColumnRepository {
+	return &encryptedColumnRepository{db: db}
+}
+
+func (r *encryptedColumnRepository) ListStale(ctx context.Context, col repository.EncryptedColumn, prefix string, afterID int64, limit int) ([]repository.EncryptedValue, error) {
+	table, column := clause.Table{Name: col.Table}, clause.Column{Name: col.Column}
+	var list []repository.EncryptedValue
+	err := Conn(ctx, r.db).Raw(`
+		SELECT id, ? AS value FROM ?
+		WHERE id > ? AND ? IS NOT NULL AND ? <> '' AND NOT starts_with(?, ?)
+		ORDER BY id
+		LIMIT ?`, column, table, afterID, column, column, column, prefix, limit).
+		Scan(&list).Error
+	return list, err
+}
+
+func (r *encryptedColumnRepository) Rewrite(ctx context.Context, col repository.EncryptedColumn, id int64, old, value string, index *string) (bool, error) {
+	table, column := clause.Table{Name: col.Table}, clause.Column{Name: col.Column}
+	var res *gorm.DB
+	if col.IndexColumn != "" {
+		res = Conn(ctx, r.db).Exec(`UPDATE ? SET ? = ?, ? = ? WHERE id = ? AND ? = ?`,
+			table, column, value, clause.Column{Name: col.IndexColumn}, index, id, column, old)
+	} else {
+		res = Conn(ctx, r.db).Exec(`UPDATE ? SET ? = ? WHERE id = ? AND ? = ?`,
+			table, column, value, id, column, old)
+	}
+	return res.RowsAffected == 1, res.Error
+}
@@ -0,0 +1,65 @@
+package leave
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/leave"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type balanceRepository struct {
+	db *gorm.DB
+}
+
+func NewBalanceRepository(db *gorm.DB) repository.LeaveBalanceRepository {
+	return &balanceRepository{db: db}
+}
+
+func (r *balanceRepository) Create(ctx context.Context, b *leave.Balance) error {
+	return postgres.Conn(ctx, r.db).Create(b).Error
+}
+
+func (r *balanceRepository) Find(ctx context.Context, employeeID, leaveTypeID int64, year int) (*leave.Balance, error) {
+	return r.find(postgres.Conn(ctx, r.db), employeeID, leaveTypeID, year)
+}
+
+func (r *balanceRepository) Lock(ctx context.Context, employeeID, leaveTypeID int64, year int) (*leave.Balance, error) {
+	return r.find(postgres.Conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}), employeeID, leaveTypeID, year)
+}
+
+func (r *balanceRepository) LockByID(ctx context.Context, id int64) (*leave.Balance, error) {
+	var b leave.Balance
+	err := postgres.Conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&b).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("leave balance not found")
+	}
+	return &b, err
+}
+
+func (r *balanceRepository) ListByEmployee(ctx context.Context, employeeID int64, year int) ([]leave.Balance, error) {
+	var list []leave.Balance
+	err := postgres.Conn(ctx, r.db).
+		Where("employee_id = ? AND year = ?", employeeID, year).
+		Find(&list).Error
+	return list, err
+}
+
+func (r *balanceRepository) Update(ctx context.Context, b *leave.Balance) error {
+	return postgres.Conn(ctx, r.db).Save(b).Error
+}
+
+func (r *balanceRepository) find(q *gorm.DB, employeeID, leaveTypeID int64, year int) (*leave.Balance, error) {
+	var b leave.Balance
+	err := q.Where("employee_id = ? AND leave_type_id = ? AND year = ?", employeeID, leaveTypeID, year).First(&b).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("leave balance not found")
+	}
+	return &b, err
+}
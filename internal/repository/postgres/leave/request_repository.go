@@ -0,0 +1,149 @@
+package leave
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/leave"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type requestRepository struct {
+	db *gorm.DB
+}
+
+func NewRequestRepository(db *gorm.DB) repository.LeaveRequestRepository {
+	return &requestRepository{db: db}
+}
+
+func (r *requestRepository) Create(ctx context.Context, req *leave.Request) error {
+	return postgres.Conn(ctx, r.db).Create(req).Error
+}
+
+func (r *requestRepository) FindByID(ctx context.Context, companyID, id int64) (*leave.Request, error) {
+	return r.find(postgres.Conn(ctx, r.db), companyID, id)
+}
+
+func (r *requestRepository) LockByID(ctx context.Context, companyID, id int64) (*leave.Request, error) {
+	return r.find(postgres.Conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "leave_requests"}}), companyID, id)
+}
+
+func (r *requestRepository) Update(ctx context.Context, req *leave.Request) error {
+	return postgres.Conn(ctx, r.db).Omit("Approvals").Save(req).Error
+}
+
+func (r *requestRepository) UpdateApproval(ctx context.Context, a *leave.Approval) error {
+	return postgres.Conn(ctx, r.db).Save(a).Error
+}
+
+func (r *requestRepository) SetStatusApplied(ctx context.Context, id int64, applied bool) error {
+	return postgres.Conn(ctx, r.db).Model(&leave.Request{}).
+		Where("id = ?", id).
+		Update("status_applied", applied).Error
+}
+
+func (r *requestRepository) List(ctx context.Context, f repository.LeaveRequestFilter, offset, limit int) ([]leave.Request, int64, error) {
+	var total int64
+	if err := filter(postgres.Conn(ctx, r.db).Model(&leave.Request{}), f).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var list []leave.Request
+	err := filter(postgres.Conn(ctx, r.db), f).
+		Preload("Approvals", orderByLevel).
+		Order("start_date DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&list).Error
+	return list, total, err
+}
+
+func (r *requestRepository) ListAwaiting(ctx context.Context, companyID, approverID int64) ([]leave.Request, error) {
+	var list []leave.Request
+	err := postgres.Conn(ctx, r.db).
+		Preload("Approvals", orderByLevel).
+		Where("company_id = ? AND status = ?", companyID, leave.RequestStatusPending).
+		Where(`EXISTS (
+			SELECT 1 FROM leave_approvals a
+			WHERE a.request_id = leave_requests.id AND a.approver_id = ? AND a.status = ?
+			AND NOT EXISTS (
+				SELECT 1 FROM leave_approvals b
+				WHERE b.request_id = a.request_id AND b.status = ? AND b.level < a.level
+			)
+		)`, approverID, leave.ApprovalStatusPending, leave.ApprovalStatusPending).
+		Order("created_at ASC").
+		Find(&list).Error
+	return list, err
+}
+
+func (r *requestRepository) ListOverlapping(ctx context.Context, employeeID int64, start, end time.Time) ([]leave.Request, error) {
+	var list []leave.Request
+	err := postgres.Conn(ctx, r.db).
+		Where("employee_id = ? AND status IN ?", employeeID, []string{leave.RequestStatusPending, leave.RequestStatusApproved}).
+		Where("start_date <= ? AND end_date >= ?", end, start).
+		Find(&list).Error
+	return list, err
+}
+
+func (r *requestRepository) ListForStatusSync(ctx context.Context, employeeID int64, from, to time.Time) ([]leave.Request, error) {
+	q := postgres.Conn(ctx, r.db)
+	if employeeID != 0 {
+		q = q.Where("employee_id = ?", employeeID)
+	}
+
+	var list []leave.Request
+	err := q.
+		Where("(status = ? AND start_date <= ? AND end_date >= ?) OR status_applied = ?",
+			leave.RequestStatusApproved, to, from, true).
+		Order("start_date ASC").
+		Find(&list).Error
+	return list, err
+}
+
+func (r *requestRepository) ListApprovedOn(ctx context.Context, companyID int64, date time.Time) ([]leave.Request, error) {
+	var list []leave.Request
+	err := postgres.Conn(ctx, r.db).
+		Where("company_id = ? AND status = ?", companyID, leave.RequestStatusApproved).
+		Where("start_date <= ? AND end_date >= ?", date, date).
+		Find(&list).Error
+	return list, err
+}
+
+func (r *requestRepository) find(q *gorm.DB, companyID, id int64) (*leave.Request, error) {
+	var req leave.Request
+	err := q.Preload("Approvals", orderByLevel).
+		Where("company_id = ? AND id = ?", companyID, id).
+		First(&req).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("leave request not found")
+	}
+	return &req, err
+}
+
+func filter(q *gorm.DB, f repository.LeaveRequestFilter) *gorm.DB {
+	q = q.Where("company_id = ?", f.CompanyID)
+	if f.EmployeeID != 0 {
+		q = q.Where("employee_id = ?", f.EmployeeID)
+	}
+	if f.LeaveTypeID != 0 {
+		q = q.Where("leave_type_id = ?", f.LeaveTypeID)
+	}
+	if f.Status != "" {
+		q = q.Where("status = ?", f.Status)
+	}
+	if f.From != nil {
+		q = q.Where("end_date >= ?", *f.From)
+	}
+	if f.To != nil {
+		q = q.Where("start_date <= ?", *f.To)
+	}
+	return q
+}
+
+func orderByLevel(db *gorm.DB) *gorm.DB {
+	return db.Order("level ASC")
+}
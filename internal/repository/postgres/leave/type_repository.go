@@ -0,0 +1,59 @@
+package leave
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/leave"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type typeRepository struct {
+	db *gorm.DB
+}
+
+func NewTypeRepository(db *gorm.DB) repository.LeaveTypeRepository {
+	return &typeRepository{db: db}
+}
+
+func (r *typeRepository) Create(ctx context.Context, t *leave.Type) error {
+	return postgres.Conn(ctx, r.db).Create(t).Error
+}
+
+func (r *typeRepository) FindByID(ctx context.Context, companyID, id int64) (*leave.Type, error) {
+	var t leave.Type
+	err := postgres.Conn(ctx, r.db).Where("company_id = ? AND id = ?", companyID, id).First(&t).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("leave type not found")
+	}
+	return &t, err
+}
+
+func (r *typeRepository) FindByCode(ctx context.Context, companyID int64, code string) (*leave.Type, error) {
+	var t leave.Type
+	err := postgres.Conn(ctx, r.db).Where("company_id = ? AND code = ?", companyID, code).First(&t).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("leave type not found")
+	}
+	return &t, err
+}
+
+func (r *typeRepository) ListByCompany(ctx context.Context, companyID int64, activeOnly bool) ([]leave.Type, error) {
+	var list []leave.Type
+	q := postgres.Conn(ctx, r.db).Where("company_id = ?", companyID)
+	if activeOnly {
+		q = q.Where("is_active = ?", true)
+	}
+	err := q.Order("name ASC").Find(&list).Error
+	return list, err
+}
+
+func (r *typeRepository) Update(ctx context.Context, t *leave.Type) error {
+	return postgres.Conn(ctx, r.db).Save(t).Error
+}
+
+func (r *typeRepository) Delete(ctx context.Context, t *leave.Type) error {
+	return postgres.Conn(ctx, r.db).Delete(t).Error
+}
@@ -0,0 +1,79 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/notification"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) repository.NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+func (r *notificationRepository) Create(ctx context.Context, n *notification.Notification) error {
+	return postgres.Conn(ctx, r.db).Create(n).Error
+}
+
+func (r *notificationRepository) ListByUser(ctx context.Context, userID int64, unreadOnly bool, offset, limit int) ([]notification.Notification, int64, error) {
+	scope := func(q *gorm.DB) *gorm.DB {
+		q = q.Where("user_id = ?", userID)
+		if unreadOnly {
+			q = q.Where("read_at IS NULL")
+		}
+		return q
+	}
+
+	var total int64
+	if err := scope(postgres.Conn(ctx, r.db).Model(&notification.Notification{})).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var list []notification.Notification
+	err := scope(postgres.Conn(ctx, r.db)).
+		Order("created_at DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&list).Error
+	return list, total, err
+}
+
+func (r *notificationRepository) CountUnread(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := postgres.Conn(ctx, r.db).
+		Model(&notification.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *notificationRepository) MarkRead(ctx context.Context, userID, id int64, at time.Time) error {
+	var n notification.Notification
+	err := postgres.Conn(ctx, r.db).Where("id = ? AND user_id = ?", id, userID).First(&n).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("notification not found")
+		}
+		return err
+	}
+	if n.IsRead() {
+		return nil
+	}
+	return postgres.Conn(ctx, r.db).Model(&n).Update("read_at", at).Error
+}
+
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID int64, at time.Time) (int64, error) {
+	res := postgres.Conn(ctx, r.db).
+		Model(&notification.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", at)
+	return res.RowsAffected, res.Error
+}
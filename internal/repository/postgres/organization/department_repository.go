@@ -0,0 +1,71 @@
+package organization
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/organization"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type departmentRepository struct {
+	db *gorm.DB
+}
+
+func NewDepartmentRepository(db *gorm.DB) repository.DepartmentRepository {
+	return &departmentRepository{db: db}
+}
+
+func (r *departmentRepository) Create(ctx context.Context, d *organization.Department) error {
+	return postgres.Conn(ctx, r.db).Create(d).Error
+}
+
+func (r *departmentRepository) FindByID(ctx context.Context, companyID, id int64) (*organization.Department, error) {
+	var d organization.Department
+	err := postgres.Conn(ctx, r.db).Where("company_id = ? AND id = ?", companyID, id).First(&d).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("department not found")
+	}
+	return &d, err
+}
+
+func (r *departmentRepository) FindByCode(ctx context.Context, companyID int64, code string) (*organization.Department, error) {
+	var d organization.Department
+	err := postgres.Conn(ctx, r.db).Where("company_id = ? AND code = ?", companyID, code).First(&d).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("department not found")
+	}
+	return &d, err
+}
+
+func (r *departmentRepository) ListByCompany(ctx context.Context, companyID, divisionID int64, activeOnly bool) ([]organization.Department, error) {
+	var list []organization.Department
+	q := postgres.Conn(ctx, r.db).Where("company_id = ?", companyID)
+	if divisionID != 0 {
+		q = q.Where("division_id = ?", divisionID)
+	}
+	if activeOnly {
+		q = q.Where("is_active = ?", true)
+	}
+	err := q.Order("name ASC").Find(&list).Error
+	return list, err
+}
+
+func (r *departmentRepository) CountByDivision(ctx context.Context, divisionID int64) (int64, error) {
+	var count int64
+	err := postgres.Conn(ctx, r.db).
+		Model(&organization.Department{}).
+		Where("division_id = ?", divisionID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *departmentRepository) Update(ctx context.Context, d *organization.Department) error {
+	return postgres.Conn(ctx, r.db).Save(d).Error
+}
+
+func (r *departmentRepository) Delete(ctx context.Context, d *organization.Department) error {
+	return postgres.Conn(ctx, r.db).Delete(d).Error
+}
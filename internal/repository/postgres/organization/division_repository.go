@@ -0,0 +1,59 @@
+package organization
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/organization"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type divisionRepository struct {
+	db *gorm.DB
+}
+
+func NewDivisionRepository(db *gorm.DB) repository.DivisionRepository {
+	return &divisionRepository{db: db}
+}
+
+func (r *divisionRepository) Create(ctx context.Context, d *organization.Division) error {
+	return postgres.Conn(ctx, r.db).Create(d).Error
+}
+
+func (r *divisionRepository) FindByID(ctx context.Context, companyID, id int64) (*organization.Division, error) {
+	var d organization.Division
+	err := postgres.Conn(ctx, r.db).Where("company_id = ? AND id = ?", companyID, id).First(&d).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("division not found")
+	}
+	return &d, err
+}
+
+func (r *divisionRepository) FindByCode(ctx context.Context, companyID int64, code string) (*organization.Division, error) {
+	var d organization.Division
+	err := postgres.Conn(ctx, r.db).Where("company_id = ? AND code = ?", companyID, code).First(&d).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("division not found")
+	}
+	return &d, err
+}
+
+func (r *divisionRepository) ListByCompany(ctx context.Context, companyID int64, activeOnly bool) ([]organization.Division, error) {
+	var list []organization.Division
+	q := postgres.Conn(ctx, r.db).Where("company_id = ?", companyID)
+	if activeOnly {
+		q = q.Where("is_active = ?", true)
+	}
+	err := q.Order("name ASC").Find(&list).Error
+	return list, err
+}
+
+func (r *divisionRepository) Update(ctx context.Context, d *organization.Division) error {
+	return postgres.Conn(ctx, r.db).Save(d).Error
+}
+
+func (r *divisionRepository) Delete(ctx context.Context, d *organization.Division) error {
+	return postgres.Conn(ctx, r.db).Delete(d).Error
+}
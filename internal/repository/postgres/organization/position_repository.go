@@ -0,0 +1,71 @@
+package organization
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/organization"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type positionRepository struct {
+	db *gorm.DB
+}
+
+func NewPositionRepository(db *gorm.DB) repository.PositionRepository {
+	return &positionRepository{db: db}
+}
+
+func (r *positionRepository) Create(ctx context.Context, p *organization.Position) error {
+	return postgres.Conn(ctx, r.db).Create(p).Error
+}
+
+func (r *positionRepository) FindByID(ctx context.Context, companyID, id int64) (*organization.Position, error) {
+	var p organization.Position
+	err := postgres.Conn(ctx, r.db).Where("company_id = ? AND id = ?", companyID, id).First(&p).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("position not found")
+	}
+	return &p, err
+}
+
+func (r *positionRepository) FindByCode(ctx context.Context, companyID int64, code string) (*organization.Position, error) {
+	var p organization.Position
+	err := postgres.Conn(ctx, r.db).Where("company_id = ? AND code = ?", companyID, code).First(&p).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("position not found")
+	}
+	return &p, err
+}
+
+func (r *positionRepository) ListByCompany(ctx context.Context, companyID, departmentID int64, activeOnly bool) ([]organization.Position, error) {
+	var list []organization.Position
+	q := postgres.Conn(ctx, r.db).Where("company_id = ?", companyID)
+	if departmentID != 0 {
+		q = q.Where("department_id = ?", departmentID)
+	}
+	if activeOnly {
+		q = q.Where("is_active = ?", true)
+	}
+	err := q.Order("title ASC").Find(&list).Error
+	return list, err
+}
+
+func (r *positionRepository) CountByDepartment(ctx context.Context, departmentID int64) (int64, error) {
+	var count int64
+	err := postgres.Conn(ctx, r.db).
+		Model(&organization.Position{}).
+		Where("department_id = ?", departmentID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *positionRepository) Update(ctx context.Context, p *organization.Position) error {
+	return postgres.Conn(ctx, r.db).Save(p).Error
+}
+
+func (r *positionRepository) Delete(ctx context.Context, p *organization.Position) error {
+	return postgres.Conn(ctx, r.db).Delete(p).Error
+}
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type txKey struct{}
+
+type transactor struct {
+	db *gorm.DB
+}
+
+func NewTransactor(db *gorm.DB) repository.Transactor {
+	return &transactor{db: db}
+}
+
+// WithinTransaction runs fn inside a database transaction. Repositories pick
+// the transaction up from ctx through Conn, so nested calls join the outer
+// transaction instead of opening a new one.
+func (t *transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// Conn returns the transaction bound to ctx, or db scoped to ctx when no
+// transaction is running.
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return db.WithContext(ctx)
+}
@@ -0,0 +1,56 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/rbac"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type roleRepository struct {
+	db *gorm.DB
+}
+
+func NewRoleRepository(db *gorm.DB) repository.RoleRepository {
+	return &roleRepository{db: db}
+}
+
+func (r *roleRepository) FindByID(ctx context.Context, id int64) (*rbac.Role, error) {
+	var role rbac.Role
+	err := postgres.Conn(ctx, r.db).Where("id = ?", id).First(&role).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("role not found")
+	}
+	return &role, err
+}
+
+func (r *roleRepository) FindSystemByCode(ctx context.Context, code string) (*rbac.Role, error) {
+	var role rbac.Role
+	err := postgres.Conn(ctx, r.db).
+		Where("company_id IS NULL AND is_system = true AND code = ?", code).
+		First(&role).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("role not found")
+	}
+	return &role, err
+}
+
+func (r *roleRepository) ListForCompany(ctx context.Context, companyID int64) ([]rbac.Role, error) {
+	var roles []rbac.Role
+	err := postgres.Conn(ctx, r.db).
+		Where("company_id IS NULL OR company_id = ?", companyID).
+		Order("is_system DESC, created_at ASC").
+		Find(&roles).Error
+	return roles, err
+}
+
+// EnsureSystem inserts a system role unless one with the same code exists.
+// On return role holds the persisted row.
+func (r *roleRepository) EnsureSystem(ctx context.Context, role *rbac.Role) error {
+	return postgres.Conn(ctx, r.db).
+		Where("company_id IS NULL AND code = ?", role.Code).
+		FirstOrCreate(role).Error
+}
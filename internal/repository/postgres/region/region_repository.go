@@ -0,0 +1,184 @@
+package region
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/region"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const upsertBatchSize = 1000
+
+type regionRepository struct {
+	db *gorm.DB
+}
+
+func NewRegionRepository(db *gorm.DB) repository.RegionRepository {
+	return &regionRepository{db: db}
+}
+
+func (r *regionRepository) ListProvinces(ctx context.Context) ([]region.Province, error) {
+	var provinces []region.Province
+	err := postgres.Conn(ctx, r.db).Order("code ASC").Find(&provinces).Error
+	return provinces, err
+}
+
+func (r *regionRepository) FindProvinceByID(ctx context.Context, id int) (*region.Province, error) {
+	var p region.Province
+	err := postgres.Conn(ctx, r.db).Where("id = ?", id).First(&p).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("province not found")
+	}
+	return &p, err
+}
+
+func (r *regionRepository) FindCityByID(ctx context.Context, id int) (*region.City, error) {
+	var c region.City
+	err := postgres.Conn(ctx, r.db).Where("id = ?", id).First(&c).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("city not found")
+	}
+	return &c, err
+}
+
+func (r *regionRepository) FindDistrictByID(ctx context.Context, id int) (*region.District, error) {
+	var d region.District
+	err := postgres.Conn(ctx, r.db).Where("id = ?", id).First(&d).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("district not found")
+	}
+	return &d, err
+}
+
+func (r *regionRepository) FindVillageByID(ctx context.Context, id int) (*region.Village, error) {
+	var v region.Village
+	err := postgres.Conn(ctx, r.db).Where("id = ?", id).First(&v).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("village not found")
+	}
+	return &v, err
+}
+
+func (r *regionRepository) ListCitiesByProvince(ctx context.Context, provinceID int) ([]region.City, error) {
+	var cities []region.City
+	err := postgres.Conn(ctx, r.db).Where("province_id = ?", provinceID).Order("full_code ASC").Find(&cities).Error
+	return cities, err
+}
+
+func (r *regionRepository) ListDistrictsByCity(ctx context.Context, cityID int) ([]region.District, error) {
+	var districts []region.District
+	err := postgres.Conn(ctx, r.db).Where("city_id = ?", cityID).Order("full_code ASC").Find(&districts).Error
+	return districts, err
+}
+
+func (r *regionRepository) ListVillagesByDistrict(ctx context.Context, districtID int) ([]region.Village, error) {
+	var villages []region.Village
+	err := postgres.Conn(ctx, r.db).Where("district_id = ?", districtID).Order("full_code ASC").Find(&villages).Error
+	return villages, err
+}
+
+func (r *regionRepository) SearchVillages(ctx context.Context, name, posCode string, limit int) ([]region.Village, error) {
+	var villages []region.Village
+	q := postgres.Conn(ctx, r.db).Preload("District.City.Province")
+	if name != "" {
+		q = q.Where("name ILIKE ?", "%"+name+"%")
+	}
+	if posCode != "" {
+		q = q.Where("pos_code = ?", posCode)
+	}
+	err := q.Order("name ASC, full_code ASC").Limit(limit).Find(&villages).Error
+	return villages, err
+}
+
+func (r *regionRepository) FindVillageByFullCode(ctx context.Context, fullCode string) (*region.Village, error) {
+	var v region.Village
+	err := postgres.Conn(ctx, r.db).
+		Preload("District.City.Province").
+		Where("full_code = ?", fullCode).
+		First(&v).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("village not found")
+	}
+	return &v, err
+}
+
+func (r *regionRepository) FindDistrictByFullCode(ctx context.Context, fullCode string) (*region.District, error) {
+	var d region.District
+	err := postgres.Conn(ctx, r.db).Where("full_code = ?", fullCode).First(&d).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("district not found")
+	}
+	return &d, err
+}
+
+func (r *regionRepository) UpsertProvinces(ctx context.Context, provinces []region.Province) error {
+	if len(provinces) == 0 {
+		return nil
+	}
+	return postgres.Conn(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "code"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name"}),
+	}).CreateInBatches(provinces, upsertBatchSize).Error
+}
+
+func (r *regionRepository) UpsertCities(ctx context.Context, cities []region.City) error {
+	if len(cities) == 0 {
+		return nil
+	}
+	return postgres.Conn(ctx, r.db).Omit("Province").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "full_code"}},
+		DoUpdates: clause.AssignmentColumns([]string{"type", "name", "code", "province_id"}),
+	}).CreateInBatches(cities, upsertBatchSize).Error
+}
+
+func (r *regionRepository) UpsertDistricts(ctx context.Context, districts []region.District) error {
+	if len(districts) == 0 {
+		return nil
+	}
+	return postgres.Conn(ctx, r.db).Omit("City").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "full_code"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "code", "city_id"}),
+	}).CreateInBatches(districts, upsertBatchSize).Error
+}
+
+func (r *regionRepository) UpsertVillages(ctx context.Context, villages []region.Village) error {
+	if len(villages) == 0 {
+		return nil
+	}
+	return postgres.Conn(ctx, r.db).Omit("District").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "full_code"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "code", "pos_code", "district_id"}),
+	}).CreateInBatches(villages, upsertBatchSize).Error
+}
+
+func (r *regionRepository) ProvinceIDs(ctx context.Context) (map[string]int, error) {
+	return r.ids(ctx, &region.Province{}, "code")
+}
+
+func (r *regionRepository) CityIDs(ctx context.Context) (map[string]int, error) {
+	return r.ids(ctx, &region.City{}, "full_code")
+}
+
+func (r *regionRepository) DistrictIDs(ctx context.Context) (map[string]int, error) {
+	return r.ids(ctx, &region.District{}, "full_code")
+}
+
+func (r *regionRepository) ids(ctx context.Context, model interface{}, column string) (map[string]int, error) {
+	var rows []struct {
+		ID   int
+		Code string
+	}
+	err := postgres.Conn(ctx, r.db).Model(model).Select("id, " + column + " AS code").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]int, len(rows))
+	for _, row := range rows {
+		ids[row.Code] = row.ID
+	}
+	return ids, nil
+}
@@ -0,0 +1,46 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/subscription"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type companyModuleRepository struct {
+	db *gorm.DB
+}
+
+func NewCompanyModuleRepository(db *gorm.DB) repository.CompanyModuleRepository {
+	return &companyModuleRepository{db: db}
+}
+
+func (r *companyModuleRepository) FindByCompanyAndModule(ctx context.Context, companyID, moduleID int64) (*subscription.CompanyModule, error) {
+	var cm subscription.CompanyModule
+	err := postgres.Conn(ctx, r.db).
+		Where("company_id = ? AND module_id = ?", companyID, moduleID).
+		First(&cm).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("company module not found")
+	}
+	return &cm, err
+}
+
+func (r *companyModuleRepository) ListByCompany(ctx context.Context, companyID int64) ([]subscription.CompanyModule, error) {
+	var cms []subscription.CompanyModule
+	err := postgres.Conn(ctx, r.db).Where("company_id = ?", companyID).Find(&cms).Error
+	return cms, err
+}
+
+func (r *companyModuleRepository) Save(ctx context.Context, cm *subscription.CompanyModule) error {
+	return postgres.Conn(ctx, r.db).Save(cm).Error
+}
+
+func (r *companyModuleRepository) Delete(ctx context.Context, companyID, moduleID int64) error {
+	return postgres.Conn(ctx, r.db).
+		Where("company_id = ? AND module_id = ?", companyID, moduleID).
+		Delete(&subscription.CompanyModule{}).Error
+}
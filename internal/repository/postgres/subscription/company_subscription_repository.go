@@ -0,0 +1,66 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/subscription"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type companySubscriptionRepository struct {
+	db *gorm.DB
+}
+
+func NewCompanySubscriptionRepository(db *gorm.DB) repository.CompanySubscriptionRepository {
+	return &companySubscriptionRepository{db: db}
+}
+
+func (r *companySubscriptionRepository) Create(ctx context.Context, s *subscription.CompanySubscription) error {
+	return postgres.Conn(ctx, r.db).Create(s).Error
+}
+
+func (r *companySubscriptionRepository) FindByID(ctx context.Context, id int64) (*subscription.CompanySubscription, error) {
+	var s subscription.CompanySubscription
+	err := postgres.Conn(ctx, r.db).Where("id = ?", id).First(&s).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("subscription not found")
+	}
+	return &s, err
+}
+
+func (r *companySubscriptionRepository) FindCurrentByCompany(ctx context.Context, companyID int64) (*subscription.CompanySubscription, error) {
+	var s subscription.CompanySubscription
+	err := postgres.Conn(ctx, r.db).
+		Where("company_id = ?", companyID).
+		Order("created_at DESC").
+		First(&s).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("subscription not found")
+	}
+	return &s, err
+}
+
+func (r *companySubscriptionRepository) Update(ctx context.Context, s *subscription.CompanySubscription) error {
+	return postgres.Conn(ctx, r.db).Save(s).Error
+}
+
+func (r *companySubscriptionRepository) ListPeriodEnded(ctx context.Context, now time.Time) ([]subscription.CompanySubscription, error) {
+	var subs []subscription.CompanySubscription
+	err := postgres.Conn(ctx, r.db).
+		Where("status <> ? AND current_period_end <= ?", subscription.StatusExpired, now).
+		Order("current_period_end ASC").
+		Find(&subs).Error
+	return subs, err
+}
+
+func (r *companySubscriptionRepository) ListTrialsEndingBefore(ctx context.Context, before time.Time) ([]subscription.CompanySubscription, error) {
+	var subs []subscription.CompanySubscription
+	err := postgres.Conn(ctx, r.db).
+		Where("status = ? AND trial_ends_at <= ? AND trial_reminder_sent_at IS NULL", subscription.StatusTrial, before).
+		Find(&subs).Error
+	return subs, err
+}
@@ -0,0 +1,57 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/haily-id/engine/internal/domain/entity/subscription"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type companyUsageRepository struct {
+	db *gorm.DB
+}
+
+func NewCompanyUsageRepository(db *gorm.DB) repository.CompanyUsageRepository {
+	return &companyUsageRepository{db: db}
+}
+
+func (r *companyUsageRepository) FindByCompany(ctx context.Context, companyID int64) (*subscription.CompanyUsage, error) {
+	var u subscription.CompanyUsage
+	err := postgres.Conn(ctx, r.db).Where("company_id = ?", companyID).First(&u).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &subscription.CompanyUsage{CompanyID: companyID}, nil
+	}
+	return &u, err
+}
+
+func (r *companyUsageRepository) AddStorage(ctx context.Context, companyID, delta, limit int64) error {
+	db := postgres.Conn(ctx, r.db)
+
+	err := db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&subscription.CompanyUsage{CompanyID: companyID}).Error
+	if err != nil {
+		return err
+	}
+
+	q := db.Model(&subscription.CompanyUsage{}).Where("company_id = ?", companyID)
+	if limit > 0 && delta > 0 {
+		q = q.Where("storage_bytes + ? <= ?", delta, limit)
+	}
+
+	res := q.Updates(map[string]interface{}{
+		"storage_bytes": gorm.Expr("GREATEST(storage_bytes + ?, 0)", delta),
+		"updated_at":    time.Now(),
+	})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return errors.New("storage quota exceeded")
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/subscription"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type moduleRepository struct {
+	db *gorm.DB
+}
+
+func NewModuleRepository(db *gorm.DB) repository.ModuleRepository {
+	return &moduleRepository{db: db}
+}
+
+func (r *moduleRepository) List(ctx context.Context) ([]subscription.Module, error) {
+	var modules []subscription.Module
+	err := postgres.Conn(ctx, r.db).Order("created_at ASC").Find(&modules).Error
+	return modules, err
+}
+
+func (r *moduleRepository) FindByID(ctx context.Context, id int64) (*subscription.Module, error) {
+	var m subscription.Module
+	err := postgres.Conn(ctx, r.db).Where("id = ?", id).First(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("module not found")
+	}
+	return &m, err
+}
+
+func (r *moduleRepository) FindByCode(ctx context.Context, code string) (*subscription.Module, error) {
+	var m subscription.Module
+	err := postgres.Conn(ctx, r.db).Where("code = ?", code).First(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("module not found")
+	}
+	return &m, err
+}
+
+func (r *moduleRepository) Update(ctx context.Context, m *subscription.Module) error {
+	return postgres.Conn(ctx, r.db).Save(m).Error
+}
+
+func (r *moduleRepository) ListByPlanID(ctx context.Context, planID int64) ([]subscription.Module, error) {
+	var modules []subscription.Module
+	err := postgres.Conn(ctx, r.db).
+		Joins("JOIN plan_modules ON plan_modules.module_id = modules.id").
+		Where("plan_modules.plan_id = ?", planID).
+		Order("modules.created_at ASC").
+		Find(&modules).Error
+	return modules, err
+}
+
+func (r *moduleRepository) ReplacePlanModules(ctx context.Context, planID int64, pms []subscription.PlanModule) error {
+	db := postgres.Conn(ctx, r.db)
+	if err := db.Where("plan_id = ?", planID).Delete(&subscription.PlanModule{}).Error; err != nil {
+		return err
+	}
+	if len(pms) == 0 {
+		return nil
+	}
+	return db.Create(&pms).Error
+}
+
+// Ensure inserts m unless a module with the same code exists. On return m
+// holds the persisted row.
+func (r *moduleRepository) Ensure(ctx context.Context, m *subscription.Module) error {
+	return postgres.Conn(ctx, r.db).Where("code = ?", m.Code).FirstOrCreate(m).Error
+}
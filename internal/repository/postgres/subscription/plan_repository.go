@@ -0,0 +1,61 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/entity/subscription"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type planRepository struct {
+	db *gorm.DB
+}
+
+func NewPlanRepository(db *gorm.DB) repository.SubscriptionPlanRepository {
+	return &planRepository{db: db}
+}
+
+func (r *planRepository) Create(ctx context.Context, p *subscription.Plan) error {
+	return postgres.Conn(ctx, r.db).Create(p).Error
+}
+
+func (r *planRepository) FindByID(ctx context.Context, id int64) (*subscription.Plan, error) {
+	var p subscription.Plan
+	err := postgres.Conn(ctx, r.db).Where("id = ?", id).First(&p).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("plan not found")
+	}
+	return &p, err
+}
+
+func (r *planRepository) FindByCode(ctx context.Context, code string) (*subscription.Plan, error) {
+	var p subscription.Plan
+	err := postgres.Conn(ctx, r.db).Where("code = ?", code).First(&p).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("plan not found")
+	}
+	return &p, err
+}
+
+func (r *planRepository) List(ctx context.Context, publicOnly bool) ([]subscription.Plan, error) {
+	var plans []subscription.Plan
+	q := postgres.Conn(ctx, r.db)
+	if publicOnly {
+		q = q.Where("is_public = true AND is_active = true")
+	}
+	err := q.Order("price_monthly ASC").Find(&plans).Error
+	return plans, err
+}
+
+func (r *planRepository) Update(ctx context.Context, p *subscription.Plan) error {
+	return postgres.Conn(ctx, r.db).Save(p).Error
+}
+
+// Ensure inserts p unless a plan with the same code exists. On return p
+// holds the persisted row.
+func (r *planRepository) Ensure(ctx context.Context, p *subscription.Plan) error {
+	return postgres.Conn(ctx, r.db).Where("code = ?", p.Code).FirstOrCreate(p).Error
+}
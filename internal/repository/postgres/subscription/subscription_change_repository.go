@@ -0,0 +1,31 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/haily-id/engine/internal/domain/entity/subscription"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
+	"gorm.io/gorm"
+)
+
+type subscriptionChangeRepository struct {
+	db *gorm.DB
+}
+
+func NewSubscriptionChangeRepository(db *gorm.DB) repository.SubscriptionChangeRepository {
+	return &subscriptionChangeRepository{db: db}
+}
+
+func (r *subscriptionChangeRepository) Create(ctx context.Context, c *subscription.SubscriptionChange) error {
+	return postgres.Conn(ctx, r.db).Create(c).Error
+}
+
+func (r *subscriptionChangeRepository) ListBySubscription(ctx context.Context, subscriptionID int64) ([]subscription.SubscriptionChange, error) {
+	var changes []subscription.SubscriptionChange
+	err := postgres.Conn(ctx, r.db).
+		Where("subscription_id = ?", subscriptionID).
+		Order("created_at DESC").
+		Find(&changes).Error
+	return changes, err
+}
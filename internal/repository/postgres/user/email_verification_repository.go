@@ -7,6 +7,7 @@ import (
 
 	"github.com/haily-id/engine/internal/domain/entity/user"
 	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
 	"gorm.io/gorm"
 )
 
@@ -19,12 +20,12 @@ func NewEmailVerificationRepository(db *gorm.DB) repository.EmailVerificationRep
 }
 
 func (r *emailVerificationRepository) Create(ctx context.Context, ev *user.EmailVerification) error {
-	return r.db.WithContext(ctx).Create(ev).Error
+	return postgres.Conn(ctx, r.db).Create(ev).Error
 }
 
 func (r *emailVerificationRepository) FindByToken(ctx context.Context, token string) (*user.EmailVerification, error) {
 	var ev user.EmailVerification
-	err := r.db.WithContext(ctx).Where("token = ?", token).First(&ev).Error
+	err := postgres.Conn(ctx, r.db).Where("token = ?", token).First(&ev).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, errors.New("verification not found")
 	}
@@ -33,7 +34,7 @@ func (r *emailVerificationRepository) FindByToken(ctx context.Context, token str
 
 func (r *emailVerificationRepository) FindActiveByUserIDAndType(ctx context.Context, userID int64, verType string) (*user.EmailVerification, error) {
 	var ev user.EmailVerification
-	err := r.db.WithContext(ctx).
+	err := postgres.Conn(ctx, r.db).
 		Where("user_id = ? AND type = ? AND is_used = false AND expires_at > ?", userID, verType, time.Now()).
 		Order("created_at DESC").
 		First(&ev).Error
@@ -44,28 +45,28 @@ func (r *emailVerificationRepository) FindActiveByUserIDAndType(ctx context.Cont
 }
 
 func (r *emailVerificationRepository) MarkUsed(ctx context.Context, id int64) error {
-	return r.db.WithContext(ctx).
+	return postgres.Conn(ctx, r.db).
 		Model(&user.EmailVerification{}).
 		Where("id = ?", id).
 		Update("is_used", true).Error
 }
 
 func (r *emailVerificationRepository) IncrementAttempts(ctx context.Context, id int64) error {
-	return r.db.WithContext(ctx).
+	return postgres.Conn(ctx, r.db).
 		Model(&user.EmailVerification{}).
 		Where("id = ?", id).
 		UpdateColumn("attempts_used", gorm.Expr("attempts_used + 1")).Error
 }
 
 func (r *emailVerificationRepository) InvalidateByUserIDAndType(ctx context.Context, userID int64, verType string) error {
-	return r.db.WithContext(ctx).
+	return postgres.Conn(ctx, r.db).
 		Model(&user.EmailVerification{}).
 		Where("user_id = ? AND type = ? AND is_used = false", userID, verType).
 		Update("is_used", true).Error
 }
 
 func (r *emailVerificationRepository) DeleteByUserID(ctx context.Context, userID int64) error {
-	return r.db.WithContext(ctx).
+	return postgres.Conn(ctx, r.db).
 		Where("user_id = ?", userID).
 		Delete(&user.EmailVerification{}).Error
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/haily-id/engine/internal/domain/entity/user"
 	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/repository/postgres"
 	"gorm.io/gorm"
 )
 
@@ -18,12 +19,12 @@ func NewUserRepository(db *gorm.DB) repository.UserRepository {
 }
 
 func (r *userRepository) Create(ctx context.Context, u *user.User) error {
-	return r.db.WithContext(ctx).Create(u).Error
+	return postgres.Conn(ctx, r.db).Create(u).Error
 }
 
 func (r *userRepository) FindByID(ctx context.Context, id int64) (*user.User, error) {
 	var u user.User
-	err := r.db.WithContext(ctx).Where("id = ?", id).First(&u).Error
+	err := postgres.Conn(ctx, r.db).Where("id = ?", id).First(&u).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, errors.New("user not found")
 	}
@@ -32,7 +33,7 @@ func (r *userRepository) FindByID(ctx context.Context, id int64) (*user.User, er
 
 func (r *userRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
 	var u user.User
-	err := r.db.WithContext(ctx).Where("email = ?", email).First(&u).Error
+	err := postgres.Conn(ctx, r.db).Where("email = ?", email).First(&u).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, errors.New("user not found")
 	}
@@ -41,7 +42,7 @@ func (r *userRepository) FindByEmail(ctx context.Context, email string) (*user.U
 
 func (r *userRepository) FindByGoogleID(ctx context.Context, googleID string) (*user.User, error) {
 	var u user.User
-	err := r.db.WithContext(ctx).Where("google_id = ?", googleID).First(&u).Error
+	err := postgres.Conn(ctx, r.db).Where("google_id = ?", googleID).First(&u).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, errors.New("user not found")
 	}
@@ -49,9 +50,9 @@ func (r *userRepository) FindByGoogleID(ctx context.Context, googleID string) (*
 }
 
 func (r *userRepository) Update(ctx context.Context, u *user.User) error {
-	return r.db.WithContext(ctx).Save(u).Error
+	return postgres.Conn(ctx, r.db).Save(u).Error
 }
 
 func (r *userRepository) Delete(ctx context.Context, id int64) error {
-	return r.db.WithContext(ctx).Delete(&user.User{}, id).Error
+	return postgres.Conn(ctx, r.db).Delete(&user.User{}, id).Error
 }
@@ -81,6 +81,51 @@ func (c *Cache) DeletePattern(ctx context.Context, pattern string) error {
 	return nil
 }
 
+func (c *Cache) Publish(ctx context.Context, channel string, message interface{}) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	return c.client.Publish(ctx, channel, data).Err()
+}
+
+// Subscribe forwards the payloads published to channel until ctx is done.
+// The connection reconnects and resubscribes on its own after network
+// errors.
+func (c *Cache) Subscribe(ctx context.Context, channel string) (<-chan []byte, error) {
+	ps := c.client.Subscribe(ctx, channel)
+	if _, err := ps.Receive(ctx); err != nil {
+		ps.Close()
+		return nil, err
+	}
+
+	out := make(chan []byte)
+	go func() {
+		defer close(out)
+		defer ps.Close()
+
+		messages := ps.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case m, ok := <-messages:
+				if !ok {
+					return
+				}
+				select {
+				case out <- []byte(m.Payload):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
 func (c *Cache) Close() error {
 	return c.client.Close()
 }
@@ -101,3 +146,31 @@ func CompanyKey(id int64) string {
 func CompanyCodeKey(code string) string {
 	return fmt.Sprintf("company:code:%s", code)
 }
+
+func EntitlementKey(companyID int64) string {
+	return fmt.Sprintf("entitlement:company:%d", companyID)
+}
+
+func EntitlementPattern() string {
+	return "entitlement:company:*"
+}
+
+func RegionKey(parts ...interface{}) string {
+	key := "region"
+	for _, p := range parts {
+		key += fmt.Sprintf(":%v", p)
+	}
+	return key
+}
+
+func RegionPattern() string {
+	return "region:*"
+}
+
+func SettingKey(companyID int64) string {
+	return fmt.Sprintf("setting:company:%d", companyID)
+}
+
+func NotificationChannel() string {
+	return "notification:events"
+}
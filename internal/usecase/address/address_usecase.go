@@ -0,0 +1,336 @@
+package address
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+	"github.com/haily-id/engine/internal/usecase/region"
+)
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+type CreateAddressRequest struct {
+	Type           string   `json:"type"          validate:"required,oneof=MAIN BRANCH CORRESPONDENCE REGIONAL WAREHOUSE"`
+	AddressLine1   string   `json:"address_line1" validate:"required,max=500"`
+	AddressLine2   *string  `json:"address_line2" validate:"omitempty,max=500"`
+	ProvinceID     int      `json:"province_id"   validate:"required,gt=0"`
+	CityID         int      `json:"city_id"       validate:"required,gt=0"`
+	DistrictID     int      `json:"district_id"   validate:"required,gt=0"`
+	VillageID      *int     `json:"village_id"    validate:"omitempty,gt=0"`
+	PostalCode     *string  `json:"postal_code"   validate:"omitempty,numeric,len=5"`
+	Phone          *string  `json:"phone"         validate:"omitempty,max=50"`
+	PICName        *string  `json:"pic_name"      validate:"omitempty,max=255"`
+	PICPhone       *string  `json:"pic_phone"     validate:"omitempty,max=50"`
+	Latitude       *float64 `json:"latitude"        validate:"required_with=Longitude,omitempty,min=-90,max=90"`
+	Longitude      *float64 `json:"longitude"       validate:"required_with=Latitude,omitempty,min=-180,max=180"`
+	GeofenceRadius *int     `json:"geofence_radius" validate:"omitempty,min=10,max=10000"`
+	IsPrimary      bool     `json:"is_primary"`
+}
+
+// UpdateAddressRequest changes the given fields. The region is replaced as a
+// whole: province_id, city_id and district_id come together, and village_id
+// is cleared when it is left out of a region change. Latitude and longitude
+// also come together.
+type UpdateAddressRequest struct {
+	Type           *string  `json:"type"          validate:"omitempty,oneof=MAIN BRANCH CORRESPONDENCE REGIONAL WAREHOUSE"`
+	AddressLine1   *string  `json:"address_line1" validate:"omitempty,min=1,max=500"`
+	AddressLine2   *string  `json:"address_line2" validate:"omitempty,max=500"`
+	ProvinceID     *int     `json:"province_id"   validate:"required_with=CityID DistrictID VillageID,omitempty,gt=0"`
+	CityID         *int     `json:"city_id"       validate:"required_with=ProvinceID DistrictID VillageID,omitempty,gt=0"`
+	DistrictID     *int     `json:"district_id"   validate:"required_with=ProvinceID CityID VillageID,omitempty,gt=0"`
+	VillageID      *int     `json:"village_id"    validate:"omitempty,gt=0"`
+	PostalCode     *string  `json:"postal_code"   validate:"omitempty,numeric,len=5"`
+	Phone          *string  `json:"phone"         validate:"omitempty,max=50"`
+	PICName        *string  `json:"pic_name"      validate:"omitempty,max=255"`
+	PICPhone       *string  `json:"pic_phone"     validate:"omitempty,max=50"`
+	Latitude       *float64 `json:"latitude"        validate:"required_with=Longitude,omitempty,min=-90,max=90"`
+	Longitude      *float64 `json:"longitude"       validate:"required_with=Latitude,omitempty,min=-180,max=180"`
+	GeofenceRadius *int     `json:"geofence_radius" validate:"omitempty,min=10,max=10000"`
+	IsPrimary      *bool    `json:"is_primary"`
+	IsActive       *bool    `json:"is_active"`
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	addressRepo      repository.CompanyAddressRepository
+	companyRepo      repository.CompanyRepository
+	regionRepo       repository.RegionRepository
+	workLocationRepo repository.WorkLocationRepository
+	transactor       repository.Transactor
+	auditor          audit.Recorder
+}
+
+func NewUseCase(
+	addressRepo repository.CompanyAddressRepository,
+	companyRepo repository.CompanyRepository,
+	regionRepo repository.RegionRepository,
+	workLocationRepo repository.WorkLocationRepository,
+	transactor repository.Transactor,
+	auditor audit.Recorder,
+) *UseCase {
+	return &UseCase{
+		addressRepo:      addressRepo,
+		companyRepo:      companyRepo,
+		regionRepo:       regionRepo,
+		workLocationRepo: workLocationRepo,
+		transactor:       transactor,
+		auditor:          auditor,
+	}
+}
+
+func (uc *UseCase) List(ctx context.Context, companyID int64) ([]companyEntity.Address, error) {
+	return uc.addressRepo.ListByCompany(ctx, companyID)
+}
+
+func (uc *UseCase) Get(ctx context.Context, companyID, id int64) (*companyEntity.Address, error) {
+	return uc.addressRepo.FindByID(ctx, companyID, id)
+}
+
+// Create adds an address. The company's first address must be MAIN and
+// becomes primary; a later MAIN address created with is_primary takes the
+// primary flag over.
+func (uc *UseCase) Create(ctx context.Context, companyID int64, req CreateAddressRequest) (*companyEntity.Address, error) {
+	postalCode, err := region.CheckChain(ctx, uc.regionRepo, req.ProvinceID, req.CityID, req.DistrictID, req.VillageID)
+	if err != nil {
+		return nil, err
+	}
+	if req.PostalCode != nil {
+		postalCode = req.PostalCode
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	a := &companyEntity.Address{
+		ID:             id,
+		CompanyID:      companyID,
+		Type:           req.Type,
+		AddressLine1:   req.AddressLine1,
+		AddressLine2:   req.AddressLine2,
+		ProvinceID:     req.ProvinceID,
+		CityID:         req.CityID,
+		DistrictID:     req.DistrictID,
+		VillageID:      req.VillageID,
+		PostalCode:     postalCode,
+		Phone:          req.Phone,
+		PICName:        req.PICName,
+		PICPhone:       req.PICPhone,
+		Latitude:       req.Latitude,
+		Longitude:      req.Longitude,
+		GeofenceRadius: req.GeofenceRadius,
+		IsPrimary:      req.IsPrimary,
+		IsActive:       true,
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Serializes address changes per company so two requests cannot
+		// both take the primary flag.
+		if _, err := uc.companyRepo.LockByID(ctx, companyID); err != nil {
+			return err
+		}
+
+		count, err := uc.addressRepo.CountByCompany(ctx, companyID)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			if a.Type != companyEntity.AddressTypeMain {
+				return errors.New("first address must be main")
+			}
+			a.IsPrimary = true
+		}
+
+		if a.IsPrimary {
+			if a.Type != companyEntity.AddressTypeMain {
+				return errors.New("primary address must be main")
+			}
+			if err := uc.addressRepo.ClearPrimary(ctx, companyID); err != nil {
+				return err
+			}
+		}
+
+		if err := uc.addressRepo.Create(ctx, a); err != nil {
+			return fmt.Errorf("failed to create address: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditor.Record(ctx, audit.Entry{
+		CompanyID: companyID,
+		Module:    audit.ModuleGeneral,
+		Entity:    "company_addresses",
+		EntityID:  a.ID,
+		Action:    audit.ActionCreate,
+		New:       a,
+	})
+
+	return uc.addressRepo.FindByID(ctx, companyID, a.ID)
+}
+
+// Update changes an address. The primary address stays MAIN and active and
+// keeps its flag until another MAIN address is made primary.
+func (uc *UseCase) Update(ctx context.Context, companyID, id int64, req UpdateAddressRequest) (*companyEntity.Address, error) {
+	var postalCode *string
+	if req.ProvinceID != nil {
+		var err error
+		postalCode, err = region.CheckChain(ctx, uc.regionRepo, *req.ProvinceID, *req.CityID, *req.DistrictID, req.VillageID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var before, after companyEntity.Address
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.companyRepo.LockByID(ctx, companyID); err != nil {
+			return err
+		}
+
+		a, err := uc.addressRepo.FindByID(ctx, companyID, id)
+		if err != nil {
+			return err
+		}
+		before = *a
+		wasPrimary := a.IsPrimary
+
+		if req.Type != nil {
+			a.Type = *req.Type
+		}
+		if req.AddressLine1 != nil {
+			a.AddressLine1 = *req.AddressLine1
+		}
+		if req.AddressLine2 != nil {
+			a.AddressLine2 = req.AddressLine2
+		}
+		if req.ProvinceID != nil {
+			a.ProvinceID = *req.ProvinceID
+			a.CityID = *req.CityID
+			a.DistrictID = *req.DistrictID
+			a.VillageID = req.VillageID
+			a.PostalCode = postalCode
+		}
+		if req.PostalCode != nil {
+			a.PostalCode = req.PostalCode
+		}
+		if req.Phone != nil {
+			a.Phone = req.Phone
+		}
+		if req.PICName != nil {
+			a.PICName = req.PICName
+		}
+		if req.PICPhone != nil {
+			a.PICPhone = req.PICPhone
+		}
+		if req.Latitude != nil {
+			a.Latitude = req.Latitude
+			a.Longitude = req.Longitude
+		}
+		if req.GeofenceRadius != nil {
+			a.GeofenceRadius = req.GeofenceRadius
+		}
+		if req.IsActive != nil {
+			a.IsActive = *req.IsActive
+		}
+		if req.IsPrimary != nil {
+			if wasPrimary && !*req.IsPrimary {
+				return errors.New("primary address required")
+			}
+			a.IsPrimary = *req.IsPrimary
+		}
+
+		if a.IsPrimary {
+			if a.Type != companyEntity.AddressTypeMain {
+				return errors.New("primary address must be main")
+			}
+			if !a.IsActive {
+				return errors.New("primary address must be active")
+			}
+			if !wasPrimary {
+				if err := uc.addressRepo.ClearPrimary(ctx, companyID); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := uc.addressRepo.Update(ctx, a); err != nil {
+			return fmt.Errorf("failed to update address: %w", err)
+		}
+		after = *a
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditor.Record(ctx, audit.Entry{
+		CompanyID: companyID,
+		Module:    audit.ModuleGeneral,
+		Entity:    "company_addresses",
+		EntityID:  id,
+		Action:    audit.ActionUpdate,
+		Old:       before,
+		New:       after,
+	})
+
+	return uc.addressRepo.FindByID(ctx, companyID, id)
+}
+
+// Delete removes an address no employee work location references. The
+// primary address can only go when it is the company's last address.
+func (uc *UseCase) Delete(ctx context.Context, companyID, id int64) error {
+	var deleted *companyEntity.Address
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.companyRepo.LockByID(ctx, companyID); err != nil {
+			return err
+		}
+
+		a, err := uc.addressRepo.FindByID(ctx, companyID, id)
+		if err != nil {
+			return err
+		}
+
+		used, err := uc.workLocationRepo.CountByAddress(ctx, a.ID)
+		if err != nil {
+			return err
+		}
+		if used > 0 {
+			return errors.New("address in use")
+		}
+
+		if a.IsPrimary {
+			count, err := uc.addressRepo.CountByCompany(ctx, companyID)
+			if err != nil {
+				return err
+			}
+			if count > 1 {
+				return errors.New("primary address required")
+			}
+		}
+
+		deleted = a
+		return uc.addressRepo.Delete(ctx, a)
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.auditor.Record(ctx, audit.Entry{
+		CompanyID: companyID,
+		Module:    audit.ModuleGeneral,
+		Entity:    "company_addresses",
+		EntityID:  id,
+		Action:    audit.ActionDelete,
+		Old:       deleted,
+	})
+	return nil
+}
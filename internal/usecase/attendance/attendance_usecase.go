@@ -0,0 +1,250 @@
+// Package attendance records employees clocking in and out, geofenced
+// against the addresses of their work locations, flags late arrivals and
+// early leaves against their shifts, summarises each day and lets HR
+// correct records.
+package attendance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	attendanceEntity "github.com/haily-id/engine/internal/domain/entity/attendance"
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/holiday"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	dateLayout      = "2006-01-02"
+)
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+// CreateShiftRequest defines a shift. An end_time not after start_time
+// makes it overnight.
+type CreateShiftRequest struct {
+	Code                       string `json:"code"                          validate:"required,min=1,max=50,alphanum"`
+	Name                       string `json:"name"                          validate:"required,min=2,max=255"`
+	StartTime                  string `json:"start_time"                    validate:"required,datetime=15:04"`
+	EndTime                    string `json:"end_time"                      validate:"required,datetime=15:04"`
+	LateToleranceMinutes       int    `json:"late_tolerance_minutes"        validate:"min=0,max=240"`
+	EarlyLeaveToleranceMinutes int    `json:"early_leave_tolerance_minutes" validate:"min=0,max=240"`
+	IsDefault                  bool   `json:"is_default"`
+}
+
+// UpdateShiftRequest cannot change the code. New times apply to records
+// made afterwards.
+type UpdateShiftRequest struct {
+	Name                       *string `json:"name"                          validate:"omitempty,min=2,max=255"`
+	StartTime                  *string `json:"start_time"                    validate:"omitempty,datetime=15:04"`
+	EndTime                    *string `json:"end_time"                      validate:"omitempty,datetime=15:04"`
+	LateToleranceMinutes       *int    `json:"late_tolerance_minutes"        validate:"omitempty,min=0,max=240"`
+	EarlyLeaveToleranceMinutes *int    `json:"early_leave_tolerance_minutes" validate:"omitempty,min=0,max=240"`
+	IsDefault                  *bool   `json:"is_default"`
+	IsActive                   *bool   `json:"is_active"`
+}
+
+// AssignShiftRequest puts an employee on a shift from start_date on,
+// replacing the assignment starting that day if there is one.
+type AssignShiftRequest struct {
+	ShiftID   string `json:"shift_id"   validate:"required,numeric"`
+	StartDate string `json:"start_date" validate:"required,datetime=2006-01-02"`
+}
+
+// ClockRequest carries the device's position in decimal degrees.
+type ClockRequest struct {
+	Latitude  *float64 `json:"latitude"  validate:"required,min=-90,max=90"`
+	Longitude *float64 `json:"longitude" validate:"required,min=-180,max=180"`
+}
+
+type ListRequest struct {
+	EmployeeID string `query:"employee_id" validate:"omitempty,numeric"`
+	From       string `query:"from"        validate:"omitempty,datetime=2006-01-02"`
+	To         string `query:"to"          validate:"omitempty,datetime=2006-01-02"`
+	Offset     int    `query:"offset"      validate:"min=0"`
+	Limit      int    `query:"limit"       validate:"min=0,max=100"`
+}
+
+// SummaryRequest selects the day, today in the company's timezone by
+// default, and optionally narrows it to a division or department.
+type SummaryRequest struct {
+	Date         string `query:"date"          validate:"omitempty,datetime=2006-01-02"`
+	DivisionID   string `query:"division_id"   validate:"omitempty,numeric"`
+	DepartmentID string `query:"department_id" validate:"omitempty,numeric"`
+}
+
+// CorrectionRequest sets an employee's clocks of a day, creating the
+// record when there is none. Times are RFC 3339; clock_out is kept as it
+// was when left out.
+type CorrectionRequest struct {
+	EmployeeID string  `json:"employee_id" validate:"required,numeric"`
+	Date       string  `json:"date"        validate:"required,datetime=2006-01-02"`
+	ClockIn    string  `json:"clock_in"    validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	ClockOut   *string `json:"clock_out"   validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Reason     string  `json:"reason"      validate:"required,max=500"`
+}
+
+// Actor is the member acting on attendance. Owners and admins, Admin, see
+// and correct everyone's records; other members only see their own.
+type Actor struct {
+	UserID int64
+	Admin  bool
+}
+
+// ─── Results ────────────────────────────────────────────────────
+
+// RecordView is a record with its employee and shift, either of which may
+// be nil.
+type RecordView struct {
+	Record   attendanceEntity.Record
+	Employee *employeeEntity.Employee
+	Shift    *attendanceEntity.Shift
+}
+
+type Page struct {
+	Records []RecordView
+	Total   int64
+	Offset  int
+	Limit   int
+}
+
+// Assignment is a shift assignment with its shift.
+type Assignment struct {
+	Assignment attendanceEntity.ShiftAssignment
+	Shift      *attendanceEntity.Shift
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	shiftRepo        repository.ShiftRepository
+	assignmentRepo   repository.ShiftAssignmentRepository
+	recordRepo       repository.AttendanceRecordRepository
+	employeeRepo     repository.EmployeeRepository
+	companyRepo      repository.CompanyRepository
+	addressRepo      repository.CompanyAddressRepository
+	workLocationRepo repository.WorkLocationRepository
+	leaveRepo        repository.LeaveRequestRepository
+	transactor       repository.Transactor
+	calendar         *holiday.Calendar
+	settings         Settings
+	auditor          audit.Recorder
+}
+
+// Settings reads the default geofence radius.
+type Settings interface {
+	Int(ctx context.Context, companyID int64, key string) (int64, error)
+}
+
+func NewUseCase(
+	shiftRepo repository.ShiftRepository,
+	assignmentRepo repository.ShiftAssignmentRepository,
+	recordRepo repository.AttendanceRecordRepository,
+	employeeRepo repository.EmployeeRepository,
+	companyRepo repository.CompanyRepository,
+	addressRepo repository.CompanyAddressRepository,
+	workLocationRepo repository.WorkLocationRepository,
+	leaveRepo repository.LeaveRequestRepository,
+	transactor repository.Transactor,
+	calendar *holiday.Calendar,
+	settings Settings,
+	auditor audit.Recorder,
+) *UseCase {
+	return &UseCase{
+		shiftRepo:        shiftRepo,
+		assignmentRepo:   assignmentRepo,
+		recordRepo:       recordRepo,
+		employeeRepo:     employeeRepo,
+		companyRepo:      companyRepo,
+		addressRepo:      addressRepo,
+		workLocationRepo: workLocationRepo,
+		leaveRepo:        leaveRepo,
+		transactor:       transactor,
+		calendar:         calendar,
+		settings:         settings,
+		auditor:          auditor,
+	}
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+// companyOf loads the company, for its timezone.
+func (uc *UseCase) companyOf(ctx context.Context, companyID int64) (*companyEntity.Company, error) {
+	c, err := uc.companyRepo.FindByID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load company: %w", err)
+	}
+	return c, nil
+}
+
+// employeeOf returns an employee of the company.
+func (uc *UseCase) employeeOf(ctx context.Context, companyID, id int64) (*employeeEntity.Employee, error) {
+	e, err := uc.employeeRepo.FindByID(ctx, id)
+	if err != nil || e.CompanyID != companyID {
+		return nil, errors.New("employee not found")
+	}
+	return e, nil
+}
+
+// self returns the actor's own employee record in the company.
+func (uc *UseCase) self(ctx context.Context, companyID int64, actor Actor) (*employeeEntity.Employee, error) {
+	e, err := uc.employeeRepo.FindByCompanyAndUser(ctx, companyID, actor.UserID)
+	if err != nil {
+		return nil, errors.New("employee not found")
+	}
+	return e, nil
+}
+
+// subject resolves the employee whose records are asked for: the actor's
+// own when id is empty, anyone's for owners and admins.
+func (uc *UseCase) subject(ctx context.Context, companyID int64, actor Actor, id string) (*employeeEntity.Employee, error) {
+	if id == "" {
+		return uc.self(ctx, companyID, actor)
+	}
+	employeeID, _ := strconv.ParseInt(id, 10, 64)
+	e, err := uc.employeeOf(ctx, companyID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.Admin && (e.UserID == nil || *e.UserID != actor.UserID) {
+		return nil, errors.New("attendance access denied")
+	}
+	return e, nil
+}
+
+// day is the date of t in loc, as midnight UTC.
+func day(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// parseDate reads a validated YYYY-MM-DD value; empty means none.
+func parseDate(s string) *time.Time {
+	if s == "" {
+		return nil
+	}
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+func optional(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := strings.TrimSpace(*s)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,251 @@
+package attendance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	attendanceEntity "github.com/haily-id/engine/internal/domain/entity/attendance"
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/pkg/geo"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+)
+
+// ─── Clocking ───────────────────────────────────────────────────
+
+// fix is a clock matched to a work location.
+type fix struct {
+	Address  *companyEntity.Address
+	Distance int
+}
+
+// ClockIn starts the member's attendance of the day, from within the
+// geofence of one of their work locations. The day is the one the
+// clock-in falls on, except that a clock-in before the end of the
+// previous day's overnight shift belongs to that shift's day.
+func (uc *UseCase) ClockIn(ctx context.Context, companyID int64, actor Actor, req ClockRequest) (*RecordView, error) {
+	c, err := uc.companyOf(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	e, err := uc.self(ctx, companyID, actor)
+	if err != nil {
+		return nil, err
+	}
+	if e.EmploymentStatus != employeeEntity.EmploymentStatusActive {
+		return nil, errors.New("employee not active")
+	}
+
+	loc := c.Location()
+	now := time.Now()
+	date, s, err := uc.workday(ctx, c, e, now, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := uc.geofence(ctx, c, e, date, *req.Latitude, *req.Longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+	r := &attendanceEntity.Record{
+		ID:               id,
+		CompanyID:        companyID,
+		EmployeeID:       e.ID,
+		Date:             date,
+		ClockIn:          &now,
+		ClockInLatitude:  req.Latitude,
+		ClockInLongitude: req.Longitude,
+		ClockInAddressID: &f.Address.ID,
+		ClockInDistance:  &f.Distance,
+	}
+	if err := schedule(r, s, loc); err != nil {
+		return nil, err
+	}
+	r.Evaluate(s)
+
+	// The employee lock serialises concurrent clock-ins, so only the first
+	// creates the day's record.
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.employeeRepo.LockByID(ctx, e.ID); err != nil {
+			return err
+		}
+		existing, err := uc.recordRepo.FindByDate(ctx, e.ID, date)
+		if err != nil && err.Error() != "attendance record not found" {
+			return err
+		}
+		if existing != nil {
+			return errors.New("already clocked in")
+		}
+		if err := uc.recordRepo.Create(ctx, r); err != nil {
+			return fmt.Errorf("failed to create attendance record: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &RecordView{Record: *r, Employee: e, Shift: s}, nil
+}
+
+// ClockOut ends the member's open attendance, the latest clocked in
+// within the last day, from within the geofence of one of their work
+// locations.
+func (uc *UseCase) ClockOut(ctx context.Context, companyID int64, actor Actor, req ClockRequest) (*RecordView, error) {
+	c, err := uc.companyOf(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	e, err := uc.self(ctx, companyID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := c.Location()
+	now := time.Now()
+	r, err := uc.recordRepo.FindOpen(ctx, e.ID, now.Add(-24*time.Hour))
+	if err != nil {
+		if err.Error() != "attendance record not found" {
+			return nil, err
+		}
+		if done, _ := uc.recordRepo.FindByDate(ctx, e.ID, day(now, loc)); done != nil && done.ClockOut != nil {
+			return nil, errors.New("already clocked out")
+		}
+		return nil, errors.New("not clocked in")
+	}
+
+	f, err := uc.geofence(ctx, c, e, r.Date, *req.Latitude, *req.Longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	var s *attendanceEntity.Shift
+	if r.ShiftID != nil {
+		if s, err = uc.shiftRepo.FindByID(ctx, companyID, *r.ShiftID); err != nil {
+			return nil, err
+		}
+	}
+
+	r.ClockOut = &now
+	r.ClockOutLatitude = req.Latitude
+	r.ClockOutLongitude = req.Longitude
+	r.ClockOutAddressID = &f.Address.ID
+	r.ClockOutDistance = &f.Distance
+	r.Evaluate(s)
+
+	if err := uc.recordRepo.Update(ctx, r); err != nil {
+		return nil, fmt.Errorf("failed to update attendance record: %w", err)
+	}
+	return &RecordView{Record: *r, Employee: e, Shift: s}, nil
+}
+
+// workday returns the day a clock-in at now belongs to and the shift the
+// employee works that day.
+func (uc *UseCase) workday(ctx context.Context, c *companyEntity.Company, e *employeeEntity.Employee, now time.Time, loc *time.Location) (time.Time, *attendanceEntity.Shift, error) {
+	date := day(now, loc)
+
+	prev := date.AddDate(0, 0, -1)
+	ps, err := uc.shiftOn(ctx, c.ID, e.ID, prev)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	if ps != nil && ps.Overnight() {
+		_, end, err := ps.Window(prev, loc)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		if now.Before(end) {
+			r, err := uc.recordRepo.FindByDate(ctx, e.ID, prev)
+			if err != nil && err.Error() != "attendance record not found" {
+				return time.Time{}, nil, err
+			}
+			if r == nil {
+				return prev, ps, nil
+			}
+		}
+	}
+
+	s, err := uc.shiftOn(ctx, c.ID, e.ID, date)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	return date, s, nil
+}
+
+// geofence matches a position to the nearest of the employee's work
+// locations on date whose address is active, has coordinates and lies
+// within its radius: the address's own, else the company's
+// hr.attendance.radius_meters.
+func (uc *UseCase) geofence(ctx context.Context, c *companyEntity.Company, e *employeeEntity.Employee, date time.Time, lat, lng float64) (*fix, error) {
+	locations, err := uc.workLocationRepo.ListByEmployee(ctx, e.ID)
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := uc.addressRepo.ListByCompany(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*companyEntity.Address, len(addresses))
+	for i := range addresses {
+		byID[addresses[i].ID] = &addresses[i]
+	}
+
+	radius, err := uc.settings.Int(ctx, c.ID, attendanceEntity.RadiusSetting)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attendance setting: %w", err)
+	}
+
+	fenced := false
+	var best *fix
+	for i := range locations {
+		w := &locations[i]
+		a, ok := byID[w.CompanyAddressID]
+		if !w.ActiveOn(date) || !ok || !a.IsActive || !a.HasCoordinates() {
+			continue
+		}
+		fenced = true
+
+		limit := float64(radius)
+		if a.GeofenceRadius != nil {
+			limit = float64(*a.GeofenceRadius)
+		}
+		d := geo.Distance(lat, lng, *a.Latitude, *a.Longitude)
+		if d > limit {
+			continue
+		}
+		if best == nil || int(math.Round(d)) < best.Distance {
+			best = &fix{Address: a, Distance: int(math.Round(d))}
+		}
+	}
+
+	switch {
+	case best != nil:
+		return best, nil
+	case !fenced:
+		return nil, errors.New("no geofenced work location")
+	}
+	return nil, errors.New("outside work location")
+}
+
+// schedule copies the window of s on the record's day onto it.
+func schedule(r *attendanceEntity.Record, s *attendanceEntity.Shift, loc *time.Location) error {
+	r.ShiftID, r.ScheduledStart, r.ScheduledEnd = nil, nil, nil
+	if s == nil {
+		return nil
+	}
+	start, end, err := s.Window(r.Date, loc)
+	if err != nil {
+		return err
+	}
+	r.ShiftID = &s.ID
+	r.ScheduledStart = &start
+	r.ScheduledEnd = &end
+	return nil
+}
@@ -0,0 +1,204 @@
+package attendance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	attendanceEntity "github.com/haily-id/engine/internal/domain/entity/attendance"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+)
+
+// ─── Records ────────────────────────────────────────────────────
+
+// List pages through attendance records, latest day first. Owners and
+// admins see everyone's, narrowed by employee_id when given; other
+// members see their own.
+func (uc *UseCase) List(ctx context.Context, companyID int64, actor Actor, req ListRequest) (*Page, error) {
+	f := repository.AttendanceRecordFilter{
+		CompanyID: companyID,
+		From:      parseDate(req.From),
+		To:        parseDate(req.To),
+	}
+	if !actor.Admin || req.EmployeeID != "" {
+		e, err := uc.subject(ctx, companyID, actor, req.EmployeeID)
+		if err != nil {
+			return nil, err
+		}
+		f.EmployeeID = e.ID
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	list, total, err := uc.recordRepo.List(ctx, f, req.Offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	views, err := uc.views(ctx, companyID, list)
+	if err != nil {
+		return nil, err
+	}
+	return &Page{Records: views, Total: total, Offset: req.Offset, Limit: limit}, nil
+}
+
+// Get returns a record to its employee and to HR.
+func (uc *UseCase) Get(ctx context.Context, companyID int64, actor Actor, id int64) (*RecordView, error) {
+	r, err := uc.recordRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+	views, err := uc.views(ctx, companyID, []attendanceEntity.Record{*r})
+	if err != nil {
+		return nil, err
+	}
+	v := &views[0]
+	if !actor.Admin && (v.Employee == nil || v.Employee.UserID == nil || *v.Employee.UserID != actor.UserID) {
+		return nil, errors.New("attendance access denied")
+	}
+	return v, nil
+}
+
+// Correct sets an employee's clocks of a day on behalf of HR, creating
+// the record when there is none. The positions of changed clocks are
+// cleared, as they no longer belong to them, and the flags are worked out
+// again. The record is marked corrected with the reason; the previous
+// values go to the audit log.
+func (uc *UseCase) Correct(ctx context.Context, companyID int64, actor Actor, req CorrectionRequest) (*RecordView, error) {
+	c, err := uc.companyOf(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	employeeID, _ := strconv.ParseInt(req.EmployeeID, 10, 64)
+	e, err := uc.employeeOf(ctx, companyID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := c.Location()
+	date := *parseDate(req.Date)
+	if date.After(day(time.Now(), loc)) {
+		return nil, errors.New("attendance date in future")
+	}
+	clockIn, _ := time.Parse(time.RFC3339, req.ClockIn)
+	var clockOut *time.Time
+	if req.ClockOut != nil {
+		t, _ := time.Parse(time.RFC3339, *req.ClockOut)
+		if !t.After(clockIn) {
+			return nil, errors.New("clock out before clock in")
+		}
+		clockOut = &t
+	}
+
+	var before *attendanceEntity.Record
+	var r *attendanceEntity.Record
+	var s *attendanceEntity.Shift
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.employeeRepo.LockByID(ctx, e.ID); err != nil {
+			return err
+		}
+
+		existing, err := uc.recordRepo.FindByDate(ctx, e.ID, date)
+		if err != nil && err.Error() != "attendance record not found" {
+			return err
+		}
+		if existing != nil {
+			prev := *existing
+			before = &prev
+			r = existing
+			if r.ShiftID != nil {
+				if s, err = uc.shiftRepo.FindByID(ctx, companyID, *r.ShiftID); err != nil {
+					return err
+				}
+			}
+		} else {
+			id, err := snowflake.Generate()
+			if err != nil {
+				return fmt.Errorf("failed to generate ID: %w", err)
+			}
+			r = &attendanceEntity.Record{ID: id, CompanyID: companyID, EmployeeID: e.ID, Date: date}
+			if s, err = uc.shiftOn(ctx, companyID, e.ID, date); err != nil {
+				return err
+			}
+			if err := schedule(r, s, loc); err != nil {
+				return err
+			}
+		}
+
+		if r.ClockIn == nil || !r.ClockIn.Equal(clockIn) {
+			r.ClockIn = &clockIn
+			r.ClockInLatitude, r.ClockInLongitude = nil, nil
+			r.ClockInAddressID, r.ClockInDistance = nil, nil
+		}
+		if clockOut != nil && (r.ClockOut == nil || !r.ClockOut.Equal(*clockOut)) {
+			r.ClockOut = clockOut
+			r.ClockOutLatitude, r.ClockOutLongitude = nil, nil
+			r.ClockOutAddressID, r.ClockOutDistance = nil, nil
+		}
+		if r.ClockOut != nil && !r.ClockOut.After(*r.ClockIn) {
+			return errors.New("clock out before clock in")
+		}
+		r.Evaluate(s)
+
+		now := time.Now()
+		r.Corrected = true
+		r.CorrectedBy = &actor.UserID
+		r.CorrectedAt = &now
+		r.CorrectionReason = optional(&req.Reason)
+
+		if before != nil {
+			return uc.recordRepo.Update(ctx, r)
+		}
+		return uc.recordRepo.Create(ctx, r)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if before != nil {
+		audit.RecordHR(ctx, uc.auditor, companyID, "attendance_records", r.ID, audit.ActionUpdate, before, r)
+	} else {
+		audit.RecordHR(ctx, uc.auditor, companyID, "attendance_records", r.ID, audit.ActionCreate, nil, r)
+	}
+	return &RecordView{Record: *r, Employee: e, Shift: s}, nil
+}
+
+// views attaches their employees and shifts to records.
+func (uc *UseCase) views(ctx context.Context, companyID int64, list []attendanceEntity.Record) ([]RecordView, error) {
+	ids := make([]int64, 0, len(list))
+	for _, r := range list {
+		ids = append(ids, r.EmployeeID)
+	}
+	employees, err := uc.employeeRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*employeeEntity.Employee, len(employees))
+	for i := range employees {
+		byID[employees[i].ID] = &employees[i]
+	}
+	shifts, err := uc.shiftMap(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]RecordView, 0, len(list))
+	for _, r := range list {
+		v := RecordView{Record: r, Employee: byID[r.EmployeeID]}
+		if r.ShiftID != nil {
+			v.Shift = shifts[*r.ShiftID]
+		}
+		views = append(views, v)
+	}
+	return views, nil
+}
@@ -0,0 +1,306 @@
+package attendance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	attendanceEntity "github.com/haily-id/engine/internal/domain/entity/attendance"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+)
+
+// ─── Shifts ─────────────────────────────────────────────────────
+
+func (uc *UseCase) ListShifts(ctx context.Context, companyID int64, activeOnly bool) ([]attendanceEntity.Shift, error) {
+	return uc.shiftRepo.ListByCompany(ctx, companyID, activeOnly)
+}
+
+func (uc *UseCase) GetShift(ctx context.Context, companyID, id int64) (*attendanceEntity.Shift, error) {
+	return uc.shiftRepo.FindByID(ctx, companyID, id)
+}
+
+// CreateShift adds a shift. Making it the default takes the flag from the
+// previous default shift.
+func (uc *UseCase) CreateShift(ctx context.Context, companyID int64, req CreateShiftRequest) (*attendanceEntity.Shift, error) {
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	if existing, _ := uc.shiftRepo.FindByCode(ctx, companyID, code); existing != nil {
+		return nil, errors.New("shift code already exists")
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	s := &attendanceEntity.Shift{
+		ID:                         id,
+		CompanyID:                  companyID,
+		Code:                       code,
+		Name:                       strings.TrimSpace(req.Name),
+		StartTime:                  req.StartTime,
+		EndTime:                    req.EndTime,
+		LateToleranceMinutes:       req.LateToleranceMinutes,
+		EarlyLeaveToleranceMinutes: req.EarlyLeaveToleranceMinutes,
+		IsDefault:                  req.IsDefault,
+		IsActive:                   true,
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Serializes shift changes per company so two requests cannot
+		// both take the default flag.
+		if _, err := uc.companyRepo.LockByID(ctx, companyID); err != nil {
+			return err
+		}
+		if s.IsDefault {
+			if err := uc.shiftRepo.ClearDefault(ctx, companyID); err != nil {
+				return err
+			}
+		}
+		if err := uc.shiftRepo.Create(ctx, s); err != nil {
+			return fmt.Errorf("failed to create shift: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "attendance_shifts", s.ID, audit.ActionCreate, nil, s)
+	return s, nil
+}
+
+// UpdateShift changes a shift. Records already made keep the schedule
+// they were made with; the tolerances apply whenever a record is
+// evaluated again.
+func (uc *UseCase) UpdateShift(ctx context.Context, companyID, id int64, req UpdateShiftRequest) (*attendanceEntity.Shift, error) {
+	var before, after attendanceEntity.Shift
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.companyRepo.LockByID(ctx, companyID); err != nil {
+			return err
+		}
+
+		s, err := uc.shiftRepo.FindByID(ctx, companyID, id)
+		if err != nil {
+			return err
+		}
+		before = *s
+		wasDefault := s.IsDefault
+
+		if req.Name != nil {
+			s.Name = strings.TrimSpace(*req.Name)
+		}
+		if req.StartTime != nil {
+			s.StartTime = *req.StartTime
+		}
+		if req.EndTime != nil {
+			s.EndTime = *req.EndTime
+		}
+		if req.LateToleranceMinutes != nil {
+			s.LateToleranceMinutes = *req.LateToleranceMinutes
+		}
+		if req.EarlyLeaveToleranceMinutes != nil {
+			s.EarlyLeaveToleranceMinutes = *req.EarlyLeaveToleranceMinutes
+		}
+		if req.IsActive != nil {
+			s.IsActive = *req.IsActive
+		}
+		if req.IsDefault != nil {
+			s.IsDefault = *req.IsDefault
+		}
+		if s.IsDefault && !s.IsActive {
+			return errors.New("default shift must be active")
+		}
+		if s.IsDefault && !wasDefault {
+			if err := uc.shiftRepo.ClearDefault(ctx, companyID); err != nil {
+				return err
+			}
+		}
+
+		if err := uc.shiftRepo.Update(ctx, s); err != nil {
+			return fmt.Errorf("failed to update shift: %w", err)
+		}
+		after = *s
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "attendance_shifts", id, audit.ActionUpdate, before, after)
+	return &after, nil
+}
+
+// DeleteShift removes a shift no assignment or record points at; such
+// shifts can be deactivated instead.
+func (uc *UseCase) DeleteShift(ctx context.Context, companyID, id int64) error {
+	s, err := uc.shiftRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return err
+	}
+	refs, err := uc.shiftRepo.CountReferences(ctx, s.ID)
+	if err != nil {
+		return err
+	}
+	if refs > 0 {
+		return errors.New("shift in use")
+	}
+	if err := uc.shiftRepo.Delete(ctx, s); err != nil {
+		return fmt.Errorf("failed to delete shift: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "attendance_shifts", s.ID, audit.ActionDelete, s, nil)
+	return nil
+}
+
+// ─── Shift Assignments ──────────────────────────────────────────
+
+// Assignments lists an employee's shift assignments, latest first.
+func (uc *UseCase) Assignments(ctx context.Context, companyID, employeeID int64) ([]Assignment, error) {
+	e, err := uc.employeeOf(ctx, companyID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	list, err := uc.assignmentRepo.ListByEmployee(ctx, e.ID)
+	if err != nil {
+		return nil, err
+	}
+	shifts, err := uc.shiftMap(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Assignment, 0, len(list))
+	for _, a := range list {
+		result = append(result, Assignment{Assignment: a, Shift: shifts[a.ShiftID]})
+	}
+	return result, nil
+}
+
+// AssignShift puts an employee on an active shift from the start date on.
+func (uc *UseCase) AssignShift(ctx context.Context, companyID, employeeID int64, actor Actor, req AssignShiftRequest) (*Assignment, error) {
+	e, err := uc.employeeOf(ctx, companyID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	shiftID, _ := strconv.ParseInt(req.ShiftID, 10, 64)
+	s, err := uc.shiftRepo.FindByID(ctx, companyID, shiftID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.IsActive {
+		return nil, errors.New("shift inactive")
+	}
+	start := *parseDate(req.StartDate)
+
+	var before *attendanceEntity.ShiftAssignment
+	var a *attendanceEntity.ShiftAssignment
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.employeeRepo.LockByID(ctx, e.ID); err != nil {
+			return err
+		}
+
+		existing, err := uc.assignmentRepo.FindByStart(ctx, e.ID, start)
+		if err != nil && err.Error() != "shift assignment not found" {
+			return err
+		}
+		if existing != nil {
+			prev := *existing
+			before = &prev
+			a = existing
+			a.ShiftID = s.ID
+			a.AssignedBy = actor.UserID
+			return uc.assignmentRepo.Update(ctx, a)
+		}
+
+		id, err := snowflake.Generate()
+		if err != nil {
+			return fmt.Errorf("failed to generate ID: %w", err)
+		}
+		a = &attendanceEntity.ShiftAssignment{
+			ID:         id,
+			CompanyID:  companyID,
+			EmployeeID: e.ID,
+			ShiftID:    s.ID,
+			StartDate:  start,
+			AssignedBy: actor.UserID,
+		}
+		if err := uc.assignmentRepo.Create(ctx, a); err != nil {
+			return fmt.Errorf("failed to create shift assignment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if before != nil {
+		audit.RecordHR(ctx, uc.auditor, companyID, "attendance_shift_assignments", a.ID, audit.ActionUpdate, before, a)
+	} else {
+		audit.RecordHR(ctx, uc.auditor, companyID, "attendance_shift_assignments", a.ID, audit.ActionCreate, nil, a)
+	}
+	return &Assignment{Assignment: *a, Shift: s}, nil
+}
+
+// DeleteAssignment removes a shift assignment; the one before it, or the
+// default shift, applies again from its start date.
+func (uc *UseCase) DeleteAssignment(ctx context.Context, companyID, employeeID, id int64) error {
+	e, err := uc.employeeOf(ctx, companyID, employeeID)
+	if err != nil {
+		return err
+	}
+	a, err := uc.assignmentRepo.FindByID(ctx, e.ID, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.assignmentRepo.Delete(ctx, a); err != nil {
+		return fmt.Errorf("failed to delete shift assignment: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "attendance_shift_assignments", a.ID, audit.ActionDelete, a, nil)
+	return nil
+}
+
+// shiftOn returns the shift an employee works on date: their assignment
+// in force then, or the company's default shift when they have none or
+// its shift was deactivated. It is nil when neither applies.
+func (uc *UseCase) shiftOn(ctx context.Context, companyID, employeeID int64, date time.Time) (*attendanceEntity.Shift, error) {
+	a, err := uc.assignmentRepo.FindOn(ctx, employeeID, date)
+	if err != nil && err.Error() != "shift assignment not found" {
+		return nil, err
+	}
+	if a != nil {
+		s, err := uc.shiftRepo.FindByID(ctx, companyID, a.ShiftID)
+		if err != nil && err.Error() != "shift not found" {
+			return nil, err
+		}
+		if s != nil && s.IsActive {
+			return s, nil
+		}
+	}
+
+	s, err := uc.shiftRepo.FindDefault(ctx, companyID)
+	if err != nil {
+		if err.Error() == "shift not found" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+// shiftMap indexes the company's shifts, inactive ones included, by ID.
+func (uc *UseCase) shiftMap(ctx context.Context, companyID int64) (map[int64]*attendanceEntity.Shift, error) {
+	list, err := uc.shiftRepo.ListByCompany(ctx, companyID, false)
+	if err != nil {
+		return nil, err
+	}
+	shifts := make(map[int64]*attendanceEntity.Shift, len(list))
+	for i := range list {
+		shifts[list[i].ID] = &list[i]
+	}
+	return shifts, nil
+}
@@ -0,0 +1,145 @@
+package attendance
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	attendanceEntity "github.com/haily-id/engine/internal/domain/entity/attendance"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+const (
+	// StatusPresent marks employees who clocked in, late or not.
+	StatusPresent = "PRESENT"
+	// StatusOnLeave marks employees on approved leave who did not clock
+	// in.
+	StatusOnLeave = "ON_LEAVE"
+	// StatusOff marks employees who did not clock in on a weekend or
+	// public holiday.
+	StatusOff = "OFF"
+	// StatusAbsent marks employees who did not clock in on a working day
+	// without leave. On the current day it also covers those who have
+	// yet to clock in.
+	StatusAbsent = "ABSENT"
+)
+
+// ─── Daily Summary ──────────────────────────────────────────────
+
+// SummaryEntry is one employee's attendance on the summary's day. Record
+// and Shift are nil when the employee did not clock in.
+type SummaryEntry struct {
+	Employee *employeeEntity.Employee
+	Record   *attendanceEntity.Record
+	Shift    *attendanceEntity.Shift
+	Status   string
+}
+
+// SummaryCounts counts the entries by status. Late and EarlyLeave count
+// the present employees flagged so.
+type SummaryCounts struct {
+	Total      int
+	Present    int
+	Late       int
+	EarlyLeave int
+	OnLeave    int
+	Off        int
+	Absent     int
+}
+
+// Summary is the attendance of a company's active and on-leave employees
+// on one day.
+type Summary struct {
+	Date       time.Time
+	WorkingDay bool
+	Holiday    *string
+	Counts     SummaryCounts
+	Entries    []SummaryEntry
+}
+
+// Summary reports who was present, late, leaving early, on leave, off or
+// absent on a day. Weekends and public holidays of the holiday calendar
+// are not working days. Employees hired after the day are left out.
+func (uc *UseCase) Summary(ctx context.Context, companyID int64, req SummaryRequest) (*Summary, error) {
+	c, err := uc.companyOf(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	date := c.Today()
+	if d := parseDate(req.Date); d != nil {
+		date = *d
+	}
+	divisionID, _ := strconv.ParseInt(req.DivisionID, 10, 64)
+	departmentID, _ := strconv.ParseInt(req.DepartmentID, 10, 64)
+
+	employees, err := uc.employeeRepo.ListActiveByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	records, err := uc.recordRepo.ListByDate(ctx, companyID, date)
+	if err != nil {
+		return nil, err
+	}
+	leave, err := uc.leaveRepo.ListApprovedOn(ctx, companyID, date)
+	if err != nil {
+		return nil, err
+	}
+	shifts, err := uc.shiftMap(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	byEmployee := make(map[int64]*attendanceEntity.Record, len(records))
+	for i := range records {
+		byEmployee[records[i].EmployeeID] = &records[i]
+	}
+	onLeave := make(map[int64]bool, len(leave))
+	for _, r := range leave {
+		onLeave[r.EmployeeID] = true
+	}
+
+	s := &Summary{Date: date, WorkingDay: uc.calendar.IsWorkingDay(date)}
+	if name, ok := uc.calendar.Holiday(date); ok {
+		s.Holiday = &name
+	}
+	for i := range employees {
+		e := &employees[i]
+		if !e.HasAccess() || (e.HireDate != nil && e.HireDate.After(date)) {
+			continue
+		}
+		if divisionID != 0 && (e.DivisionID == nil || *e.DivisionID != divisionID) {
+			continue
+		}
+		if departmentID != 0 && (e.DepartmentID == nil || *e.DepartmentID != departmentID) {
+			continue
+		}
+
+		entry := SummaryEntry{Employee: e, Record: byEmployee[e.ID]}
+		switch {
+		case entry.Record != nil && entry.Record.ClockIn != nil:
+			entry.Status = StatusPresent
+			if entry.Record.ShiftID != nil {
+				entry.Shift = shifts[*entry.Record.ShiftID]
+			}
+			s.Counts.Present++
+			if entry.Record.IsLate {
+				s.Counts.Late++
+			}
+			if entry.Record.IsEarlyLeave {
+				s.Counts.EarlyLeave++
+			}
+		case onLeave[e.ID]:
+			entry.Status = StatusOnLeave
+			s.Counts.OnLeave++
+		case !s.WorkingDay:
+			entry.Status = StatusOff
+			s.Counts.Off++
+		default:
+			entry.Status = StatusAbsent
+			s.Counts.Absent++
+		}
+		s.Counts.Total++
+		s.Entries = append(s.Entries, entry)
+	}
+	return s, nil
+}
@@ -0,0 +1,345 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+
+	auditEntity "github.com/haily-id/engine/internal/domain/entity/audit"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/asynq/tasks"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+	"github.com/haily-id/engine/internal/pkg/table"
+	"github.com/hibiken/asynq"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 200
+)
+
+// Fields every row carries that say nothing about the change itself.
+var ignoredFields = map[string]bool{"CreatedAt": true, "UpdatedAt": true, "DeletedAt": true}
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+type ListRequest struct {
+	UserID    string `query:"user_id"    json:"user_id,omitempty"    validate:"omitempty,numeric"`
+	Module    string `query:"module"     json:"module,omitempty"     validate:"omitempty,max=50"`
+	Entity    string `query:"entity"     json:"entity,omitempty"     validate:"omitempty,max=50"`
+	EntityID  string `query:"entity_id"  json:"entity_id,omitempty"  validate:"omitempty,numeric"`
+	Action    string `query:"action"     json:"action,omitempty"     validate:"omitempty,max=30"`
+	RequestID string `query:"request_id" json:"request_id,omitempty" validate:"omitempty,max=64"`
+	From      int64  `query:"from"       json:"from,omitempty"       validate:"omitempty,gt=0"`
+	To        int64  `query:"to"         json:"to,omitempty"         validate:"omitempty,gt=0"`
+	Offset    int    `query:"offset"     json:"-"                    validate:"omitempty,gte=0"`
+	Limit     int    `query:"limit"      json:"-"                    validate:"omitempty,min=1,max=200"`
+}
+
+// ─── Results ────────────────────────────────────────────────────
+
+type Page struct {
+	Logs   []auditEntity.AuditLog
+	Total  int64
+	Offset int
+	Limit  int
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	auditRepo   repository.AuditLogRepository
+	asynqClient interface {
+		Enqueue(task *asynq.Task, opts ...asynq.Option) error
+	}
+}
+
+func NewUseCase(
+	auditRepo repository.AuditLogRepository,
+	asynqClient interface {
+		Enqueue(task *asynq.Task, opts ...asynq.Option) error
+	},
+) *UseCase {
+	return &UseCase{
+		auditRepo:   auditRepo,
+		asynqClient: asynqClient,
+	}
+}
+
+// Record queues an entry for the worker to write, taking the request ID,
+// actor, IP and user agent from ctx. Queued tasks survive restarts and are
+// retried; if queueing fails the row is written directly instead.
+func (uc *UseCase) Record(ctx context.Context, e audit.Entry) {
+	p, err := uc.build(ctx, e)
+	if err != nil {
+		logger.Errorf("Failed to build audit log %s %s %d: %v", e.Action, e.Entity, e.EntityID, err)
+		return
+	}
+
+	task, err := tasks.NewWriteAuditLogTask(*p)
+	if err == nil {
+		err = uc.asynqClient.Enqueue(task, asynq.Queue("default"))
+	}
+	if err == nil {
+		return
+	}
+
+	logger.Errorf("Failed to queue audit log %d, writing directly: %v", p.ID, err)
+	if err := uc.Write(context.WithoutCancel(ctx), *p); err != nil {
+		data, _ := json.Marshal(p)
+		logger.Errorf("Failed to write audit log: %v: %s", err, data)
+	}
+}
+
+// Write stores a queued entry. It is safe to retry.
+func (uc *UseCase) Write(ctx context.Context, p tasks.WriteAuditLogPayload) error {
+	l := &auditEntity.AuditLog{
+		ID:        p.ID,
+		CompanyID: p.CompanyID,
+		UserID:    p.UserID,
+		RequestID: p.RequestID,
+		Module:    p.Module,
+		Entity:    p.Entity,
+		EntityID:  p.EntityID,
+		Action:    p.Action,
+		OldValues: jsonText(p.OldValues),
+		NewValues: jsonText(p.NewValues),
+		IPAddress: p.IPAddress,
+		UserAgent: p.UserAgent,
+		CreatedAt: p.CreatedAt,
+	}
+	return uc.auditRepo.Create(ctx, l)
+}
+
+func (uc *UseCase) List(ctx context.Context, companyID int64, req ListRequest) (*Page, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	logs, total, err := uc.auditRepo.List(ctx, toFilter(companyID, req), req.Offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &Page{Logs: logs, Total: total, Offset: req.Offset, Limit: limit}, nil
+}
+
+// Export writes every matching log as CSV to w, newest first, and records
+// the export itself.
+func (uc *UseCase) Export(ctx context.Context, companyID int64, req ListRequest, w io.Writer) error {
+	tw, err := table.NewWriter(w, table.FormatCSV, "")
+	if err != nil {
+		return err
+	}
+	if err := tw.Write([]string{
+		"id", "created_at", "user_id", "request_id", "module", "entity", "entity_id",
+		"action", "old_values", "new_values", "ip_address", "user_agent",
+	}); err != nil {
+		return err
+	}
+
+	err = uc.auditRepo.Each(ctx, toFilter(companyID, req), func(logs []auditEntity.AuditLog) error {
+		for _, l := range logs {
+			if err := tw.Write([]string{
+				strconv.FormatInt(l.ID, 10),
+				l.CreatedAt.UTC().Format(time.RFC3339),
+				idText(l.UserID),
+				deref(l.RequestID),
+				l.Module,
+				l.Entity,
+				idText(l.EntityID),
+				l.Action,
+				deref(l.OldValues),
+				deref(l.NewValues),
+				deref(l.IPAddress),
+				deref(l.UserAgent),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	uc.Record(ctx, audit.Entry{
+		CompanyID: companyID,
+		Module:    audit.ModuleGeneral,
+		Entity:    "audit_logs",
+		Action:    audit.ActionExport,
+		New:       req,
+	})
+	return nil
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func (uc *UseCase) build(ctx context.Context, e audit.Entry) (*tasks.WriteAuditLogPayload, error) {
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	oldValues, newValues, err := diff(e.Old, e.New)
+	if err != nil {
+		return nil, err
+	}
+
+	req := audit.FromContext(ctx)
+	userID := e.UserID
+	if userID == 0 {
+		userID = req.UserID
+	}
+
+	return &tasks.WriteAuditLogPayload{
+		ID:        id,
+		CompanyID: optionalID(e.CompanyID),
+		UserID:    optionalID(userID),
+		RequestID: optionalText(req.ID),
+		Module:    e.Module,
+		Entity:    e.Entity,
+		EntityID:  optionalID(e.EntityID),
+		Action:    e.Action,
+		OldValues: oldValues,
+		NewValues: newValues,
+		IPAddress: optionalText(req.IP),
+		UserAgent: optionalText(truncate(req.UserAgent, 500)),
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// diff marshals old and new to JSON objects. When both are given only the
+// fields whose values differ are kept.
+func diff(oldValue, newValue interface{}) (json.RawMessage, json.RawMessage, error) {
+	oldFields, err := fields(oldValue)
+	if err != nil {
+		return nil, nil, err
+	}
+	newFields, err := fields(newValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if oldFields != nil && newFields != nil {
+		for k, v := range oldFields {
+			if nv, ok := newFields[k]; (ok && reflect.DeepEqual(v, nv)) || ignoredFields[k] {
+				delete(oldFields, k)
+				delete(newFields, k)
+			}
+		}
+		for k := range newFields {
+			if ignoredFields[k] {
+				delete(newFields, k)
+			}
+		}
+	}
+
+	oldJSON, err := marshalFields(oldFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	newJSON, err := marshalFields(newFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	return oldJSON, newJSON, nil
+}
+
+func fields(v interface{}) (map[string]interface{}, error) {
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Ptr && reflect.ValueOf(v).IsNil()) {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("audit values must be objects: %w", err)
+	}
+	return m, nil
+}
+
+func marshalFields(m map[string]interface{}) (json.RawMessage, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return json.Marshal(m)
+}
+
+func toFilter(companyID int64, req ListRequest) repository.AuditLogFilter {
+	f := repository.AuditLogFilter{
+		CompanyID: companyID,
+		Module:    req.Module,
+		Entity:    req.Entity,
+		Action:    req.Action,
+		RequestID: req.RequestID,
+	}
+	f.UserID, _ = strconv.ParseInt(req.UserID, 10, 64)
+	f.EntityID, _ = strconv.ParseInt(req.EntityID, 10, 64)
+	if req.From > 0 {
+		t := time.Unix(req.From, 0)
+		f.From = &t
+	}
+	if req.To > 0 {
+		t := time.Unix(req.To, 0)
+		f.To = &t
+	}
+	return f
+}
+
+func jsonText(raw json.RawMessage) *string {
+	if len(raw) == 0 {
+		return nil
+	}
+	s := string(raw)
+	return &s
+}
+
+func optionalID(id int64) *int64 {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+func optionalText(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func idText(id *int64) string {
+	if id == nil {
+		return ""
+	}
+	return strconv.FormatInt(*id, 10)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
@@ -13,6 +13,7 @@ import (
 	userEntity "github.com/haily-id/engine/internal/domain/entity/user"
 	"github.com/haily-id/engine/internal/domain/repository"
 	"github.com/haily-id/engine/internal/pkg/asynq/tasks"
+	"github.com/haily-id/engine/internal/pkg/audit"
 	"github.com/haily-id/engine/internal/pkg/i18n"
 	"github.com/haily-id/engine/internal/pkg/mailer"
 	"github.com/haily-id/engine/internal/pkg/snowflake"
@@ -61,6 +62,7 @@ type UseCase struct {
 	asynqClient interface {
 		Enqueue(task *asynq.Task, opts ...asynq.Option) error
 	}
+	auditor        audit.Recorder
 	jwtSecret      string
 	jwtExpiryHours int
 }
@@ -77,6 +79,7 @@ func NewUseCase(
 	asynqClient interface {
 		Enqueue(task *asynq.Task, opts ...asynq.Option) error
 	},
+	auditor audit.Recorder,
 	cfg Config,
 ) *UseCase {
 	return &UseCase{
@@ -84,6 +87,7 @@ func NewUseCase(
 		evRepo:         evRepo,
 		mailer:         m,
 		asynqClient:    asynqClient,
+		auditor:        auditor,
 		jwtSecret:      cfg.JWTSecret,
 		jwtExpiryHours: cfg.JWTExpiryHours,
 	}
@@ -167,6 +171,13 @@ func (uc *UseCase) Login(ctx context.Context, req LoginRequest) (*userEntity.Use
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(*u.Password), []byte(req.Password)); err != nil {
+		uc.auditor.Record(ctx, audit.Entry{
+			UserID:   u.ID,
+			Module:   audit.ModuleGeneral,
+			Entity:   "users",
+			EntityID: u.ID,
+			Action:   audit.ActionLoginFailed,
+		})
 		return nil, "", errors.New("invalid email or password")
 	}
 
@@ -179,6 +190,14 @@ func (uc *UseCase) Login(ctx context.Context, req LoginRequest) (*userEntity.Use
 	u.LastLoginAt = &now
 	_ = uc.userRepo.Update(ctx, u)
 
+	uc.auditor.Record(ctx, audit.Entry{
+		UserID:   u.ID,
+		Module:   audit.ModuleGeneral,
+		Entity:   "users",
+		EntityID: u.ID,
+		Action:   audit.ActionLogin,
+	})
+
 	return u, token, nil
 }
 
@@ -0,0 +1,331 @@
+package billing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	billingEntity "github.com/haily-id/engine/internal/domain/entity/billing"
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	subscriptionEntity "github.com/haily-id/engine/internal/domain/entity/subscription"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/asynq/tasks"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+	"github.com/haily-id/engine/internal/pkg/storage"
+	"github.com/hibiken/asynq"
+)
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	invoiceRepo repository.InvoiceRepository
+	subRepo     repository.CompanySubscriptionRepository
+	planRepo    repository.SubscriptionPlanRepository
+	companyRepo repository.CompanyRepository
+	transactor  repository.Transactor
+	storage     storage.Storage
+	asynqClient interface {
+		Enqueue(task *asynq.Task, opts ...asynq.Option) error
+	}
+	taxRate  int
+	dueDays  int
+	issuer   Issuer
+	location *time.Location
+}
+
+// Issuer is the seller printed on every invoice.
+type Issuer struct {
+	Name    string
+	Address string
+	TaxID   string
+}
+
+type Config struct {
+	// TaxRate is the PPN rate in percent applied to every invoice.
+	TaxRate int
+	// DueDays is how long after issue an invoice becomes overdue.
+	DueDays int
+	Issuer  Issuer
+}
+
+func NewUseCase(
+	invoiceRepo repository.InvoiceRepository,
+	subRepo repository.CompanySubscriptionRepository,
+	planRepo repository.SubscriptionPlanRepository,
+	companyRepo repository.CompanyRepository,
+	transactor repository.Transactor,
+	storage storage.Storage,
+	asynqClient interface {
+		Enqueue(task *asynq.Task, opts ...asynq.Option) error
+	},
+	cfg Config,
+) *UseCase {
+	return &UseCase{
+		invoiceRepo: invoiceRepo,
+		subRepo:     subRepo,
+		planRepo:    planRepo,
+		companyRepo: companyRepo,
+		transactor:  transactor,
+		storage:     storage,
+		asynqClient: asynqClient,
+		taxRate:     cfg.TaxRate,
+		dueDays:     cfg.DueDays,
+		issuer:      cfg.Issuer,
+		// Invoice years follow Indonesian time so that numbering restarts
+		// at local midnight on 1 January.
+		location: companyEntity.LoadLocation(companyEntity.DefaultTimezone),
+	}
+}
+
+// InvoicePeriod issues the invoice for the subscription's current period on
+// plan. It is called inside the transaction that renews the subscription.
+func (uc *UseCase) InvoicePeriod(ctx context.Context, sub *subscriptionEntity.CompanySubscription, plan *subscriptionEntity.Plan) (*billingEntity.Invoice, error) {
+	price := plan.Price(sub.BillingCycle)
+	if price <= 0 {
+		return nil, nil
+	}
+
+	item := billingEntity.InvoiceItem{
+		Description: fmt.Sprintf("%s plan (%s), %s - %s",
+			plan.Name, cycleLabel(sub.BillingCycle),
+			sub.CurrentPeriodStart.In(uc.location).Format("2 Jan 2006"),
+			sub.CurrentPeriodEnd.In(uc.location).Format("2 Jan 2006")),
+		Quantity:  1,
+		UnitPrice: price,
+		Amount:    price,
+	}
+
+	return uc.issue(ctx, sub, nil, sub.CurrentPeriodStart, sub.CurrentPeriodEnd, []billingEntity.InvoiceItem{item})
+}
+
+// InvoiceChange issues the invoice for the amount due on an immediate plan
+// change. It is called inside the transaction that applies the change.
+func (uc *UseCase) InvoiceChange(ctx context.Context, sub *subscriptionEntity.CompanySubscription, change *subscriptionEntity.SubscriptionChange, plan *subscriptionEntity.Plan) (*billingEntity.Invoice, error) {
+	if change.AmountDue <= 0 {
+		return nil, nil
+	}
+
+	items := []billingEntity.InvoiceItem{{
+		Description: fmt.Sprintf("%s plan (%s), prorated until %s",
+			plan.Name, cycleLabel(change.ToBillingCycle),
+			sub.CurrentPeriodEnd.In(uc.location).Format("2 Jan 2006")),
+		Quantity:  1,
+		UnitPrice: change.ProrationCharge,
+		Amount:    change.ProrationCharge,
+	}}
+	if change.ProrationCredit > 0 {
+		items = append(items, billingEntity.InvoiceItem{
+			Description: "Credit for unused time on previous plan",
+			Quantity:    1,
+			UnitPrice:   -change.ProrationCredit,
+			Amount:      -change.ProrationCredit,
+		})
+	}
+
+	return uc.issue(ctx, sub, &change.ID, change.EffectiveAt, sub.CurrentPeriodEnd, items)
+}
+
+// QueueRender asks the worker to render the invoice PDF. Call it after the
+// transaction that created the invoice has committed.
+func (uc *UseCase) QueueRender(ctx context.Context, inv *billingEntity.Invoice) {
+	if inv == nil {
+		return
+	}
+	task, err := tasks.NewRenderInvoiceTask(inv.ID)
+	if err != nil {
+		logger.Errorf("Failed to create render task for invoice %d: %v", inv.ID, err)
+		return
+	}
+	if err := uc.asynqClient.Enqueue(task, asynq.Queue("default")); err != nil {
+		logger.Errorf("Failed to enqueue render task for invoice %d: %v", inv.ID, err)
+	}
+}
+
+func (uc *UseCase) ListByCompany(ctx context.Context, companyID int64, status string) ([]billingEntity.Invoice, error) {
+	return uc.invoiceRepo.ListByCompany(ctx, companyID, status)
+}
+
+func (uc *UseCase) Get(ctx context.Context, companyID, invoiceID int64) (*billingEntity.Invoice, error) {
+	inv, err := uc.invoiceRepo.FindByID(ctx, invoiceID)
+	if err != nil || inv.CompanyID != companyID {
+		return nil, errors.New("invoice not found")
+	}
+	return inv, nil
+}
+
+// OpenPDF returns the rendered PDF of an invoice. The caller closes it.
+func (uc *UseCase) OpenPDF(ctx context.Context, companyID, invoiceID int64) (*billingEntity.Invoice, io.ReadCloser, error) {
+	inv, err := uc.Get(ctx, companyID, invoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if inv.PDFKey == nil {
+		return nil, nil, errors.New("invoice pdf not ready")
+	}
+
+	r, err := uc.storage.Open(ctx, *inv.PDFKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil, errors.New("invoice pdf not ready")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open invoice pdf: %w", err)
+	}
+	return inv, r, nil
+}
+
+// RenderPDF renders the invoice and stores the PDF. It runs in the worker and
+// may be repeated; the stored file is replaced.
+func (uc *UseCase) RenderPDF(ctx context.Context, invoiceID int64) error {
+	inv, err := uc.invoiceRepo.FindByID(ctx, invoiceID)
+	if err != nil {
+		return err
+	}
+	c, err := uc.companyRepo.FindByID(ctx, inv.CompanyID)
+	if err != nil {
+		return err
+	}
+
+	doc := uc.renderInvoice(inv, c)
+
+	key := fmt.Sprintf("invoices/%d/%d.pdf", inv.CompanyID, inv.ID)
+	if err := uc.storage.Put(ctx, key, bytes.NewReader(doc.Bytes())); err != nil {
+		return fmt.Errorf("failed to store invoice pdf: %w", err)
+	}
+
+	// The invoice may have been paid while rendering; only the key is
+	// written onto the locked row.
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		locked, err := uc.invoiceRepo.LockByID(ctx, inv.ID)
+		if err != nil {
+			return err
+		}
+		locked.PDFKey = &key
+		if err := uc.invoiceRepo.Update(ctx, locked); err != nil {
+			return fmt.Errorf("failed to update invoice: %w", err)
+		}
+		return nil
+	})
+}
+
+// MarkOverdue is run periodically by the worker. Issued invoices past their
+// due date become OVERDUE, and the active subscription they bill becomes
+// PAST_DUE so it expires at the period end unless paid.
+func (uc *UseCase) MarkOverdue(ctx context.Context) error {
+	now := time.Now()
+
+	invoices, err := uc.invoiceRepo.ListIssuedDueBefore(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to list due invoices: %w", err)
+	}
+
+	var errs []error
+	for i := range invoices {
+		inv := &invoices[i]
+		err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			// A payment may have settled the invoice since it was listed.
+			locked, err := uc.invoiceRepo.LockByID(ctx, inv.ID)
+			if err != nil {
+				return err
+			}
+			if locked.Status != billingEntity.InvoiceStatusIssued {
+				return nil
+			}
+			locked.Status = billingEntity.InvoiceStatusOverdue
+			if err := uc.invoiceRepo.Update(ctx, locked); err != nil {
+				return err
+			}
+
+			sub, err := uc.subRepo.FindByID(ctx, inv.SubscriptionID)
+			if err != nil {
+				return err
+			}
+			if sub.Status == subscriptionEntity.StatusActive {
+				sub.Status = subscriptionEntity.StatusPastDue
+				return uc.subRepo.Update(ctx, sub)
+			}
+			return nil
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invoice %d: %w", inv.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func (uc *UseCase) issue(
+	ctx context.Context,
+	sub *subscriptionEntity.CompanySubscription,
+	changeID *int64,
+	periodStart, periodEnd time.Time,
+	items []billingEntity.InvoiceItem,
+) (*billingEntity.Invoice, error) {
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	var subtotal int64
+	for i := range items {
+		itemID, err := snowflake.Generate()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate ID: %w", err)
+		}
+		items[i].ID = itemID
+		items[i].InvoiceID = id
+		subtotal += items[i].Amount
+	}
+
+	now := time.Now()
+	due := now.AddDate(0, 0, uc.dueDays)
+	tax := billingEntity.TaxOf(subtotal, uc.taxRate)
+
+	inv := &billingEntity.Invoice{
+		ID:                   id,
+		CompanyID:            sub.CompanyID,
+		SubscriptionID:       sub.ID,
+		SubscriptionChangeID: changeID,
+		Status:               billingEntity.InvoiceStatusIssued,
+		Currency:             companyEntity.DefaultCurrency,
+		Subtotal:             subtotal,
+		TaxRate:              uc.taxRate,
+		TaxAmount:            tax,
+		TotalAmount:          subtotal + tax,
+		PeriodStart:          periodStart,
+		PeriodEnd:            periodEnd,
+		IssuedAt:             &now,
+		DueAt:                &due,
+		Items:                items,
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		year := now.In(uc.location).Year()
+		n, err := uc.invoiceRepo.NextNumber(ctx, year)
+		if err != nil {
+			return fmt.Errorf("failed to reserve invoice number: %w", err)
+		}
+		inv.InvoiceNumber = billingEntity.FormatInvoiceNumber(year, n)
+
+		if err := uc.invoiceRepo.Create(ctx, inv); err != nil {
+			return fmt.Errorf("failed to create invoice: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+func cycleLabel(cycle string) string {
+	if cycle == subscriptionEntity.BillingCycleYearly {
+		return "yearly"
+	}
+	return "monthly"
+}
@@ -0,0 +1,157 @@
+package billing
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	billingEntity "github.com/haily-id/engine/internal/domain/entity/billing"
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	"github.com/haily-id/engine/internal/pkg/pdf"
+)
+
+const (
+	marginLeft  = 50.0
+	marginRight = pdf.PageWidth - 50.0
+)
+
+// renderInvoice lays the invoice out on A4 pages. Labels are bilingual, as
+// is usual for Indonesian invoices.
+func (uc *UseCase) renderInvoice(inv *billingEntity.Invoice, c *companyEntity.Company) *pdf.Document {
+	doc := pdf.New()
+	page := doc.AddPage()
+
+	page.Text(marginLeft, 70, pdf.HelveticaBold, 22, "INVOICE / TAGIHAN")
+	page.Text(marginLeft, 92, pdf.Helvetica, 10, inv.InvoiceNumber)
+	page.Text(400, 70, pdf.HelveticaBold, 11, uc.issuer.Name)
+	y := 85.0
+	for _, line := range wrap(uc.issuer.Address, 35) {
+		page.Text(400, y, pdf.Helvetica, 9, line)
+		y += 12
+	}
+	if uc.issuer.TaxID != "" {
+		page.Text(400, y, pdf.Helvetica, 9, "NPWP: "+uc.issuer.TaxID)
+	}
+
+	page.Line(marginLeft, 140, marginRight, 140, 0.5)
+
+	page.Text(marginLeft, 165, pdf.HelveticaBold, 10, "Billed to / Ditagihkan kepada")
+	page.Text(marginLeft, 180, pdf.Helvetica, 10, c.LegalName)
+	y = 194.0
+	if c.TaxID != nil && *c.TaxID != "" {
+		page.Text(marginLeft, y, pdf.Helvetica, 9, "NPWP: "+*c.TaxID)
+		y += 14
+	}
+	if c.Email != nil {
+		page.Text(marginLeft, y, pdf.Helvetica, 9, *c.Email)
+	}
+
+	meta := [][2]string{
+		{"Status", inv.Status},
+		{"Issued / Terbit", uc.formatDate(inv.IssuedAt)},
+		{"Due / Jatuh tempo", uc.formatDate(inv.DueAt)},
+		{"Period / Periode", uc.formatDate(&inv.PeriodStart) + " - " + uc.formatDate(&inv.PeriodEnd)},
+	}
+	y = 165.0
+	for _, m := range meta {
+		page.Text(330, y, pdf.HelveticaBold, 9, m[0])
+		page.Text(430, y, pdf.Helvetica, 9, m[1])
+		y += 14
+	}
+
+	y = 250.0
+	page.Line(marginLeft, y, marginRight, y, 0.5)
+	page.Text(marginLeft, y+15, pdf.HelveticaBold, 9, "Description / Keterangan")
+	page.Text(380, y+15, pdf.HelveticaBold, 9, "Qty")
+	page.Text(470, y+15, pdf.HelveticaBold, 9, "Amount / Jumlah")
+	page.Line(marginLeft, y+22, marginRight, y+22, 0.5)
+
+	y += 40
+	for _, item := range inv.Items {
+		lines := wrap(item.Description, 60)
+		for i, line := range lines {
+			page.Text(marginLeft, y+float64(i)*12, pdf.Helvetica, 9, line)
+		}
+		page.Text(385, y, pdf.Helvetica, 9, strconv.Itoa(item.Quantity))
+		page.TextRight(marginRight, y, 9, formatIDR(item.Amount))
+		y += float64(len(lines))*12 + 8
+
+		if y > pdf.PageHeight-150 {
+			page = doc.AddPage()
+			y = 70
+		}
+	}
+
+	page.Line(330, y, marginRight, y, 0.5)
+	y += 18
+	totals := [][2]string{
+		{"Subtotal", formatIDR(inv.Subtotal)},
+		{fmt.Sprintf("PPN %d%%", inv.TaxRate), formatIDR(inv.TaxAmount)},
+	}
+	for _, t := range totals {
+		page.Text(330, y, pdf.Helvetica, 10, t[0])
+		page.TextRight(marginRight, y, 10, t[1])
+		y += 16
+	}
+	page.Text(330, y+4, pdf.HelveticaBold, 11, "Total")
+	page.TextRight(marginRight, y+4, 11, formatIDR(inv.TotalAmount))
+
+	if inv.Notes != nil {
+		y += 40
+		for _, line := range wrap(*inv.Notes, 90) {
+			page.Text(marginLeft, y, pdf.Helvetica, 9, line)
+			y += 12
+		}
+	}
+
+	page.Text(marginLeft, pdf.PageHeight-50, pdf.Helvetica, 8,
+		"This invoice was generated electronically and is valid without a signature. / Tagihan ini dibuat secara elektronik dan sah tanpa tanda tangan.")
+
+	return doc
+}
+
+func (uc *UseCase) formatDate(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.In(uc.location).Format("02/01/2006")
+}
+
+// formatIDR renders an amount as Indonesian Rupiah, e.g. "Rp 1.250.000".
+func formatIDR(amount int64) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	digits := strconv.FormatInt(amount, 10)
+	var b strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte('.')
+		}
+		b.WriteRune(d)
+	}
+	return sign + "Rp " + b.String()
+}
+
+// wrap splits s into lines of at most width characters on word boundaries.
+func wrap(s string, width int) []string {
+	var lines []string
+	var line string
+	for _, word := range strings.Fields(s) {
+		if line != "" && len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,165 @@
+// Package bulk imports employees from CSV and XLSX files and exports them
+// to the same formats.
+package bulk
+
+import (
+	"context"
+	"io"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/table"
+	"github.com/haily-id/engine/internal/usecase/employee"
+	"github.com/haily-id/engine/internal/usecase/invitation"
+	"github.com/hibiken/asynq"
+)
+
+const (
+	FormatCSV  = table.FormatCSV
+	FormatXLSX = table.FormatXLSX
+
+	dateLayout = "2006-01-02"
+)
+
+// Columns are the columns of the import template, in order. name and
+// email are required; any other column may be left out of the file or
+// left empty.
+var Columns = []string{
+	"employee_number",
+	"name",
+	"email",
+	"phone",
+	"gender",
+	"date_of_birth",
+	"place_of_birth",
+	"national_id",
+	"tax_id",
+	"marital_status",
+	"hire_date",
+	"employment_type",
+	"dependents_count",
+	"department_code",
+	"position_code",
+}
+
+// ExportColumns are the columns an export can hold: the template's, then
+// the ones the import leaves to the system.
+var ExportColumns = []string{
+	"employee_number",
+	"name",
+	"email",
+	"phone",
+	"gender",
+	"date_of_birth",
+	"place_of_birth",
+	"national_id",
+	"tax_id",
+	"marital_status",
+	"hire_date",
+	"employment_type",
+	"dependents_count",
+	"department_code",
+	"position_code",
+	"division_code",
+	"employment_status",
+}
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+type TemplateRequest struct {
+	Format string `query:"format" validate:"omitempty,oneof=csv xlsx"`
+}
+
+// ConfirmImportRequest starts a validated import. With SendInvitations,
+// every imported employee is invited with the role RoleID.
+type ConfirmImportRequest struct {
+	SendInvitations bool   `json:"send_invitations"`
+	RoleID          string `json:"role_id"          validate:"required_if=SendInvitations true,omitempty,numeric"`
+}
+
+// ExportRequest takes the employee list filters. Columns is a comma
+// separated subset of ExportColumns, all of them when empty.
+type ExportRequest struct {
+	Format           string `query:"format"            validate:"omitempty,oneof=csv xlsx"`
+	Columns          string `query:"columns"           validate:"omitempty,max=500"`
+	Search           string `query:"q"                 validate:"omitempty,max=100"`
+	DivisionID       string `query:"division_id"       validate:"omitempty,numeric"`
+	DepartmentID     string `query:"department_id"     validate:"omitempty,numeric"`
+	EmploymentStatus string `query:"employment_status" validate:"omitempty,oneof=ACTIVE TERMINATED SUSPENDED ON_LEAVE"`
+	EmploymentType   string `query:"employment_type"   validate:"omitempty,oneof=FULL_TIME PART_TIME CONTRACT INTERN"`
+}
+
+// File is an uploaded import file as received from the client.
+type File struct {
+	Name    string
+	Size    int64
+	Content io.ReaderAt
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	importRepo           repository.EmployeeImportRepository
+	employeeRepo         repository.EmployeeRepository
+	companyRepo          repository.CompanyRepository
+	divisionRepo         repository.DivisionRepository
+	departmentRepo       repository.DepartmentRepository
+	positionRepo         repository.PositionRepository
+	employeePositionRepo repository.EmployeePositionRepository
+	roleRepo             repository.RoleRepository
+	transactor           repository.Transactor
+	employees            Employees
+	invitations          Invitations
+	asynqClient          interface {
+		Enqueue(task *asynq.Task, opts ...asynq.Option) error
+	}
+	auditor audit.Recorder
+}
+
+// Employees creates the imported employees through the same checks as the
+// employee endpoints.
+type Employees interface {
+	Check(ctx context.Context, companyID int64, req employee.CreateEmployeeRequest) error
+	Create(ctx context.Context, companyID int64, req employee.CreateEmployeeRequest) (*employeeEntity.Employee, error)
+	AssignPosition(ctx context.Context, companyID, employeeID int64, req employee.AssignPositionRequest) (*employee.Assignment, error)
+}
+
+// Invitations invites imported employees.
+type Invitations interface {
+	Create(ctx context.Context, companyID, inviterUserID int64, req invitation.CreateInvitationRequest) (*employeeEntity.Invitation, error)
+}
+
+func NewUseCase(
+	importRepo repository.EmployeeImportRepository,
+	employeeRepo repository.EmployeeRepository,
+	companyRepo repository.CompanyRepository,
+	divisionRepo repository.DivisionRepository,
+	departmentRepo repository.DepartmentRepository,
+	positionRepo repository.PositionRepository,
+	employeePositionRepo repository.EmployeePositionRepository,
+	roleRepo repository.RoleRepository,
+	transactor repository.Transactor,
+	employees Employees,
+	invitations Invitations,
+	asynqClient interface {
+		Enqueue(task *asynq.Task, opts ...asynq.Option) error
+	},
+	auditor audit.Recorder,
+) *UseCase {
+	return &UseCase{
+		importRepo:           importRepo,
+		employeeRepo:         employeeRepo,
+		companyRepo:          companyRepo,
+		divisionRepo:         divisionRepo,
+		departmentRepo:       departmentRepo,
+		positionRepo:         positionRepo,
+		employeePositionRepo: employeePositionRepo,
+		roleRepo:             roleRepo,
+		transactor:           transactor,
+		employees:            employees,
+		invitations:          invitations,
+		asynqClient:          asynqClient,
+		auditor:              auditor,
+	}
+}
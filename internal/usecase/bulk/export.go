@@ -0,0 +1,188 @@
+package bulk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	orgEntity "github.com/haily-id/engine/internal/domain/entity/organization"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/table"
+)
+
+const exportBatchSize = 500
+
+// ExportColumnsOf parses the columns of an export request, keeping their
+// order. It returns "unknown column" for a column not in ExportColumns, so
+// handlers can reject the request before anything is written.
+func ExportColumnsOf(req ExportRequest) ([]string, error) {
+	if strings.TrimSpace(req.Columns) == "" {
+		return ExportColumns, nil
+	}
+	known := make(map[string]bool, len(ExportColumns))
+	for _, c := range ExportColumns {
+		known[c] = true
+	}
+	var columns []string
+	seen := make(map[string]bool)
+	for _, c := range strings.Split(req.Columns, ",") {
+		c = strings.ToLower(strings.TrimSpace(c))
+		if c == "" || seen[c] {
+			continue
+		}
+		if !known[c] {
+			return nil, errors.New("unknown column")
+		}
+		columns = append(columns, c)
+		seen[c] = true
+	}
+	if len(columns) == 0 {
+		return ExportColumns, nil
+	}
+	return columns, nil
+}
+
+// Export writes the employees matching the filters to w, one row each
+// under a header row, and records the export. The template columns come
+// out as the import reads them, so an export can be edited and imported
+// into another company.
+func (uc *UseCase) Export(ctx context.Context, companyID int64, req ExportRequest, w io.Writer) error {
+	columns, err := ExportColumnsOf(req)
+	if err != nil {
+		return err
+	}
+
+	divisions, err := uc.divisionRepo.ListByCompany(ctx, companyID, false)
+	if err != nil {
+		return err
+	}
+	departments, err := uc.departmentRepo.ListByCompany(ctx, companyID, 0, false)
+	if err != nil {
+		return err
+	}
+	positions, err := uc.positionRepo.ListByCompany(ctx, companyID, 0, false)
+	if err != nil {
+		return err
+	}
+	current, err := uc.employeePositionRepo.ListCurrentPrimaryByCompany(ctx, companyID)
+	if err != nil {
+		return err
+	}
+	divisionCodes := make(map[int64]string, len(divisions))
+	for _, d := range divisions {
+		divisionCodes[d.ID] = d.Code
+	}
+	departmentCodes := make(map[int64]string, len(departments))
+	for _, d := range departments {
+		departmentCodes[d.ID] = d.Code
+	}
+	positionsByID := make(map[int64]orgEntity.Position, len(positions))
+	for _, p := range positions {
+		positionsByID[p.ID] = p
+	}
+	positionCodes := make(map[int64]string, len(current))
+	for _, a := range current {
+		positionCodes[a.EmployeeID] = positionsByID[a.PositionID].Code
+	}
+
+	tw, err := table.NewWriter(w, req.Format, "Employees")
+	if err != nil {
+		return err
+	}
+	if err := tw.Write(columns); err != nil {
+		return err
+	}
+
+	f := repository.EmployeeFilter{
+		CompanyID:        companyID,
+		Search:           strings.TrimSpace(req.Search),
+		EmploymentStatus: req.EmploymentStatus,
+		EmploymentType:   req.EmploymentType,
+	}
+	f.DivisionID, _ = strconv.ParseInt(req.DivisionID, 10, 64)
+	f.DepartmentID, _ = strconv.ParseInt(req.DepartmentID, 10, 64)
+
+	count := 0
+	for offset := 0; ; offset += exportBatchSize {
+		list, _, err := uc.employeeRepo.List(ctx, f, offset, exportBatchSize)
+		if err != nil {
+			return err
+		}
+		for i := range list {
+			e := &list[i]
+			values := map[string]string{
+				"employee_number":   deref(e.EmployeeNumber),
+				"name":              e.Name,
+				"email":             e.Email,
+				"phone":             deref(e.Phone),
+				"gender":            deref(e.Gender),
+				"date_of_birth":     dateText(e.DateOfBirth),
+				"place_of_birth":    deref(e.PlaceOfBirth),
+				"national_id":       deref(e.NationalID),
+				"tax_id":            deref(e.TaxID),
+				"marital_status":    deref(e.MaritalStatus),
+				"hire_date":         dateText(e.HireDate),
+				"employment_type":   e.EmploymentType,
+				"dependents_count":  strconv.Itoa(e.DependentsCount),
+				"department_code":   codeOf(departmentCodes, e.DepartmentID),
+				"position_code":     positionCodes[e.ID],
+				"division_code":     codeOf(divisionCodes, e.DivisionID),
+				"employment_status": e.EmploymentStatus,
+			}
+			row := make([]string, len(columns))
+			for j, c := range columns {
+				row[j] = values[c]
+			}
+			if err := tw.Write(row); err != nil {
+				return err
+			}
+		}
+		count += len(list)
+		if len(list) < exportBatchSize {
+			break
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	uc.auditor.Record(ctx, audit.Entry{
+		CompanyID: companyID,
+		Module:    audit.ModuleHR,
+		Entity:    "employees",
+		Action:    audit.ActionExport,
+		New: map[string]interface{}{
+			"filters": req,
+			"columns": columns,
+			"rows":    count,
+		},
+	})
+	return nil
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func dateText(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(dateLayout)
+}
+
+func codeOf(codes map[int64]string, id *int64) string {
+	if id == nil {
+		return ""
+	}
+	return codes[*id]
+}
@@ -0,0 +1,616 @@
+package bulk
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	orgEntity "github.com/haily-id/engine/internal/domain/entity/organization"
+	"github.com/haily-id/engine/internal/domain/entity/rbac"
+	"github.com/haily-id/engine/internal/pkg/asynq/tasks"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/i18n"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+	"github.com/haily-id/engine/internal/pkg/table"
+	"github.com/haily-id/engine/internal/pkg/validator"
+	"github.com/haily-id/engine/internal/pkg/xlsx"
+	"github.com/haily-id/engine/internal/usecase/employee"
+	"github.com/haily-id/engine/internal/usecase/invitation"
+	"github.com/hibiken/asynq"
+)
+
+// runLease is how long a running import may go without progress before
+// another task takes it over. Every row saves the import.
+const runLease = 5 * time.Minute
+
+// importRow is a validated data row as stored on the import until the
+// worker creates it. Row is the spreadsheet row, the header being row 1.
+type importRow struct {
+	Row        int                            `json:"row"`
+	Employee   employee.CreateEmployeeRequest `json:"employee"`
+	PositionID string                         `json:"position_id,omitempty"`
+}
+
+// fieldColumns maps the request fields named in validation messages to
+// template columns.
+var fieldColumns = map[string]string{
+	"EmployeeNumber":  "employee_number",
+	"Name":            "name",
+	"Email":           "email",
+	"Phone":           "phone",
+	"Gender":          "gender",
+	"DateOfBirth":     "date_of_birth",
+	"PlaceOfBirth":    "place_of_birth",
+	"NationalID":      "national_id",
+	"TaxID":           "tax_id",
+	"MaritalStatus":   "marital_status",
+	"HireDate":        "hire_date",
+	"EmploymentType":  "employment_type",
+	"DependentsCount": "dependents_count",
+}
+
+// errorColumns maps the errors of employee creation to the column at
+// fault. An error missing here is not about the row and fails the upload.
+var errorColumns = map[string]string{
+	"employee email already exists":     "email",
+	"employee number already exists":    "employee_number",
+	"invalid national id":               "national_id",
+	"national id region not found":      "national_id",
+	"national id birth date mismatch":   "national_id",
+	"national id gender mismatch":       "national_id",
+	"national id already exists":        "national_id",
+	"tax id does not match national id": "tax_id",
+	"department not available":          "department_code",
+	"division not available":            "department_code",
+	"department not in division":        "department_code",
+}
+
+// Template returns an empty import file: the header row only.
+func (uc *UseCase) Template(ctx context.Context, format string, w io.Writer) error {
+	tw, err := table.NewWriter(w, format, "Employees")
+	if err != nil {
+		return err
+	}
+	if err := tw.Write(Columns); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// Get returns an import for polling its progress.
+func (uc *UseCase) Get(ctx context.Context, companyID, id int64) (*employeeEntity.Import, error) {
+	return uc.importRepo.FindByID(ctx, companyID, id)
+}
+
+// Validate is the dry run: it parses the file and checks every row as
+// the employee endpoints would, creating nothing. The import comes back
+// VALIDATED, ready to confirm, or INVALID with the errors of every row.
+func (uc *UseCase) Validate(ctx context.Context, companyID, userID int64, f File) (*employeeEntity.Import, error) {
+	if f.Size == 0 {
+		return nil, errors.New("file is empty")
+	}
+	if f.Size > employeeEntity.MaxImportSize {
+		return nil, errors.New("file too large")
+	}
+	cells, err := readTable(f)
+	if err != nil {
+		return nil, err
+	}
+	if len(cells) == 0 {
+		return nil, errors.New("import is empty")
+	}
+
+	var problems []employeeEntity.ImportRowError
+	columns, headerErrors := parseHeader(cells[0])
+	problems = append(problems, headerErrors...)
+
+	type dataRow struct {
+		row    int
+		values map[string]string
+	}
+	var data []dataRow
+	for i, row := range cells[1:] {
+		values := make(map[string]string)
+		for j, cell := range row {
+			if j < len(columns) && columns[j] != "" {
+				if v := table.Unescape(strings.TrimSpace(cell)); v != "" {
+					values[columns[j]] = v
+				}
+			}
+		}
+		if len(values) == 0 {
+			continue
+		}
+		data = append(data, dataRow{row: i + 2, values: values})
+	}
+	if len(data) == 0 {
+		return nil, errors.New("import is empty")
+	}
+	if len(data) > employeeEntity.MaxImportRows {
+		return nil, errors.New("too many rows")
+	}
+
+	departments, positions, err := uc.orgByCode(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]importRow, 0, len(data))
+	if len(headerErrors) == 0 {
+		seen := map[string]map[string]int{
+			"email":           {},
+			"employee_number": {},
+			"national_id":     {},
+		}
+		for _, d := range data {
+			r, rowErrors := buildRow(d.row, d.values, departments, positions)
+			if len(rowErrors) == 0 {
+				rowErrors, err = uc.check(ctx, companyID, r)
+				if err != nil {
+					return nil, err
+				}
+			}
+			for column, values := range seen {
+				v := strings.ToLower(d.values[column])
+				if v == "" {
+					continue
+				}
+				if first, ok := values[v]; ok {
+					rowErrors = append(rowErrors, employeeEntity.ImportRowError{
+						Row:     d.row,
+						Column:  column,
+						Message: fmt.Sprintf("duplicate of row %d", first),
+					})
+					continue
+				}
+				values[v] = d.row
+			}
+			problems = append(problems, rowErrors...)
+			rows = append(rows, *r)
+		}
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool {
+		if problems[i].Row != problems[j].Row {
+			return problems[i].Row < problems[j].Row
+		}
+		return problems[i].Column < problems[j].Column
+	})
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+	imp := &employeeEntity.Import{
+		ID:        id,
+		CompanyID: companyID,
+		CreatedBy: userID,
+		FileName:  filepath.Base(f.Name),
+		Status:    employeeEntity.ImportStatusValidated,
+		TotalRows: len(data),
+		Errors:    problems,
+		Lang:      i18n.FromContext(ctx),
+	}
+	if len(problems) > 0 {
+		imp.Status = employeeEntity.ImportStatusInvalid
+	} else {
+		encoded, err := json.Marshal(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode rows: %w", err)
+		}
+		imp.Rows = string(encoded)
+	}
+	if err := uc.importRepo.Create(ctx, imp); err != nil {
+		return nil, fmt.Errorf("failed to create import: %w", err)
+	}
+	return imp, nil
+}
+
+// Confirm queues a validated import for the worker.
+func (uc *UseCase) Confirm(ctx context.Context, companyID, id int64, req ConfirmImportRequest) (*employeeEntity.Import, error) {
+	var roleID *int64
+	if req.SendInvitations {
+		rid, _ := strconv.ParseInt(req.RoleID, 10, 64)
+		role, err := uc.roleRepo.FindByID(ctx, rid)
+		if err != nil || (role.CompanyID != nil && *role.CompanyID != companyID) {
+			return nil, errors.New("role not found")
+		}
+		if role.Code == rbac.RoleOwner {
+			return nil, errors.New("role not assignable")
+		}
+		roleID = &role.ID
+	}
+
+	var imp *employeeEntity.Import
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.importRepo.FindByID(ctx, companyID, id); err != nil {
+			return err
+		}
+		var err error
+		if imp, err = uc.importRepo.LockByID(ctx, id); err != nil {
+			return err
+		}
+		if imp.Status != employeeEntity.ImportStatusValidated {
+			return errors.New("import not ready")
+		}
+		imp.Status = employeeEntity.ImportStatusQueued
+		imp.SendInvitations = req.SendInvitations
+		imp.RoleID = roleID
+		imp.Lang = i18n.FromContext(ctx)
+		if err := uc.importRepo.Update(ctx, imp); err != nil {
+			return fmt.Errorf("failed to update import: %w", err)
+		}
+
+		task, err := tasks.NewImportEmployeesTask(imp.ID)
+		if err != nil {
+			return fmt.Errorf("failed to create import task: %w", err)
+		}
+		if err := uc.asynqClient.Enqueue(task, asynq.Queue("default")); err != nil {
+			return fmt.Errorf("failed to enqueue import: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditor.Record(ctx, audit.Entry{
+		CompanyID: companyID,
+		Module:    audit.ModuleHR,
+		Entity:    "employee_imports",
+		EntityID:  imp.ID,
+		Action:    audit.ActionCreate,
+		New:       imp,
+	})
+	return imp, nil
+}
+
+// Run creates the employees of a queued import. It saves its progress
+// after every row, so a retried task resumes where the last attempt
+// stopped. Rows that fail are recorded on the import and skipped.
+//
+// The import is claimed first, so that only one task works on it. A task
+// that finds it running elsewhere fails and is retried later; once the
+// other run has made no progress for runLease it takes over.
+func (uc *UseCase) Run(ctx context.Context, importID int64) error {
+	imp, claimed, err := uc.importRepo.Claim(ctx, importID, time.Now().Add(-runLease))
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		if imp.Status == employeeEntity.ImportStatusRunning {
+			return errors.New("import already running")
+		}
+		return nil
+	}
+	c, err := uc.companyRepo.FindByID(ctx, imp.CompanyID)
+	if err != nil {
+		return fmt.Errorf("failed to load company: %w", err)
+	}
+
+	var rows []importRow
+	if err := json.Unmarshal([]byte(imp.Rows), &rows); err != nil {
+		return fmt.Errorf("failed to decode rows: %w", err)
+	}
+
+	ctx = audit.WithActor(ctx, imp.CreatedBy)
+	ctx = i18n.WithLang(ctx, imp.Lang)
+
+	if imp.StartedAt == nil {
+		now := time.Now()
+		imp.StartedAt = &now
+	}
+	if err := uc.importRepo.Update(ctx, imp); err != nil {
+		return fmt.Errorf("failed to update import: %w", err)
+	}
+
+	for _, r := range rows[imp.ProcessedRows:] {
+		invited, err := uc.createRow(ctx, imp, c.ID, c.Today(), r)
+		if err != nil {
+			imp.FailedCount++
+			imp.Errors = append(imp.Errors, employeeEntity.ImportRowError{Row: r.Row, Message: err.Error()})
+		} else {
+			imp.CreatedCount++
+			if invited {
+				imp.InvitedCount++
+			}
+		}
+		imp.ProcessedRows++
+		if err := uc.importRepo.Update(ctx, imp); err != nil {
+			return fmt.Errorf("failed to update import: %w", err)
+		}
+	}
+
+	now := time.Now()
+	imp.Status = employeeEntity.ImportStatusCompleted
+	imp.FinishedAt = &now
+	imp.Rows = ""
+	if err := uc.importRepo.Update(ctx, imp); err != nil {
+		return fmt.Errorf("failed to update import: %w", err)
+	}
+	return nil
+}
+
+// createRow creates one employee, places them in their position from the
+// hire date and invites them when the import asks for it. An employee
+// whose position or invitation fails stays created; the row reports the
+// failure and counts as created.
+func (uc *UseCase) createRow(ctx context.Context, imp *employeeEntity.Import, companyID int64, today time.Time, r importRow) (bool, error) {
+	e, err := uc.employees.Create(ctx, companyID, r.Employee)
+	if err != nil {
+		return false, err
+	}
+
+	if r.PositionID != "" {
+		start := today.Format(dateLayout)
+		if r.Employee.HireDate != nil {
+			start = *r.Employee.HireDate
+		}
+		_, err := uc.employees.AssignPosition(ctx, companyID, e.ID, employee.AssignPositionRequest{
+			PositionID: r.PositionID,
+			StartDate:  start,
+			IsPrimary:  true,
+		})
+		if err != nil {
+			imp.Errors = append(imp.Errors, employeeEntity.ImportRowError{Row: r.Row, Column: "position_code", Message: err.Error()})
+		}
+	}
+
+	if !imp.SendInvitations || imp.RoleID == nil {
+		return false, nil
+	}
+	_, err = uc.invitations.Create(ctx, companyID, imp.CreatedBy, invitation.CreateInvitationRequest{
+		Email:          e.Email,
+		Name:           e.Name,
+		RoleID:         strconv.FormatInt(*imp.RoleID, 10),
+		EmployeeNumber: e.EmployeeNumber,
+	})
+	if err != nil {
+		imp.Errors = append(imp.Errors, employeeEntity.ImportRowError{Row: r.Row, Column: "email", Message: err.Error()})
+		return false, nil
+	}
+	return true, nil
+}
+
+// check runs the employee checks on a row that passed field validation.
+func (uc *UseCase) check(ctx context.Context, companyID int64, r *importRow) ([]employeeEntity.ImportRowError, error) {
+	err := uc.employees.Check(ctx, companyID, r.Employee)
+	if err == nil {
+		return nil, nil
+	}
+	column, ok := errorColumns[err.Error()]
+	if !ok {
+		return nil, err
+	}
+	return []employeeEntity.ImportRowError{{Row: r.Row, Column: column, Message: err.Error()}}, nil
+}
+
+// orgByCode indexes the company's active departments and positions by
+// their upper case code.
+func (uc *UseCase) orgByCode(ctx context.Context, companyID int64) (map[string]orgEntity.Department, map[string]orgEntity.Position, error) {
+	departments, err := uc.departmentRepo.ListByCompany(ctx, companyID, 0, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	positions, err := uc.positionRepo.ListByCompany(ctx, companyID, 0, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	byDepartment := make(map[string]orgEntity.Department, len(departments))
+	for _, d := range departments {
+		byDepartment[d.Code] = d
+	}
+	byPosition := make(map[string]orgEntity.Position, len(positions))
+	for _, p := range positions {
+		byPosition[p.Code] = p
+	}
+	return byDepartment, byPosition, nil
+}
+
+// ─── Parsing ────────────────────────────────────────────────────
+
+// readTable reads the rows of an XLSX workbook's first sheet or of a CSV
+// file, told apart by the zip signature XLSX files start with.
+func readTable(f File) ([][]string, error) {
+	magic := make([]byte, 4)
+	if _, err := f.Content.ReadAt(magic, 0); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if bytes.Equal(magic, []byte("PK\x03\x04")) {
+		// The header row comes on top of the data rows.
+		rows, err := xlsx.Read(f.Content, f.Size, employeeEntity.MaxImportRows+1)
+		if errors.Is(err, xlsx.ErrTooManyRows) {
+			return nil, errors.New("too many rows")
+		}
+		if err != nil {
+			return nil, errors.New("invalid import file")
+		}
+		return rows, nil
+	}
+
+	switch strings.ToLower(filepath.Ext(f.Name)) {
+	case ".csv", ".txt":
+	default:
+		return nil, errors.New("unsupported file type")
+	}
+
+	content, err := io.ReadAll(io.NewSectionReader(f.Content, 0, f.Size))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+
+	r := csv.NewReader(bytes.NewReader(content))
+	r.FieldsPerRecord = -1
+	// Spreadsheets in locales with a decimal comma save CSV with
+	// semicolons; the header row tells which one this file uses.
+	header, _, _ := bytes.Cut(content, []byte("\n"))
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		r.Comma = ';'
+	}
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, errors.New("invalid import file")
+	}
+	return rows, nil
+}
+
+// parseHeader maps each column of the header row to a template column,
+// or to "" for empty header cells.
+func parseHeader(header []string) ([]string, []employeeEntity.ImportRowError) {
+	known := make(map[string]bool, len(Columns))
+	for _, c := range Columns {
+		known[c] = true
+	}
+
+	var problems []employeeEntity.ImportRowError
+	columns := make([]string, len(header))
+	seen := make(map[string]bool)
+	for i, h := range header {
+		name := strings.ToLower(strings.Join(strings.Fields(strings.TrimSpace(h)), "_"))
+		switch {
+		case name == "":
+			continue
+		case !known[name]:
+			problems = append(problems, employeeEntity.ImportRowError{Row: 1, Column: h, Message: "unknown column"})
+		case seen[name]:
+			problems = append(problems, employeeEntity.ImportRowError{Row: 1, Column: name, Message: "duplicate column"})
+		default:
+			columns[i] = name
+			seen[name] = true
+		}
+	}
+	for _, required := range []string{"name", "email"} {
+		if !seen[required] {
+			problems = append(problems, employeeEntity.ImportRowError{Row: 1, Column: required, Message: "missing column"})
+		}
+	}
+	return columns, problems
+}
+
+// buildRow turns the values of a row into an employee request, checking
+// the fields and resolving the department and position codes.
+func buildRow(row int, values map[string]string, departments map[string]orgEntity.Department, positions map[string]orgEntity.Position) (*importRow, []employeeEntity.ImportRowError) {
+	var problems []employeeEntity.ImportRowError
+	fail := func(column, message string) {
+		problems = append(problems, employeeEntity.ImportRowError{Row: row, Column: column, Message: message})
+	}
+	value := func(column string) *string {
+		if v, ok := values[column]; ok {
+			return &v
+		}
+		return nil
+	}
+	upper := func(column string) *string {
+		if v := value(column); v != nil {
+			u := strings.ToUpper(*v)
+			return &u
+		}
+		return nil
+	}
+	date := func(column string) *string {
+		v := value(column)
+		if v == nil {
+			return nil
+		}
+		d, ok := parseDate(*v)
+		if !ok {
+			fail(column, column+" must be a date (YYYY-MM-DD)")
+			return nil
+		}
+		return &d
+	}
+
+	req := employee.CreateEmployeeRequest{
+		EmployeeNumber: value("employee_number"),
+		Name:           values["name"],
+		Email:          values["email"],
+		Phone:          value("phone"),
+		Gender:         upper("gender"),
+		DateOfBirth:    date("date_of_birth"),
+		PlaceOfBirth:   value("place_of_birth"),
+		NationalID:     value("national_id"),
+		TaxID:          value("tax_id"),
+		MaritalStatus:  upper("marital_status"),
+		HireDate:       date("hire_date"),
+		EmploymentType: strings.ToUpper(strings.ReplaceAll(values["employment_type"], " ", "_")),
+	}
+	if v := value("dependents_count"); v != nil {
+		n, err := strconv.Atoi(*v)
+		if err != nil {
+			fail("dependents_count", "dependents_count must be a number")
+		}
+		req.DependentsCount = n
+	}
+
+	r := &importRow{Row: row}
+	var department *orgEntity.Department
+	if code := upper("department_code"); code != nil {
+		d, ok := departments[*code]
+		if !ok {
+			fail("department_code", "department not found")
+		} else {
+			department = &d
+		}
+	}
+	if code := upper("position_code"); code != nil {
+		p, ok := positions[*code]
+		switch {
+		case !ok:
+			fail("position_code", "position not found")
+		case department != nil && department.ID != p.DepartmentID:
+			fail("position_code", "position not in department")
+		default:
+			r.PositionID = strconv.FormatInt(p.ID, 10)
+			if department == nil {
+				departmentID := strconv.FormatInt(p.DepartmentID, 10)
+				req.DepartmentID = &departmentID
+			}
+		}
+	}
+	if department != nil {
+		departmentID := strconv.FormatInt(department.ID, 10)
+		req.DepartmentID = &departmentID
+	}
+
+	if err := validator.Validate(req); err != nil {
+		for field, message := range validator.FormatValidationError(err) {
+			for name, column := range fieldColumns {
+				if strings.ToLower(name) == field {
+					message = column + strings.TrimPrefix(message, name)
+					field = column
+					break
+				}
+			}
+			fail(field, message)
+		}
+	}
+
+	r.Employee = req
+	return r, problems
+}
+
+// parseDate reads a date as YYYY-MM-DD, DD/MM/YYYY or the day number
+// spreadsheets store dates as, and returns it as YYYY-MM-DD.
+func parseDate(s string) (string, bool) {
+	for _, layout := range []string{dateLayout, "02/01/2006", "2/1/2006"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.Format(dateLayout), true
+		}
+	}
+	if n, err := strconv.Atoi(s); err == nil && n > 0 && n < 100000 {
+		// Day 1 is 1900-01-01, counting the 1900-02-29 Lotus kept.
+		return time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC).AddDate(0, 0, n).Format(dateLayout), true
+	}
+	return "", false
+}
@@ -0,0 +1,173 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+)
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+type CreateEntryRequest struct {
+	Name        string  `json:"name"        validate:"required,min=2,max=100"`
+	Code        string  `json:"code"        validate:"required,min=2,max=20,alphanum"`
+	Description *string `json:"description" validate:"omitempty,max=1000"`
+}
+
+// UpdateEntryRequest cannot change the code, which clients may rely on.
+// Deactivating an entry hides it from signup forms; companies referencing it
+// keep it.
+type UpdateEntryRequest struct {
+	Name        *string `json:"name"        validate:"omitempty,min=2,max=100"`
+	Description *string `json:"description" validate:"omitempty,max=1000"`
+	IsActive    *bool   `json:"is_active"`
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	industryRepo    repository.IndustryRepository
+	companyTypeRepo repository.CompanyTypeRepository
+}
+
+func NewUseCase(
+	industryRepo repository.IndustryRepository,
+	companyTypeRepo repository.CompanyTypeRepository,
+) *UseCase {
+	return &UseCase{
+		industryRepo:    industryRepo,
+		companyTypeRepo: companyTypeRepo,
+	}
+}
+
+// ListIndustries returns active industries, or every industry when all is
+// set (platform admin).
+func (uc *UseCase) ListIndustries(ctx context.Context, all bool) ([]companyEntity.Industry, error) {
+	return uc.industryRepo.List(ctx, !all)
+}
+
+func (uc *UseCase) CreateIndustry(ctx context.Context, req CreateEntryRequest) (*companyEntity.Industry, error) {
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	name := strings.TrimSpace(req.Name)
+	if existing, _ := uc.industryRepo.FindByCode(ctx, code); existing != nil {
+		return nil, errors.New("industry code already exists")
+	}
+	if existing, _ := uc.industryRepo.FindByName(ctx, name); existing != nil {
+		return nil, errors.New("industry name already exists")
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	i := &companyEntity.Industry{
+		ID:          id,
+		Name:        name,
+		Code:        code,
+		Description: req.Description,
+		IsActive:    true,
+	}
+	if err := uc.industryRepo.Create(ctx, i); err != nil {
+		return nil, fmt.Errorf("failed to create industry: %w", err)
+	}
+	return i, nil
+}
+
+func (uc *UseCase) UpdateIndustry(ctx context.Context, id int64, req UpdateEntryRequest) (*companyEntity.Industry, error) {
+	i, err := uc.industryRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if existing, _ := uc.industryRepo.FindByName(ctx, name); existing != nil && existing.ID != i.ID {
+			return nil, errors.New("industry name already exists")
+		}
+		i.Name = name
+	}
+	if req.Description != nil {
+		i.Description = req.Description
+	}
+	if req.IsActive != nil {
+		i.IsActive = *req.IsActive
+	}
+
+	if err := uc.industryRepo.Update(ctx, i); err != nil {
+		return nil, fmt.Errorf("failed to update industry: %w", err)
+	}
+	return i, nil
+}
+
+// ListCompanyTypes returns active company types, or every type when all is
+// set (platform admin).
+func (uc *UseCase) ListCompanyTypes(ctx context.Context, all bool) ([]companyEntity.CompanyType, error) {
+	return uc.companyTypeRepo.List(ctx, !all)
+}
+
+func (uc *UseCase) CreateCompanyType(ctx context.Context, req CreateEntryRequest) (*companyEntity.CompanyType, error) {
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	name := strings.TrimSpace(req.Name)
+	if existing, _ := uc.companyTypeRepo.FindByCode(ctx, code); existing != nil {
+		return nil, errors.New("company type code already exists")
+	}
+	if existing, _ := uc.companyTypeRepo.FindByName(ctx, name); existing != nil {
+		return nil, errors.New("company type name already exists")
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	t := &companyEntity.CompanyType{
+		ID:          id,
+		Name:        name,
+		Code:        code,
+		Description: req.Description,
+		IsActive:    true,
+	}
+	if err := uc.companyTypeRepo.Create(ctx, t); err != nil {
+		return nil, fmt.Errorf("failed to create company type: %w", err)
+	}
+	return t, nil
+}
+
+func (uc *UseCase) UpdateCompanyType(ctx context.Context, id int64, req UpdateEntryRequest) (*companyEntity.CompanyType, error) {
+	t, err := uc.companyTypeRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if existing, _ := uc.companyTypeRepo.FindByName(ctx, name); existing != nil && existing.ID != t.ID {
+			return nil, errors.New("company type name already exists")
+		}
+		t.Name = name
+	}
+	if req.Description != nil {
+		t.Description = req.Description
+	}
+	if req.IsActive != nil {
+		t.IsActive = *req.IsActive
+	}
+
+	if err := uc.companyTypeRepo.Update(ctx, t); err != nil {
+		return nil, fmt.Errorf("failed to update company type: %w", err)
+	}
+	return t, nil
+}
+
+// ListBanks returns the bundled bank directory. It is not stored, so it
+// cannot be edited.
+func (uc *UseCase) ListBanks() []employeeEntity.Bank {
+	return employeeEntity.Banks()
+}
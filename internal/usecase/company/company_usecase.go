@@ -0,0 +1,196 @@
+package company
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	"github.com/haily-id/engine/internal/domain/entity/rbac"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+	"github.com/haily-id/engine/internal/pkg/validator"
+)
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+type CreateCompanyRequest struct {
+	Name      string  `json:"name"       validate:"required,min=2,max=255"`
+	LegalName string  `json:"legal_name" validate:"required,min=2,max=255"`
+	Code      string  `json:"code"       validate:"required,min=2,max=50,alphanum"`
+	Email     *string `json:"email"      validate:"omitempty,email"`
+	Phone     *string `json:"phone"      validate:"omitempty,max=50"`
+	TaxID     *string `json:"tax_id"     validate:"omitempty,npwp"`
+
+	IndustryID    *string `json:"industry_id"     validate:"omitempty,numeric"`
+	CompanyTypeID *string `json:"company_type_id" validate:"omitempty,numeric"`
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	companyRepo     repository.CompanyRepository
+	memberRepo      repository.UserCompanyRepository
+	roleRepo        repository.RoleRepository
+	industryRepo    repository.IndustryRepository
+	companyTypeRepo repository.CompanyTypeRepository
+	transactor      repository.Transactor
+	trials          interface {
+		StartTrial(ctx context.Context, companyID int64) error
+	}
+	auditor audit.Recorder
+}
+
+func NewUseCase(
+	companyRepo repository.CompanyRepository,
+	memberRepo repository.UserCompanyRepository,
+	roleRepo repository.RoleRepository,
+	industryRepo repository.IndustryRepository,
+	companyTypeRepo repository.CompanyTypeRepository,
+	transactor repository.Transactor,
+	trials interface {
+		StartTrial(ctx context.Context, companyID int64) error
+	},
+	auditor audit.Recorder,
+) *UseCase {
+	return &UseCase{
+		companyRepo:     companyRepo,
+		memberRepo:      memberRepo,
+		roleRepo:        roleRepo,
+		industryRepo:    industryRepo,
+		companyTypeRepo: companyTypeRepo,
+		transactor:      transactor,
+		trials:          trials,
+		auditor:         auditor,
+	}
+}
+
+func (uc *UseCase) Create(ctx context.Context, userID int64, req CreateCompanyRequest) (*companyEntity.Company, error) {
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	if existing, _ := uc.companyRepo.FindByCode(ctx, code); existing != nil {
+		return nil, errors.New("company code already exists")
+	}
+
+	industryID, err := uc.activeIndustry(ctx, req.IndustryID)
+	if err != nil {
+		return nil, err
+	}
+	companyTypeID, err := uc.activeCompanyType(ctx, req.CompanyTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerRole, err := uc.roleRepo.FindSystemByCode(ctx, rbac.RoleOwner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load owner role: %w", err)
+	}
+
+	companyID, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+	memberID, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	c := &companyEntity.Company{
+		ID:            companyID,
+		Name:          req.Name,
+		LegalName:     req.LegalName,
+		Code:          code,
+		Email:         req.Email,
+		Phone:         req.Phone,
+		TaxID:         validator.OptionalNPWP(req.TaxID),
+		IndustryID:    industryID,
+		CompanyTypeID: companyTypeID,
+		OwnerID:       userID,
+		Timezone:      companyEntity.DefaultTimezone,
+		Locale:        companyEntity.DefaultLocale,
+		Currency:      companyEntity.DefaultCurrency,
+		IsActive:      true,
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.companyRepo.Create(ctx, c); err != nil {
+			return fmt.Errorf("failed to create company: %w", err)
+		}
+		if err := uc.memberRepo.Create(ctx, &companyEntity.UserCompany{
+			ID:        memberID,
+			UserID:    userID,
+			CompanyID: c.ID,
+			RoleID:    ownerRole.ID,
+			IsActive:  true,
+			JoinedAt:  time.Now(),
+		}); err != nil {
+			return fmt.Errorf("failed to create owner membership: %w", err)
+		}
+		return uc.trials.StartTrial(ctx, c.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditor.Record(ctx, audit.Entry{
+		CompanyID: c.ID,
+		Module:    audit.ModuleGeneral,
+		Entity:    "companies",
+		EntityID:  c.ID,
+		Action:    audit.ActionCreate,
+		New:       c,
+	})
+
+	return c, nil
+}
+
+func (uc *UseCase) ListMine(ctx context.Context, userID int64) ([]companyEntity.Company, error) {
+	return uc.companyRepo.ListByUserID(ctx, userID)
+}
+
+func (uc *UseCase) GetByID(ctx context.Context, id int64) (*companyEntity.Company, error) {
+	return uc.companyRepo.FindByID(ctx, id)
+}
+
+func (uc *UseCase) ListRoles(ctx context.Context, companyID int64) ([]rbac.Role, error) {
+	return uc.roleRepo.ListForCompany(ctx, companyID)
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+// activeIndustry resolves an optional industry ID, which must name an
+// active industry.
+func (uc *UseCase) activeIndustry(ctx context.Context, raw *string) (*int64, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+	id, err := strconv.ParseInt(*raw, 10, 64)
+	if err != nil {
+		return nil, errors.New("industry not available")
+	}
+	i, err := uc.industryRepo.FindByID(ctx, id)
+	if err != nil || !i.IsActive {
+		return nil, errors.New("industry not available")
+	}
+	return &i.ID, nil
+}
+
+// activeCompanyType resolves an optional company type ID, which must name an
+// active company type.
+func (uc *UseCase) activeCompanyType(ctx context.Context, raw *string) (*int64, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+	id, err := strconv.ParseInt(*raw, 10, 64)
+	if err != nil {
+		return nil, errors.New("company type not available")
+	}
+	t, err := uc.companyTypeRepo.FindByID(ctx, id)
+	if err != nil || !t.IsActive {
+		return nil, errors.New("company type not available")
+	}
+	return &t.ID, nil
+}
@@ -0,0 +1,501 @@
+package document
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/entity/rbac"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/asynq/tasks"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/i18n"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	"github.com/haily-id/engine/internal/pkg/notify"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+	"github.com/haily-id/engine/internal/pkg/storage"
+	"github.com/hibiken/asynq"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	dateLayout      = "2006-01-02"
+
+	// reminderHorizon bounds the reminder query; it covers the largest
+	// number of days the setting accepts.
+	reminderHorizon = 366 * 24 * time.Hour
+)
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+type ListRequest struct {
+	EmployeeID     string `query:"employee_id"     validate:"omitempty,numeric"`
+	Type           string `query:"type"            validate:"omitempty,oneof=KTP NPWP CONTRACT DEGREE CERTIFICATE OTHER"`
+	Status         string `query:"status"          validate:"omitempty,oneof=PENDING VERIFIED REJECTED"`
+	ExpiringBefore string `query:"expiring_before" validate:"omitempty,datetime=2006-01-02"`
+	Offset         int    `query:"offset"          validate:"min=0"`
+	Limit          int    `query:"limit"           validate:"min=0,max=100"`
+}
+
+// UploadRequest carries the form fields sent with the file. Name defaults
+// to the file name.
+type UploadRequest struct {
+	Type       string `form:"type"        validate:"required,oneof=KTP NPWP CONTRACT DEGREE CERTIFICATE OTHER"`
+	Name       string `form:"name"        validate:"omitempty,max=255"`
+	ValidFrom  string `form:"valid_from"  validate:"omitempty,datetime=2006-01-02"`
+	ValidUntil string `form:"valid_until" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type RejectRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+// File is an uploaded file as received from the client.
+type File struct {
+	Name    string
+	Size    int64
+	Content io.Reader
+}
+
+// ─── Results ────────────────────────────────────────────────────
+
+type Page struct {
+	Documents []employeeEntity.Document
+	Total     int64
+	Offset    int
+	Limit     int
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	documentRepo repository.EmployeeDocumentRepository
+	employeeRepo repository.EmployeeRepository
+	companyRepo  repository.CompanyRepository
+	memberRepo   repository.UserCompanyRepository
+	userRepo     repository.UserRepository
+	storage      storage.Storage
+	limits       Limits
+	settings     Settings
+	asynqClient  interface {
+		Enqueue(task *asynq.Task, opts ...asynq.Option) error
+	}
+	notifier notify.Notifier
+	auditor  audit.Recorder
+}
+
+// Limits keeps uploaded files within the plan's storage quota.
+type Limits interface {
+	ReserveStorage(ctx context.Context, companyID, size int64) error
+	ReleaseStorage(ctx context.Context, companyID, size int64) error
+}
+
+// Settings reads company settings, here the expiry reminder lead time.
+type Settings interface {
+	Int(ctx context.Context, companyID int64, key string) (int64, error)
+}
+
+func NewUseCase(
+	documentRepo repository.EmployeeDocumentRepository,
+	employeeRepo repository.EmployeeRepository,
+	companyRepo repository.CompanyRepository,
+	memberRepo repository.UserCompanyRepository,
+	userRepo repository.UserRepository,
+	storage storage.Storage,
+	limits Limits,
+	settings Settings,
+	asynqClient interface {
+		Enqueue(task *asynq.Task, opts ...asynq.Option) error
+	},
+	notifier notify.Notifier,
+	auditor audit.Recorder,
+) *UseCase {
+	return &UseCase{
+		documentRepo: documentRepo,
+		employeeRepo: employeeRepo,
+		companyRepo:  companyRepo,
+		memberRepo:   memberRepo,
+		userRepo:     userRepo,
+		storage:      storage,
+		limits:       limits,
+		settings:     settings,
+		asynqClient:  asynqClient,
+		notifier:     notifier,
+		auditor:      auditor,
+	}
+}
+
+func (uc *UseCase) List(ctx context.Context, companyID int64, req ListRequest) (*Page, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	f := repository.EmployeeDocumentFilter{
+		CompanyID: companyID,
+		Type:      req.Type,
+		Status:    req.Status,
+	}
+	f.EmployeeID, _ = strconv.ParseInt(req.EmployeeID, 10, 64)
+	if t, err := time.Parse(dateLayout, req.ExpiringBefore); err == nil {
+		f.ExpiringBefore = &t
+	}
+
+	list, total, err := uc.documentRepo.List(ctx, f, req.Offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &Page{Documents: list, Total: total, Offset: req.Offset, Limit: limit}, nil
+}
+
+// ListByEmployee lists one employee's documents.
+func (uc *UseCase) ListByEmployee(ctx context.Context, companyID, employeeID int64, req ListRequest) (*Page, error) {
+	if _, err := uc.findEmployee(ctx, companyID, employeeID); err != nil {
+		return nil, err
+	}
+	req.EmployeeID = strconv.FormatInt(employeeID, 10)
+	return uc.List(ctx, companyID, req)
+}
+
+func (uc *UseCase) Get(ctx context.Context, companyID, id int64) (*employeeEntity.Document, error) {
+	return uc.documentRepo.FindByID(ctx, companyID, id)
+}
+
+// Upload stores a PDF, JPEG or PNG file for the employee against the
+// company's storage quota. The document awaits verification.
+func (uc *UseCase) Upload(ctx context.Context, companyID, employeeID int64, req UploadRequest, file File) (*employeeEntity.Document, error) {
+	if _, err := uc.findEmployee(ctx, companyID, employeeID); err != nil {
+		return nil, err
+	}
+	if file.Size <= 0 {
+		return nil, errors.New("file is empty")
+	}
+	if file.Size > employeeEntity.MaxDocumentSize {
+		return nil, errors.New("file too large")
+	}
+
+	validFrom := parseDate(req.ValidFrom)
+	validUntil := parseDate(req.ValidUntil)
+	if validFrom != nil && validUntil != nil && validUntil.Before(*validFrom) {
+		return nil, errors.New("valid until before valid from")
+	}
+
+	// Sniff the content rather than trusting the client's content type.
+	content := bufio.NewReaderSize(file.Content, 512)
+	head, err := content.Peek(512)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	contentType, _, _ := strings.Cut(http.DetectContentType(head), ";")
+	ext, ok := employeeEntity.DocumentContentTypes[contentType]
+	if !ok {
+		return nil, errors.New("unsupported file type")
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = file.Name
+	}
+	d := &employeeEntity.Document{
+		ID:          id,
+		CompanyID:   companyID,
+		EmployeeID:  employeeID,
+		StorageKey:  fmt.Sprintf("documents/%d/%d/%d%s", companyID, employeeID, id, ext),
+		Type:        req.Type,
+		Name:        truncate(name, 255),
+		FileName:    truncate(file.Name, 255),
+		ContentType: contentType,
+		Size:        file.Size,
+		ValidFrom:   validFrom,
+		ValidUntil:  validUntil,
+		Status:      employeeEntity.DocumentStatusPending,
+	}
+
+	if err := uc.limits.ReserveStorage(ctx, companyID, d.Size); err != nil {
+		return nil, err
+	}
+	if err := uc.storage.Put(ctx, d.StorageKey, io.LimitReader(content, d.Size)); err != nil {
+		uc.release(ctx, companyID, d.Size)
+		return nil, fmt.Errorf("failed to store document: %w", err)
+	}
+	if err := uc.documentRepo.Create(ctx, d); err != nil {
+		uc.release(ctx, companyID, d.Size)
+		if err := uc.storage.Delete(ctx, d.StorageKey); err != nil {
+			logger.Errorf("Failed to remove orphaned document %s: %v", d.StorageKey, err)
+		}
+		return nil, fmt.Errorf("failed to create document: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "employee_documents", d.ID, audit.ActionCreate, nil, d)
+	return d, nil
+}
+
+// Open returns a document with its file. The caller closes it.
+func (uc *UseCase) Open(ctx context.Context, companyID, id int64) (*employeeEntity.Document, io.ReadCloser, error) {
+	d, err := uc.documentRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r, err := uc.storage.Open(ctx, d.StorageKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil, errors.New("document file not found")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open document: %w", err)
+	}
+	return d, r, nil
+}
+
+// Verify marks the document as checked by HR.
+func (uc *UseCase) Verify(ctx context.Context, companyID, id, reviewerID int64) (*employeeEntity.Document, error) {
+	d, err := uc.documentRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+	if d.Status == employeeEntity.DocumentStatusVerified {
+		return nil, errors.New("document already verified")
+	}
+
+	before := *d
+	now := time.Now()
+	d.Status = employeeEntity.DocumentStatusVerified
+	d.IsVerified = true
+	d.VerifiedAt = &now
+	d.VerifiedBy = &reviewerID
+	d.RejectionReason = nil
+	if err := uc.documentRepo.Update(ctx, d); err != nil {
+		return nil, fmt.Errorf("failed to update document: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "employee_documents", d.ID, audit.ActionUpdate, before, d)
+	return d, nil
+}
+
+// Reject marks the document as unacceptable, e.g. illegible or for the
+// wrong person. A verified document may still be rejected later.
+func (uc *UseCase) Reject(ctx context.Context, companyID, id, reviewerID int64, req RejectRequest) (*employeeEntity.Document, error) {
+	d, err := uc.documentRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+	if d.Status == employeeEntity.DocumentStatusRejected {
+		return nil, errors.New("document already rejected")
+	}
+
+	before := *d
+	now := time.Now()
+	reason := strings.TrimSpace(req.Reason)
+	d.Status = employeeEntity.DocumentStatusRejected
+	d.IsVerified = false
+	d.VerifiedAt = &now
+	d.VerifiedBy = &reviewerID
+	d.RejectionReason = &reason
+	if err := uc.documentRepo.Update(ctx, d); err != nil {
+		return nil, fmt.Errorf("failed to update document: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "employee_documents", d.ID, audit.ActionUpdate, before, d)
+	return d, nil
+}
+
+// Delete removes the document and its file and gives the space back to the
+// company's quota.
+func (uc *UseCase) Delete(ctx context.Context, companyID, id int64) error {
+	d, err := uc.documentRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.documentRepo.Delete(ctx, d); err != nil {
+		return fmt.Errorf("failed to delete document: %w", err)
+	}
+	if err := uc.storage.Delete(ctx, d.StorageKey); err != nil {
+		logger.Errorf("Failed to remove document file %s: %v", d.StorageKey, err)
+	}
+	uc.release(ctx, companyID, d.Size)
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "employee_documents", d.ID, audit.ActionDelete, d, nil)
+	return nil
+}
+
+// RemindExpiring is run daily by the worker. It reminds the employee and
+// the company's owners and admins once about every document valid until a
+// date within the company's reminder lead time.
+func (uc *UseCase) RemindExpiring(ctx context.Context) error {
+	now := time.Now()
+	due, err := uc.documentRepo.ListDueForReminder(ctx, now.Add(-24*time.Hour), now.Add(reminderHorizon))
+	if err != nil {
+		return fmt.Errorf("failed to list expiring documents: %w", err)
+	}
+
+	byCompany := make(map[int64][]*employeeEntity.Document)
+	var companyIDs []int64
+	for i := range due {
+		d := &due[i]
+		if _, ok := byCompany[d.CompanyID]; !ok {
+			companyIDs = append(companyIDs, d.CompanyID)
+		}
+		byCompany[d.CompanyID] = append(byCompany[d.CompanyID], d)
+	}
+
+	var errs []error
+	for _, companyID := range companyIDs {
+		if err := uc.remindCompany(ctx, companyID, byCompany[companyID], now); err != nil {
+			errs = append(errs, fmt.Errorf("company %d: %w", companyID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func (uc *UseCase) remindCompany(ctx context.Context, companyID int64, docs []*employeeEntity.Document, now time.Time) error {
+	days, err := uc.settings.Int(ctx, companyID, employeeEntity.DocumentExpirySetting)
+	if err != nil {
+		return fmt.Errorf("failed to read reminder setting: %w", err)
+	}
+	if days <= 0 {
+		return nil
+	}
+	c, err := uc.companyRepo.FindByID(ctx, companyID)
+	if err != nil {
+		return fmt.Errorf("failed to load company: %w", err)
+	}
+	t := c.Today()
+	last := t.AddDate(0, 0, int(days))
+
+	var hr []recipient
+	for _, d := range docs {
+		if d.ValidUntil.Before(t) || d.ValidUntil.After(last) {
+			continue
+		}
+		e, err := uc.employeeRepo.FindByID(ctx, d.EmployeeID)
+		if err != nil {
+			logger.Errorf("Failed to load employee %d for document reminder: %v", d.EmployeeID, err)
+			continue
+		}
+
+		d.ReminderSentAt = &now
+		if err := uc.documentRepo.Update(ctx, d); err != nil {
+			logger.Errorf("Failed to mark reminder for document %d: %v", d.ID, err)
+			continue
+		}
+
+		if hr == nil {
+			hr = uc.hrRecipients(ctx, companyID)
+		}
+		uc.remind(ctx, c, e, d, recipient{UserID: e.UserID, Email: e.Email, Name: e.Name}, true)
+		for _, r := range hr {
+			if e.UserID != nil && r.UserID != nil && *e.UserID == *r.UserID {
+				continue
+			}
+			uc.remind(ctx, c, e, d, r, false)
+		}
+	}
+	return nil
+}
+
+type recipient struct {
+	UserID *int64
+	Email  string
+	Name   string
+}
+
+// hrRecipients are the company's active owners and admins.
+func (uc *UseCase) hrRecipients(ctx context.Context, companyID int64) []recipient {
+	members, err := uc.memberRepo.ListActiveByRoles(ctx, companyID, []string{rbac.RoleOwner, rbac.RoleAdmin})
+	if err != nil {
+		logger.Errorf("Failed to list admins of company %d for document reminder: %v", companyID, err)
+		return []recipient{}
+	}
+
+	list := make([]recipient, 0, len(members))
+	for _, m := range members {
+		u, err := uc.userRepo.FindByID(ctx, m.UserID)
+		if err != nil {
+			logger.Errorf("Failed to load user %d for document reminder: %v", m.UserID, err)
+			continue
+		}
+		list = append(list, recipient{UserID: &u.ID, Email: u.Email, Name: u.Name})
+	}
+	return list
+}
+
+// remind sends one reminder as an in-app notice, when the recipient has an
+// account, and by email. Failures are logged.
+func (uc *UseCase) remind(ctx context.Context, c *companyEntity.Company, e *employeeEntity.Employee, d *employeeEntity.Document, r recipient, self bool) {
+	lang := i18n.Detect(c.Locale)
+	if r.UserID != nil {
+		content := i18n.DocumentExpiryNotification(c.Name, e.Name, d.Name, *d.ValidUntil, self, lang)
+		uc.notifier.Notify(ctx, notify.Notice{
+			UserID:    *r.UserID,
+			CompanyID: c.ID,
+			Type:      notify.TypeDocumentExpiring,
+			Title:     content.Title,
+			Body:      content.Body,
+			Data: map[string]string{
+				"document_id": strconv.FormatInt(d.ID, 10),
+				"employee_id": strconv.FormatInt(e.ID, 10),
+			},
+		})
+	}
+
+	task, err := tasks.NewSendDocumentExpiryTask(r.Email, r.Name, c.Name, e.Name, d.Name, *d.ValidUntil, self, lang)
+	if err != nil {
+		logger.Errorf("Failed to create document expiry email task: %v", err)
+		return
+	}
+	if err := uc.asynqClient.Enqueue(task, asynq.Queue("default")); err != nil {
+		logger.Errorf("Failed to enqueue document expiry email task: %v", err)
+	}
+}
+
+func (uc *UseCase) findEmployee(ctx context.Context, companyID, id int64) (*employeeEntity.Employee, error) {
+	e, err := uc.employeeRepo.FindByID(ctx, id)
+	if err != nil || e.CompanyID != companyID {
+		return nil, errors.New("employee not found")
+	}
+	return e, nil
+}
+
+func (uc *UseCase) release(ctx context.Context, companyID, size int64) {
+	if err := uc.limits.ReleaseStorage(ctx, companyID, size); err != nil {
+		logger.Errorf("Failed to release %d bytes of storage for company %d: %v", size, companyID, err)
+	}
+}
+
+// parseDate reads a validated YYYY-MM-DD value; empty means none.
+func parseDate(s string) *time.Time {
+	if s == "" {
+		return nil
+	}
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
@@ -0,0 +1,270 @@
+package employee
+
+import (
+	"context"
+	"fmt"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+	"github.com/haily-id/engine/internal/usecase/region"
+)
+
+// ─── Address Requests ───────────────────────────────────────────
+
+// AddressRequest adds an address. The postal code defaults to the
+// village's. The first address of a type is always primary.
+type AddressRequest struct {
+	Type         string  `json:"type"          validate:"required,oneof=DOMICILE KTP HOMETOWN"`
+	AddressLine1 string  `json:"address_line1" validate:"required,max=500"`
+	AddressLine2 *string `json:"address_line2" validate:"omitempty,max=500"`
+	ProvinceID   int     `json:"province_id"   validate:"required,gt=0"`
+	CityID       int     `json:"city_id"       validate:"required,gt=0"`
+	DistrictID   int     `json:"district_id"   validate:"required,gt=0"`
+	VillageID    *int    `json:"village_id"    validate:"omitempty,gt=0"`
+	PostalCode   *string `json:"postal_code"   validate:"omitempty,numeric,len=5"`
+	IsPrimary    bool    `json:"is_primary"`
+}
+
+// UpdateAddressRequest replaces an address's location. The type cannot
+// change; add an address of the other type instead.
+type UpdateAddressRequest struct {
+	AddressLine1 string  `json:"address_line1" validate:"required,max=500"`
+	AddressLine2 *string `json:"address_line2" validate:"omitempty,max=500"`
+	ProvinceID   int     `json:"province_id"   validate:"required,gt=0"`
+	CityID       int     `json:"city_id"       validate:"required,gt=0"`
+	DistrictID   int     `json:"district_id"   validate:"required,gt=0"`
+	VillageID    *int    `json:"village_id"    validate:"omitempty,gt=0"`
+	PostalCode   *string `json:"postal_code"   validate:"omitempty,numeric,len=5"`
+}
+
+// ─── Addresses ──────────────────────────────────────────────────
+
+// Addresses lists the employee's addresses by type, the primary of each
+// type first.
+func (uc *UseCase) Addresses(ctx context.Context, companyID, employeeID int64) ([]employeeEntity.Address, error) {
+	if _, err := uc.find(ctx, companyID, employeeID); err != nil {
+		return nil, err
+	}
+	return uc.addressRepo.ListByEmployee(ctx, employeeID)
+}
+
+func (uc *UseCase) AddAddress(ctx context.Context, companyID, employeeID int64, req AddressRequest) (*employeeEntity.Address, error) {
+	postalCode, err := region.CheckChain(ctx, uc.regionRepo, req.ProvinceID, req.CityID, req.DistrictID, req.VillageID)
+	if err != nil {
+		return nil, err
+	}
+	if req.PostalCode != nil {
+		postalCode = req.PostalCode
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+	a := &employeeEntity.Address{
+		ID:           id,
+		EmployeeID:   employeeID,
+		Type:         req.Type,
+		AddressLine1: req.AddressLine1,
+		AddressLine2: optional(req.AddressLine2),
+		ProvinceID:   req.ProvinceID,
+		CityID:       req.CityID,
+		DistrictID:   req.DistrictID,
+		VillageID:    req.VillageID,
+		PostalCode:   postalCode,
+	}
+
+	var demoted []employeeEntity.Address
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.lockEmployee(ctx, companyID, employeeID); err != nil {
+			return err
+		}
+		addresses, err := uc.addressRepo.ListByEmployee(ctx, employeeID)
+		if err != nil {
+			return err
+		}
+
+		sameType := ofType(addresses, a.Type)
+		a.IsPrimary = req.IsPrimary || len(sameType) == 0
+		if a.IsPrimary {
+			if demoted, err = clearPrimary(ctx, "address", uc.addressRepo.Update, sameType, a.ID); err != nil {
+				return err
+			}
+		}
+		if err := uc.addressRepo.Create(ctx, a); err != nil {
+			return fmt.Errorf("failed to create address: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recordDemoted(ctx, companyID, demoted, uc.recordAddress)
+	uc.recordAddress(ctx, companyID, audit.ActionCreate, nil, a)
+	return uc.addressRepo.FindByID(ctx, employeeID, a.ID)
+}
+
+func (uc *UseCase) UpdateAddress(ctx context.Context, companyID, employeeID, id int64, req UpdateAddressRequest) (*employeeEntity.Address, error) {
+	postalCode, err := region.CheckChain(ctx, uc.regionRepo, req.ProvinceID, req.CityID, req.DistrictID, req.VillageID)
+	if err != nil {
+		return nil, err
+	}
+	if req.PostalCode != nil {
+		postalCode = req.PostalCode
+	}
+	if _, err := uc.find(ctx, companyID, employeeID); err != nil {
+		return nil, err
+	}
+
+	a, err := uc.addressRepo.FindByID(ctx, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *a
+
+	a.AddressLine1 = req.AddressLine1
+	a.AddressLine2 = optional(req.AddressLine2)
+	a.ProvinceID = req.ProvinceID
+	a.CityID = req.CityID
+	a.DistrictID = req.DistrictID
+	a.VillageID = req.VillageID
+	a.PostalCode = postalCode
+	if err := uc.addressRepo.Update(ctx, a); err != nil {
+		return nil, fmt.Errorf("failed to update address: %w", err)
+	}
+
+	uc.recordAddress(ctx, companyID, audit.ActionUpdate, &before, a)
+	return uc.addressRepo.FindByID(ctx, employeeID, id)
+}
+
+// SetPrimaryAddress makes the address the primary one of its type,
+// demoting the current one in the same transaction.
+func (uc *UseCase) SetPrimaryAddress(ctx context.Context, companyID, employeeID, id int64) (*employeeEntity.Address, error) {
+	var before, after employeeEntity.Address
+	var demoted []employeeEntity.Address
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.lockEmployee(ctx, companyID, employeeID); err != nil {
+			return err
+		}
+		a, err := uc.addressRepo.FindByID(ctx, employeeID, id)
+		if err != nil {
+			return err
+		}
+		before = *a
+		after = *a
+		if a.IsPrimary {
+			return nil
+		}
+
+		addresses, err := uc.addressRepo.ListByEmployee(ctx, employeeID)
+		if err != nil {
+			return err
+		}
+		if demoted, err = clearPrimary(ctx, "address", uc.addressRepo.Update, ofType(addresses, a.Type), a.ID); err != nil {
+			return err
+		}
+		a.IsPrimary = true
+		if err := uc.addressRepo.Update(ctx, a); err != nil {
+			return fmt.Errorf("failed to update address: %w", err)
+		}
+		after = *a
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !before.IsPrimary {
+		recordDemoted(ctx, companyID, demoted, uc.recordAddress)
+		uc.recordAddress(ctx, companyID, audit.ActionUpdate, &before, &after)
+	}
+	return &after, nil
+}
+
+// DeleteAddress removes the address. Deleting the primary address of a
+// type makes the oldest remaining one of that type primary.
+func (uc *UseCase) DeleteAddress(ctx context.Context, companyID, employeeID, id int64) error {
+	var deleted employeeEntity.Address
+	var promoted *employeeEntity.Address
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.lockEmployee(ctx, companyID, employeeID); err != nil {
+			return err
+		}
+		a, err := uc.addressRepo.FindByID(ctx, employeeID, id)
+		if err != nil {
+			return err
+		}
+		if err := uc.addressRepo.Delete(ctx, a); err != nil {
+			return fmt.Errorf("failed to delete address: %w", err)
+		}
+		deleted = *a
+		if !a.IsPrimary {
+			return nil
+		}
+
+		remaining, err := uc.addressRepo.ListByEmployee(ctx, employeeID)
+		if err != nil {
+			return err
+		}
+		for i := range remaining {
+			if remaining[i].Type != a.Type {
+				continue
+			}
+			next := remaining[i]
+			next.IsPrimary = true
+			if err := uc.addressRepo.Update(ctx, &next); err != nil {
+				return fmt.Errorf("failed to update address: %w", err)
+			}
+			promoted = &next
+			break
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.recordAddress(ctx, companyID, audit.ActionDelete, &deleted, nil)
+	if promoted != nil {
+		before := *promoted
+		before.IsPrimary = false
+		uc.recordAddress(ctx, companyID, audit.ActionUpdate, &before, promoted)
+	}
+	return nil
+}
+
+// ─── Address Helpers ────────────────────────────────────────────
+
+// recordAddress audits a change without the loaded regions, which only
+// repeat the region IDs.
+func (uc *UseCase) recordAddress(ctx context.Context, companyID int64, action string, before, after *employeeEntity.Address) {
+	var oldValue, newValue interface{}
+	id := int64(0)
+	if before != nil {
+		oldValue = withoutRegions(*before)
+		id = before.ID
+	}
+	if after != nil {
+		newValue = withoutRegions(*after)
+		id = after.ID
+	}
+	audit.RecordHR(ctx, uc.auditor, companyID, "employee_addresses", id, action, oldValue, newValue)
+}
+
+func withoutRegions(a employeeEntity.Address) employeeEntity.Address {
+	a.Province, a.City, a.District, a.Village = nil, nil, nil, nil
+	return a
+}
+
+// ofType returns the addresses of type t; each type has its own primary.
+func ofType(addresses []employeeEntity.Address, t string) []employeeEntity.Address {
+	var list []employeeEntity.Address
+	for _, a := range addresses {
+		if a.Type == t {
+			list = append(list, a)
+		}
+	}
+	return list
+}
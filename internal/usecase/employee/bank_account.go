@@ -0,0 +1,269 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+)
+
+// ─── Bank Account Requests ──────────────────────────────────────
+
+// BankAccountRequest adds an account. The bank name is taken from the
+// directory entry of BankCode. Spaces, dots and dashes in the account
+// number are ignored. The first account is always primary.
+type BankAccountRequest struct {
+	BankCode      string `json:"bank_code"      validate:"required,len=3,numeric"`
+	AccountNumber string `json:"account_number" validate:"required,max=40"`
+	AccountName   string `json:"account_name"   validate:"required,max=255"`
+	IsPrimary     bool   `json:"is_primary"`
+}
+
+type UpdateBankAccountRequest struct {
+	BankCode      string `json:"bank_code"      validate:"required,len=3,numeric"`
+	AccountNumber string `json:"account_number" validate:"required,max=40"`
+	AccountName   string `json:"account_name"   validate:"required,max=255"`
+}
+
+// ─── Bank Accounts ──────────────────────────────────────────────
+
+// BankAccounts lists the employee's accounts, the primary first.
+func (uc *UseCase) BankAccounts(ctx context.Context, companyID, employeeID int64) ([]employeeEntity.BankAccount, error) {
+	if _, err := uc.find(ctx, companyID, employeeID); err != nil {
+		return nil, err
+	}
+	return uc.bankAccountRepo.ListByEmployee(ctx, employeeID)
+}
+
+func (uc *UseCase) AddBankAccount(ctx context.Context, companyID, employeeID int64, req BankAccountRequest) (*employeeEntity.BankAccount, error) {
+	bank, number, err := bankAccountNumber(req.BankCode, req.AccountNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+	a := &employeeEntity.BankAccount{
+		ID:            id,
+		EmployeeID:    employeeID,
+		BankName:      bank.Name,
+		BankCode:      bank.Code,
+		AccountNumber: number,
+		AccountName:   strings.Join(strings.Fields(req.AccountName), " "),
+	}
+
+	var demoted []employeeEntity.BankAccount
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.lockEmployee(ctx, companyID, employeeID); err != nil {
+			return err
+		}
+		accounts, err := uc.bankAccountRepo.ListByEmployee(ctx, employeeID)
+		if err != nil {
+			return err
+		}
+		if err := checkDuplicateAccount(accounts, a); err != nil {
+			return err
+		}
+
+		a.IsPrimary = req.IsPrimary || len(accounts) == 0
+		if a.IsPrimary {
+			if demoted, err = clearPrimary(ctx, "bank account", uc.bankAccountRepo.Update, accounts, a.ID); err != nil {
+				return err
+			}
+		}
+		if err := uc.bankAccountRepo.Create(ctx, a); err != nil {
+			return fmt.Errorf("failed to create bank account: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recordDemoted(ctx, companyID, demoted, uc.recordBankAccount)
+	uc.recordBankAccount(ctx, companyID, audit.ActionCreate, nil, a)
+	return a, nil
+}
+
+func (uc *UseCase) UpdateBankAccount(ctx context.Context, companyID, employeeID, id int64, req UpdateBankAccountRequest) (*employeeEntity.BankAccount, error) {
+	bank, number, err := bankAccountNumber(req.BankCode, req.AccountNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	var before, after employeeEntity.BankAccount
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.lockEmployee(ctx, companyID, employeeID); err != nil {
+			return err
+		}
+		a, err := uc.bankAccountRepo.FindByID(ctx, employeeID, id)
+		if err != nil {
+			return err
+		}
+		before = *a
+
+		a.BankName = bank.Name
+		a.BankCode = bank.Code
+		a.AccountNumber = number
+		a.AccountName = strings.Join(strings.Fields(req.AccountName), " ")
+
+		accounts, err := uc.bankAccountRepo.ListByEmployee(ctx, employeeID)
+		if err != nil {
+			return err
+		}
+		if err := checkDuplicateAccount(accounts, a); err != nil {
+			return err
+		}
+		if err := uc.bankAccountRepo.Update(ctx, a); err != nil {
+			return fmt.Errorf("failed to update bank account: %w", err)
+		}
+		after = *a
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.recordBankAccount(ctx, companyID, audit.ActionUpdate, &before, &after)
+	return &after, nil
+}
+
+// SetPrimaryBankAccount makes the account primary, demoting the current
+// primary account in the same transaction.
+func (uc *UseCase) SetPrimaryBankAccount(ctx context.Context, companyID, employeeID, id int64) (*employeeEntity.BankAccount, error) {
+	var before, after employeeEntity.BankAccount
+	var demoted []employeeEntity.BankAccount
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.lockEmployee(ctx, companyID, employeeID); err != nil {
+			return err
+		}
+		a, err := uc.bankAccountRepo.FindByID(ctx, employeeID, id)
+		if err != nil {
+			return err
+		}
+		before = *a
+		after = *a
+		if a.IsPrimary {
+			return nil
+		}
+
+		accounts, err := uc.bankAccountRepo.ListByEmployee(ctx, employeeID)
+		if err != nil {
+			return err
+		}
+		if demoted, err = clearPrimary(ctx, "bank account", uc.bankAccountRepo.Update, accounts, a.ID); err != nil {
+			return err
+		}
+		a.IsPrimary = true
+		if err := uc.bankAccountRepo.Update(ctx, a); err != nil {
+			return fmt.Errorf("failed to update bank account: %w", err)
+		}
+		after = *a
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !before.IsPrimary {
+		recordDemoted(ctx, companyID, demoted, uc.recordBankAccount)
+		uc.recordBankAccount(ctx, companyID, audit.ActionUpdate, &before, &after)
+	}
+	return &after, nil
+}
+
+// DeleteBankAccount removes the account. Deleting the primary account makes
+// the oldest remaining one primary.
+func (uc *UseCase) DeleteBankAccount(ctx context.Context, companyID, employeeID, id int64) error {
+	var deleted employeeEntity.BankAccount
+	var promoted *employeeEntity.BankAccount
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.lockEmployee(ctx, companyID, employeeID); err != nil {
+			return err
+		}
+		a, err := uc.bankAccountRepo.FindByID(ctx, employeeID, id)
+		if err != nil {
+			return err
+		}
+		if err := uc.bankAccountRepo.Delete(ctx, a); err != nil {
+			return fmt.Errorf("failed to delete bank account: %w", err)
+		}
+		deleted = *a
+		if !a.IsPrimary {
+			return nil
+		}
+
+		remaining, err := uc.bankAccountRepo.ListByEmployee(ctx, employeeID)
+		if err != nil {
+			return err
+		}
+		if len(remaining) == 0 {
+			return nil
+		}
+		next := remaining[0]
+		next.IsPrimary = true
+		if err := uc.bankAccountRepo.Update(ctx, &next); err != nil {
+			return fmt.Errorf("failed to update bank account: %w", err)
+		}
+		promoted = &next
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.recordBankAccount(ctx, companyID, audit.ActionDelete, &deleted, nil)
+	if promoted != nil {
+		before := *promoted
+		before.IsPrimary = false
+		uc.recordBankAccount(ctx, companyID, audit.ActionUpdate, &before, promoted)
+	}
+	return nil
+}
+
+// ─── Bank Account Helpers ───────────────────────────────────────
+
+// recordBankAccount audits a change with account numbers masked, so the
+// audit log never exposes them.
+func (uc *UseCase) recordBankAccount(ctx context.Context, companyID int64, action string, before, after *employeeEntity.BankAccount) {
+	var oldValue, newValue interface{}
+	id := int64(0)
+	if before != nil {
+		oldValue = before.Masked()
+		id = before.ID
+	}
+	if after != nil {
+		newValue = after.Masked()
+		id = after.ID
+	}
+	audit.RecordHR(ctx, uc.auditor, companyID, "employee_bank_accounts", id, action, oldValue, newValue)
+}
+
+// bankAccountNumber looks the bank up in the directory and checks the
+// account number against its length rule.
+func bankAccountNumber(code, raw string) (employeeEntity.Bank, string, error) {
+	bank, ok := employeeEntity.FindBank(code)
+	if !ok {
+		return employeeEntity.Bank{}, "", errors.New("bank not found")
+	}
+	number := strings.NewReplacer(" ", "", "-", "", ".", "").Replace(raw)
+	if !bank.ValidAccountNumber(number) {
+		return employeeEntity.Bank{}, "", errors.New("invalid account number")
+	}
+	return bank, number, nil
+}
+
+func checkDuplicateAccount(accounts []employeeEntity.BankAccount, a *employeeEntity.BankAccount) error {
+	for _, other := range accounts {
+		if other.ID != a.ID && other.BankCode == a.BankCode && other.AccountNumber == a.AccountNumber {
+			return errors.New("bank account already exists")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+// ─── Dated Assignments ──────────────────────────────────────────
+
+// dated is a pointer to a position or work location assignment.
+type dated[T any] interface {
+	*T
+	employeeEntity.Dated
+}
+
+// datedRepo is the part of the position and work location repositories the
+// shared helpers use.
+type datedRepo[T any] interface {
+	ListByEmployee(ctx context.Context, employeeID int64) ([]T, error)
+	Update(ctx context.Context, r *T) error
+	Delete(ctx context.Context, r *T) error
+}
+
+// datedKind names one kind of assignment in the helpers' errors and its
+// audit entries.
+type datedKind struct {
+	name      string
+	entity    string
+	assigned  string
+	overlaps  string
+	noPrimary string
+	unchanged string
+}
+
+var (
+	positionKind = datedKind{
+		name:      "employee position",
+		entity:    "employee_positions",
+		assigned:  "position already assigned",
+		overlaps:  "primary position overlaps",
+		noPrimary: "no primary position",
+		unchanged: "position unchanged",
+	}
+	workLocationKind = datedKind{
+		name:      "work location",
+		entity:    "employee_work_locations",
+		assigned:  "work location already assigned",
+		overlaps:  "primary work location overlaps",
+		noPrimary: "no primary work location",
+		unchanged: "work location unchanged",
+	}
+)
+
+// checkOverlap rejects a second primary assignment on any date and the
+// same target held twice on any date. records may include r itself.
+func checkOverlap[T any, P dated[T]](kind datedKind, records []T, r P) error {
+	for i := range records {
+		other := P(&records[i])
+		if other.Key() == r.Key() || !other.Dates().Overlaps(r.Dates()) {
+			continue
+		}
+		if other.Target() == r.Target() {
+			return errors.New(kind.assigned)
+		}
+		if other.Primary() && r.Primary() {
+			return errors.New(kind.overlaps)
+		}
+	}
+	return nil
+}
+
+// succeed makes the new primary assignment r replace the one covering its
+// start date: that one ends the day before and hands r its planned end.
+// It returns the replaced assignment, changed but not saved, and a copy
+// of it as it was.
+func succeed[T any, P dated[T]](kind datedKind, records []T, r P) (P, T, error) {
+	var before T
+	start := r.Dates().StartDate
+	current := primaryOn[T, P](records, start)
+	if current == nil {
+		return nil, before, errors.New(kind.noPrimary)
+	}
+	if current.Target() == r.Target() {
+		return nil, before, errors.New(kind.unchanged)
+	}
+	if !current.Dates().StartDate.Before(start) {
+		return nil, before, errors.New("effective date not after current start")
+	}
+
+	before = *current
+	r.Dates().EndDate = current.Dates().EndDate
+	end := start.AddDate(0, 0, -1)
+	current.Dates().EndDate = &end
+	if err := checkOverlap(kind, records, r); err != nil {
+		return nil, before, err
+	}
+	return current, before, nil
+}
+
+// endAssignments closes the employee's assignments still open after end,
+// when they leave the company.
+func endAssignments[T any, P dated[T]](ctx context.Context, kind datedKind, repo datedRepo[T], employeeID int64, end time.Time) error {
+	records, err := repo.ListByEmployee(ctx, employeeID)
+	if err != nil {
+		return err
+	}
+	for i := range records {
+		r := &records[i]
+		d := P(r).Dates()
+		if d.EndDate != nil && !d.EndDate.After(end) {
+			continue
+		}
+		if d.StartDate.After(end) {
+			// Planned assignments that never started are dropped.
+			if err := repo.Delete(ctx, r); err != nil {
+				return fmt.Errorf("failed to delete %s: %w", kind.name, err)
+			}
+			continue
+		}
+		d.EndDate = &end
+		if err := repo.Update(ctx, r); err != nil {
+			return fmt.Errorf("failed to update %s: %w", kind.name, err)
+		}
+	}
+	return nil
+}
+
+func primaryOn[T any, P dated[T]](records []T, date time.Time) P {
+	for i := range records {
+		if r := P(&records[i]); r.Primary() && r.Dates().ActiveOn(date) {
+			return r
+		}
+	}
+	return nil
+}
+
+func activeOn[T any, P dated[T]](records []T, date time.Time) []T {
+	active := make([]T, 0, len(records))
+	for i := range records {
+		if P(&records[i]).Dates().ActiveOn(date) {
+			active = append(active, records[i])
+		}
+	}
+	return active
+}
@@ -0,0 +1,250 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+	"github.com/haily-id/engine/internal/pkg/validator"
+)
+
+// ─── Emergency Contact Requests ─────────────────────────────────
+
+// EmergencyContactRequest adds a contact. Phone numbers are stored in
+// E.164 form. The first contact is always primary.
+type EmergencyContactRequest struct {
+	Name             string  `json:"name"              validate:"required,min=2,max=255"`
+	Relationship     string  `json:"relationship"      validate:"required,oneof=SPOUSE PARENT CHILD SIBLING RELATIVE FRIEND OTHER"`
+	Phone            string  `json:"phone"             validate:"required,phone"`
+	AlternativePhone *string `json:"alternative_phone" validate:"omitempty,phone"`
+	IsPrimary        bool    `json:"is_primary"`
+}
+
+// UpdateEmergencyContactRequest replaces a contact's details; an empty or
+// missing alternative phone clears it.
+type UpdateEmergencyContactRequest struct {
+	Name             string  `json:"name"              validate:"required,min=2,max=255"`
+	Relationship     string  `json:"relationship"      validate:"required,oneof=SPOUSE PARENT CHILD SIBLING RELATIVE FRIEND OTHER"`
+	Phone            string  `json:"phone"             validate:"required,phone"`
+	AlternativePhone *string `json:"alternative_phone" validate:"omitempty,phone"`
+}
+
+// ─── Emergency Contacts ─────────────────────────────────────────
+
+// EmergencyContacts lists the employee's contacts, the primary first.
+func (uc *UseCase) EmergencyContacts(ctx context.Context, companyID, employeeID int64) ([]employeeEntity.EmergencyContact, error) {
+	if _, err := uc.find(ctx, companyID, employeeID); err != nil {
+		return nil, err
+	}
+	return uc.contactRepo.ListByEmployee(ctx, employeeID)
+}
+
+func (uc *UseCase) AddEmergencyContact(ctx context.Context, companyID, employeeID int64, req EmergencyContactRequest) (*employeeEntity.EmergencyContact, error) {
+	phone, alternative, err := contactPhones(req.Phone, req.AlternativePhone)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+	c := &employeeEntity.EmergencyContact{
+		ID:               id,
+		EmployeeID:       employeeID,
+		Name:             strings.Join(strings.Fields(req.Name), " "),
+		Relationship:     req.Relationship,
+		Phone:            phone,
+		AlternativePhone: alternative,
+	}
+
+	var demoted []employeeEntity.EmergencyContact
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.lockEmployee(ctx, companyID, employeeID); err != nil {
+			return err
+		}
+		contacts, err := uc.contactRepo.ListByEmployee(ctx, employeeID)
+		if err != nil {
+			return err
+		}
+
+		c.IsPrimary = req.IsPrimary || len(contacts) == 0
+		if c.IsPrimary {
+			if demoted, err = clearPrimary(ctx, "emergency contact", uc.contactRepo.Update, contacts, c.ID); err != nil {
+				return err
+			}
+		}
+		if err := uc.contactRepo.Create(ctx, c); err != nil {
+			return fmt.Errorf("failed to create emergency contact: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recordDemoted(ctx, companyID, demoted, uc.recordContact)
+	uc.recordContact(ctx, companyID, audit.ActionCreate, nil, c)
+	return c, nil
+}
+
+func (uc *UseCase) UpdateEmergencyContact(ctx context.Context, companyID, employeeID, id int64, req UpdateEmergencyContactRequest) (*employeeEntity.EmergencyContact, error) {
+	phone, alternative, err := contactPhones(req.Phone, req.AlternativePhone)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.find(ctx, companyID, employeeID); err != nil {
+		return nil, err
+	}
+
+	c, err := uc.contactRepo.FindByID(ctx, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *c
+
+	c.Name = strings.Join(strings.Fields(req.Name), " ")
+	c.Relationship = req.Relationship
+	c.Phone = phone
+	c.AlternativePhone = alternative
+	if err := uc.contactRepo.Update(ctx, c); err != nil {
+		return nil, fmt.Errorf("failed to update emergency contact: %w", err)
+	}
+
+	uc.recordContact(ctx, companyID, audit.ActionUpdate, &before, c)
+	return c, nil
+}
+
+// SetPrimaryEmergencyContact makes the contact primary, demoting the
+// current primary contact in the same transaction.
+func (uc *UseCase) SetPrimaryEmergencyContact(ctx context.Context, companyID, employeeID, id int64) (*employeeEntity.EmergencyContact, error) {
+	var before, after employeeEntity.EmergencyContact
+	var demoted []employeeEntity.EmergencyContact
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.lockEmployee(ctx, companyID, employeeID); err != nil {
+			return err
+		}
+		c, err := uc.contactRepo.FindByID(ctx, employeeID, id)
+		if err != nil {
+			return err
+		}
+		before = *c
+		after = *c
+		if c.IsPrimary {
+			return nil
+		}
+
+		contacts, err := uc.contactRepo.ListByEmployee(ctx, employeeID)
+		if err != nil {
+			return err
+		}
+		if demoted, err = clearPrimary(ctx, "emergency contact", uc.contactRepo.Update, contacts, c.ID); err != nil {
+			return err
+		}
+		c.IsPrimary = true
+		if err := uc.contactRepo.Update(ctx, c); err != nil {
+			return fmt.Errorf("failed to update emergency contact: %w", err)
+		}
+		after = *c
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !before.IsPrimary {
+		recordDemoted(ctx, companyID, demoted, uc.recordContact)
+		uc.recordContact(ctx, companyID, audit.ActionUpdate, &before, &after)
+	}
+	return &after, nil
+}
+
+// DeleteEmergencyContact removes the contact. Deleting the primary contact
+// makes the oldest remaining one primary.
+func (uc *UseCase) DeleteEmergencyContact(ctx context.Context, companyID, employeeID, id int64) error {
+	var deleted employeeEntity.EmergencyContact
+	var promoted *employeeEntity.EmergencyContact
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.lockEmployee(ctx, companyID, employeeID); err != nil {
+			return err
+		}
+		c, err := uc.contactRepo.FindByID(ctx, employeeID, id)
+		if err != nil {
+			return err
+		}
+		if err := uc.contactRepo.Delete(ctx, c); err != nil {
+			return fmt.Errorf("failed to delete emergency contact: %w", err)
+		}
+		deleted = *c
+		if !c.IsPrimary {
+			return nil
+		}
+
+		remaining, err := uc.contactRepo.ListByEmployee(ctx, employeeID)
+		if err != nil {
+			return err
+		}
+		if len(remaining) == 0 {
+			return nil
+		}
+		next := remaining[0]
+		next.IsPrimary = true
+		if err := uc.contactRepo.Update(ctx, &next); err != nil {
+			return fmt.Errorf("failed to update emergency contact: %w", err)
+		}
+		promoted = &next
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.recordContact(ctx, companyID, audit.ActionDelete, &deleted, nil)
+	if promoted != nil {
+		before := *promoted
+		before.IsPrimary = false
+		uc.recordContact(ctx, companyID, audit.ActionUpdate, &before, promoted)
+	}
+	return nil
+}
+
+// ─── Emergency Contact Helpers ──────────────────────────────────
+
+// recordContact audits a change with phone numbers masked.
+func (uc *UseCase) recordContact(ctx context.Context, companyID int64, action string, before, after *employeeEntity.EmergencyContact) {
+	var oldValue, newValue interface{}
+	id := int64(0)
+	if before != nil {
+		oldValue = before.Masked()
+		id = before.ID
+	}
+	if after != nil {
+		newValue = after.Masked()
+		id = after.ID
+	}
+	audit.RecordHR(ctx, uc.auditor, companyID, "employee_emergency_contacts", id, action, oldValue, newValue)
+}
+
+// contactPhones normalises a contact's phone numbers. An empty
+// alternative phone is none.
+func contactPhones(phone string, alternative *string) (string, *string, error) {
+	p, ok := validator.NormalizePhone(phone)
+	if !ok {
+		return "", nil, errors.New("invalid phone number")
+	}
+	if alternative == nil || strings.TrimSpace(*alternative) == "" {
+		return p, nil, nil
+	}
+	alt, ok := validator.NormalizePhone(*alternative)
+	if !ok {
+		return "", nil, errors.New("invalid phone number")
+	}
+	if alt == p {
+		return p, nil, nil
+	}
+	return p, &alt, nil
+}
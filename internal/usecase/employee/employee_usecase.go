@@ -0,0 +1,782 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+	"github.com/haily-id/engine/internal/pkg/validator"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	// numberAttempts bounds how many running numbers are skipped when
+	// generated numbers collide with ones entered by hand.
+	numberAttempts = 100
+
+	dateLayout = "2006-01-02"
+)
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+// CreateEmployeeRequest creates an employee without a user account; the
+// account is linked later through an invitation. The employee number is
+// generated from the company's pattern when left out.
+type CreateEmployeeRequest struct {
+	EmployeeNumber  *string `json:"employee_number"  validate:"omitempty,max=50"`
+	Name            string  `json:"name"             validate:"required,min=2,max=255"`
+	Email           string  `json:"email"            validate:"required,email,max=255"`
+	Phone           *string `json:"phone"            validate:"omitempty,max=50"`
+	Gender          *string `json:"gender"           validate:"omitempty,oneof=MALE FEMALE"`
+	DateOfBirth     *string `json:"date_of_birth"    validate:"omitempty,datetime=2006-01-02"`
+	PlaceOfBirth    *string `json:"place_of_birth"   validate:"omitempty,max=100"`
+	NationalID      *string `json:"national_id"      validate:"omitempty,nik"`
+	TaxID           *string `json:"tax_id"           validate:"omitempty,npwp"`
+	MaritalStatus   *string `json:"marital_status"   validate:"omitempty,oneof=SINGLE MARRIED DIVORCED WIDOWED"`
+	HireDate        *string `json:"hire_date"        validate:"omitempty,datetime=2006-01-02"`
+	EmploymentType  string  `json:"employment_type"  validate:"omitempty,oneof=FULL_TIME PART_TIME CONTRACT INTERN"`
+	DependentsCount int     `json:"dependents_count" validate:"min=0,max=20"`
+	DivisionID      *string `json:"division_id"      validate:"omitempty,numeric"`
+	DepartmentID    *string `json:"department_id"    validate:"omitempty,numeric"`
+}
+
+// UpdateEmployeeRequest changes master data only; the employment status
+// changes through the lifecycle actions. Empty strings clear optional
+// fields.
+type UpdateEmployeeRequest struct {
+	EmployeeNumber  *string `json:"employee_number"  validate:"omitempty,max=50"`
+	Name            *string `json:"name"             validate:"omitempty,min=2,max=255"`
+	Email           *string `json:"email"            validate:"omitempty,email,max=255"`
+	Phone           *string `json:"phone"            validate:"omitempty,max=50"`
+	Gender          *string `json:"gender"           validate:"omitempty,oneof=MALE FEMALE"`
+	DateOfBirth     *string `json:"date_of_birth"    validate:"omitempty,datetime=2006-01-02"`
+	PlaceOfBirth    *string `json:"place_of_birth"   validate:"omitempty,max=100"`
+	NationalID      *string `json:"national_id"      validate:"omitempty,nik"`
+	TaxID           *string `json:"tax_id"           validate:"omitempty,npwp"`
+	MaritalStatus   *string `json:"marital_status"   validate:"omitempty,oneof=SINGLE MARRIED DIVORCED WIDOWED"`
+	HireDate        *string `json:"hire_date"        validate:"omitempty,datetime=2006-01-02"`
+	EmploymentType  *string `json:"employment_type"  validate:"omitempty,oneof=FULL_TIME PART_TIME CONTRACT INTERN"`
+	DependentsCount *int    `json:"dependents_count" validate:"omitempty,min=0,max=20"`
+	DivisionID      *string `json:"division_id"      validate:"omitempty,numeric"`
+	DepartmentID    *string `json:"department_id"    validate:"omitempty,numeric"`
+}
+
+type ListRequest struct {
+	Search           string `query:"q"                 validate:"omitempty,max=100"`
+	NationalID       string `query:"national_id"       validate:"omitempty,nik"`
+	DivisionID       string `query:"division_id"       validate:"omitempty,numeric"`
+	DepartmentID     string `query:"department_id"     validate:"omitempty,numeric"`
+	EmploymentStatus string `query:"employment_status" validate:"omitempty,oneof=ACTIVE TERMINATED SUSPENDED ON_LEAVE"`
+	EmploymentType   string `query:"employment_type"   validate:"omitempty,oneof=FULL_TIME PART_TIME CONTRACT INTERN"`
+	Offset           int    `query:"offset"            validate:"min=0"`
+	Limit            int    `query:"limit"             validate:"min=0,max=100"`
+}
+
+// StatusChangeRequest is the body of every lifecycle action. The effective
+// date defaults to today in the company's timezone.
+type StatusChangeRequest struct {
+	EffectiveDate *string `json:"effective_date" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// ─── Results ────────────────────────────────────────────────────
+
+type Page struct {
+	Employees []employeeEntity.Employee
+	Total     int64
+	Offset    int
+	Limit     int
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	employeeRepo         repository.EmployeeRepository
+	invitationRepo       repository.EmployeeInvitationRepository
+	memberRepo           repository.UserCompanyRepository
+	companyRepo          repository.CompanyRepository
+	divisionRepo         repository.DivisionRepository
+	departmentRepo       repository.DepartmentRepository
+	positionRepo         repository.PositionRepository
+	employeePositionRepo repository.EmployeePositionRepository
+	bankAccountRepo      repository.EmployeeBankAccountRepository
+	contactRepo          repository.EmployeeEmergencyContactRepository
+	addressRepo          repository.EmployeeAddressRepository
+	companyAddressRepo   repository.CompanyAddressRepository
+	workLocationRepo     repository.WorkLocationRepository
+	regionRepo           repository.RegionRepository
+	transactor           repository.Transactor
+	limits               Limits
+	settings             Settings
+	auditor              audit.Recorder
+}
+
+// Limits enforces the plan's employee and member caps inside the
+// transaction that adds the row they guard.
+type Limits interface {
+	ReserveUserSeat(ctx context.Context, companyID int64) error
+	ReserveEmployee(ctx context.Context, companyID int64) error
+}
+
+// Settings reads company settings, here the employee number pattern.
+type Settings interface {
+	String(ctx context.Context, companyID int64, key string) (string, error)
+}
+
+func NewUseCase(
+	employeeRepo repository.EmployeeRepository,
+	invitationRepo repository.EmployeeInvitationRepository,
+	memberRepo repository.UserCompanyRepository,
+	companyRepo repository.CompanyRepository,
+	divisionRepo repository.DivisionRepository,
+	departmentRepo repository.DepartmentRepository,
+	positionRepo repository.PositionRepository,
+	employeePositionRepo repository.EmployeePositionRepository,
+	bankAccountRepo repository.EmployeeBankAccountRepository,
+	contactRepo repository.EmployeeEmergencyContactRepository,
+	addressRepo repository.EmployeeAddressRepository,
+	companyAddressRepo repository.CompanyAddressRepository,
+	workLocationRepo repository.WorkLocationRepository,
+	regionRepo repository.RegionRepository,
+	transactor repository.Transactor,
+	limits Limits,
+	settings Settings,
+	auditor audit.Recorder,
+) *UseCase {
+	return &UseCase{
+		employeeRepo:         employeeRepo,
+		invitationRepo:       invitationRepo,
+		memberRepo:           memberRepo,
+		companyRepo:          companyRepo,
+		divisionRepo:         divisionRepo,
+		departmentRepo:       departmentRepo,
+		positionRepo:         positionRepo,
+		employeePositionRepo: employeePositionRepo,
+		bankAccountRepo:      bankAccountRepo,
+		contactRepo:          contactRepo,
+		addressRepo:          addressRepo,
+		companyAddressRepo:   companyAddressRepo,
+		workLocationRepo:     workLocationRepo,
+		regionRepo:           regionRepo,
+		transactor:           transactor,
+		limits:               limits,
+		settings:             settings,
+		auditor:              auditor,
+	}
+}
+
+func (uc *UseCase) List(ctx context.Context, companyID int64, req ListRequest) (*Page, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	f := repository.EmployeeFilter{
+		CompanyID:        companyID,
+		Search:           strings.TrimSpace(req.Search),
+		EmploymentStatus: req.EmploymentStatus,
+		EmploymentType:   req.EmploymentType,
+		NationalID:       optional(&req.NationalID),
+	}
+	f.DivisionID, _ = strconv.ParseInt(req.DivisionID, 10, 64)
+	f.DepartmentID, _ = strconv.ParseInt(req.DepartmentID, 10, 64)
+
+	list, total, err := uc.employeeRepo.List(ctx, f, req.Offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &Page{Employees: list, Total: total, Offset: req.Offset, Limit: limit}, nil
+}
+
+func (uc *UseCase) Get(ctx context.Context, companyID, id int64) (*employeeEntity.Employee, error) {
+	return uc.find(ctx, companyID, id)
+}
+
+func (uc *UseCase) Create(ctx context.Context, companyID int64, req CreateEmployeeRequest) (*employeeEntity.Employee, error) {
+	c, err := uc.companyRepo.FindByID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load company: %w", err)
+	}
+	e, err := uc.prepare(ctx, companyID, req)
+	if err != nil {
+		return nil, err
+	}
+	if e.ID, err = snowflake.Generate(); err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.limits.ReserveEmployee(ctx, companyID); err != nil {
+			return err
+		}
+		if e.EmployeeNumber == nil {
+			n, err := uc.generateNumber(ctx, c)
+			if err != nil {
+				return err
+			}
+			e.EmployeeNumber = &n
+		}
+		if err := uc.employeeRepo.Create(ctx, e); err != nil {
+			return fmt.Errorf("failed to create employee: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.record(ctx, companyID, e.ID, audit.ActionCreate, nil, e)
+	return e, nil
+}
+
+// Check runs every check Create does short of the plan's employee limit,
+// without creating anything. Imports use it for their dry run.
+func (uc *UseCase) Check(ctx context.Context, companyID int64, req CreateEmployeeRequest) error {
+	_, err := uc.prepare(ctx, companyID, req)
+	return err
+}
+
+// prepare validates a new employee against the company's data and builds
+// it without an ID, and without an employee number when the request leaves
+// it to the company's pattern.
+func (uc *UseCase) prepare(ctx context.Context, companyID int64, req CreateEmployeeRequest) (*employeeEntity.Employee, error) {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if existing, _ := uc.employeeRepo.FindByCompanyAndEmail(ctx, companyID, email); existing != nil {
+		return nil, errors.New("employee email already exists")
+	}
+
+	number := optional(req.EmployeeNumber)
+	if number != nil {
+		if existing, _ := uc.employeeRepo.FindByCompanyAndNumber(ctx, companyID, *number); existing != nil {
+			return nil, errors.New("employee number already exists")
+		}
+	}
+
+	divisionID, departmentID, err := uc.placement(ctx, companyID, parseID(req.DivisionID), parseID(req.DepartmentID))
+	if err != nil {
+		return nil, err
+	}
+
+	employmentType := req.EmploymentType
+	if employmentType == "" {
+		employmentType = employeeEntity.EmploymentTypeFullTime
+	}
+
+	e := &employeeEntity.Employee{
+		CompanyID:        companyID,
+		DivisionID:       divisionID,
+		DepartmentID:     departmentID,
+		EmployeeNumber:   number,
+		Email:            email,
+		Name:             strings.TrimSpace(req.Name),
+		Phone:            optional(req.Phone),
+		Gender:           optional(req.Gender),
+		DateOfBirth:      parseDate(req.DateOfBirth),
+		PlaceOfBirth:     optional(req.PlaceOfBirth),
+		NationalID:       optional(req.NationalID),
+		TaxID:            validator.OptionalNPWP(req.TaxID),
+		MaritalStatus:    optional(req.MaritalStatus),
+		HireDate:         parseDate(req.HireDate),
+		EmploymentStatus: employeeEntity.EmploymentStatusActive,
+		EmploymentType:   employmentType,
+		DependentsCount:  req.DependentsCount,
+		IsActive:         true,
+	}
+	if err := uc.checkIdentity(ctx, e); err != nil {
+		return nil, err
+	}
+	if err := uc.checkNationalIDTaken(ctx, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (uc *UseCase) Update(ctx context.Context, companyID, id int64, req UpdateEmployeeRequest) (*employeeEntity.Employee, error) {
+	e, err := uc.find(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *e
+
+	if req.EmployeeNumber != nil {
+		number := optional(req.EmployeeNumber)
+		if number == nil {
+			return nil, errors.New("employee number required")
+		}
+		if e.EmployeeNumber == nil || *number != *e.EmployeeNumber {
+			if existing, _ := uc.employeeRepo.FindByCompanyAndNumber(ctx, companyID, *number); existing != nil {
+				return nil, errors.New("employee number already exists")
+			}
+		}
+		e.EmployeeNumber = number
+	}
+	if req.Email != nil {
+		email := strings.ToLower(strings.TrimSpace(*req.Email))
+		if email != e.Email {
+			if e.UserID != nil {
+				return nil, errors.New("employee already linked to a user")
+			}
+			if existing, _ := uc.employeeRepo.FindByCompanyAndEmail(ctx, companyID, email); existing != nil {
+				return nil, errors.New("employee email already exists")
+			}
+		}
+		e.Email = email
+	}
+	if req.Name != nil {
+		e.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Phone != nil {
+		e.Phone = optional(req.Phone)
+	}
+	if req.Gender != nil {
+		e.Gender = optional(req.Gender)
+	}
+	if req.DateOfBirth != nil {
+		e.DateOfBirth = parseDate(req.DateOfBirth)
+	}
+	if req.PlaceOfBirth != nil {
+		e.PlaceOfBirth = optional(req.PlaceOfBirth)
+	}
+	if req.NationalID != nil {
+		e.NationalID = optional(req.NationalID)
+		if err := uc.checkNationalIDTaken(ctx, e); err != nil {
+			return nil, err
+		}
+	}
+	if req.TaxID != nil {
+		e.TaxID = validator.OptionalNPWP(req.TaxID)
+	}
+	if req.MaritalStatus != nil {
+		e.MaritalStatus = optional(req.MaritalStatus)
+	}
+	if req.HireDate != nil {
+		e.HireDate = parseDate(req.HireDate)
+		if e.HireDate != nil && e.TerminationDate != nil && e.TerminationDate.Before(*e.HireDate) {
+			return nil, errors.New("termination date before hire date")
+		}
+	}
+	if req.EmploymentType != nil {
+		e.EmploymentType = *req.EmploymentType
+	}
+	if req.DependentsCount != nil {
+		e.DependentsCount = *req.DependentsCount
+	}
+
+	if req.NationalID != nil || req.TaxID != nil || req.DateOfBirth != nil || req.Gender != nil {
+		if err := uc.checkIdentity(ctx, e); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.DivisionID != nil || req.DepartmentID != nil {
+		divisionID, departmentID := e.DivisionID, e.DepartmentID
+		if req.DivisionID != nil {
+			divisionID = parseID(req.DivisionID)
+		}
+		if req.DepartmentID != nil {
+			departmentID = parseID(req.DepartmentID)
+		}
+		if e.DivisionID, e.DepartmentID, err = uc.placement(ctx, companyID, divisionID, departmentID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.employeeRepo.Update(ctx, e); err != nil {
+		return nil, fmt.Errorf("failed to update employee: %w", err)
+	}
+
+	uc.record(ctx, companyID, e.ID, audit.ActionUpdate, &before, e)
+	return e, nil
+}
+
+// Delete removes an employee who never got a user account, cancelling a
+// pending invitation. Employees with an account are terminated instead so
+// their history stays.
+func (uc *UseCase) Delete(ctx context.Context, companyID, id int64) error {
+	e, err := uc.find(ctx, companyID, id)
+	if err != nil {
+		return err
+	}
+	if e.UserID != nil {
+		return errors.New("employee already linked to a user")
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.cancelInvitation(ctx, e); err != nil {
+			return err
+		}
+		if err := uc.employeeRepo.Delete(ctx, e); err != nil {
+			return fmt.Errorf("failed to delete employee: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.record(ctx, companyID, e.ID, audit.ActionDelete, e, nil)
+	return nil
+}
+
+// ─── Lifecycle ──────────────────────────────────────────────────
+
+// Terminate ends the employment and open position assignments on the
+// effective date, revoking the linked user's membership and any pending
+// invitation.
+func (uc *UseCase) Terminate(ctx context.Context, companyID, id int64, req StatusChangeRequest) (*employeeEntity.Employee, error) {
+	return uc.transition(ctx, companyID, id, req, employeeEntity.EmploymentStatusTerminated,
+		employeeEntity.EmploymentStatusActive,
+		employeeEntity.EmploymentStatusOnLeave,
+		employeeEntity.EmploymentStatusSuspended,
+	)
+}
+
+// Suspend revokes the linked user's membership until reactivation.
+func (uc *UseCase) Suspend(ctx context.Context, companyID, id int64, req StatusChangeRequest) (*employeeEntity.Employee, error) {
+	return uc.transition(ctx, companyID, id, req, employeeEntity.EmploymentStatusSuspended,
+		employeeEntity.EmploymentStatusActive,
+		employeeEntity.EmploymentStatusOnLeave,
+	)
+}
+
+// PutOnLeave marks an active employee as on leave. The linked user keeps
+// access to the company.
+func (uc *UseCase) PutOnLeave(ctx context.Context, companyID, id int64, req StatusChangeRequest) (*employeeEntity.Employee, error) {
+	return uc.transition(ctx, companyID, id, req, employeeEntity.EmploymentStatusOnLeave,
+		employeeEntity.EmploymentStatusActive,
+	)
+}
+
+// Reactivate returns an employee to ACTIVE and restores the linked user's
+// membership. Rehiring a terminated employee clears the termination date
+// and counts against the plan's employee limit again.
+func (uc *UseCase) Reactivate(ctx context.Context, companyID, id int64, req StatusChangeRequest) (*employeeEntity.Employee, error) {
+	return uc.transition(ctx, companyID, id, req, employeeEntity.EmploymentStatusActive,
+		employeeEntity.EmploymentStatusOnLeave,
+		employeeEntity.EmploymentStatusSuspended,
+		employeeEntity.EmploymentStatusTerminated,
+	)
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func (uc *UseCase) find(ctx context.Context, companyID, id int64) (*employeeEntity.Employee, error) {
+	e, err := uc.employeeRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if e.CompanyID != companyID {
+		return nil, errors.New("employee not found")
+	}
+	return e, nil
+}
+
+// transition moves an employee from one of the from statuses to status,
+// stamping the effective date and syncing the linked user's membership in
+// the same transaction.
+func (uc *UseCase) transition(ctx context.Context, companyID, id int64, req StatusChangeRequest, status string, from ...string) (*employeeEntity.Employee, error) {
+	c, err := uc.companyRepo.FindByID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load company: %w", err)
+	}
+
+	e, err := uc.find(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !contains(from, e.EmploymentStatus) {
+		return nil, errors.New("invalid status transition")
+	}
+	if e.UserID != nil && *e.UserID == c.OwnerID && status != employeeEntity.EmploymentStatusActive && status != employeeEntity.EmploymentStatusOnLeave {
+		return nil, errors.New("cannot change owner status")
+	}
+
+	effective := parseDate(req.EffectiveDate)
+	if effective == nil {
+		t := c.Today()
+		effective = &t
+	}
+	if status == employeeEntity.EmploymentStatusTerminated && e.HireDate != nil && effective.Before(*e.HireDate) {
+		return nil, errors.New("termination date before hire date")
+	}
+
+	before := *e
+	wasTerminated := e.EmploymentStatus == employeeEntity.EmploymentStatusTerminated
+
+	e.EmploymentStatus = status
+	e.StatusChangedOn = effective
+	e.IsActive = e.HasAccess()
+	switch {
+	case status == employeeEntity.EmploymentStatusTerminated:
+		e.TerminationDate = effective
+	case wasTerminated:
+		e.TerminationDate = nil
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if wasTerminated {
+			if err := uc.limits.ReserveEmployee(ctx, companyID); err != nil {
+				return err
+			}
+		}
+		if err := uc.syncMembership(ctx, e); err != nil {
+			return err
+		}
+		if status == employeeEntity.EmploymentStatusTerminated {
+			if err := uc.cancelInvitation(ctx, e); err != nil {
+				return err
+			}
+			if err := endAssignments[employeeEntity.Position](ctx, positionKind, uc.employeePositionRepo, e.ID, *effective); err != nil {
+				return err
+			}
+			if err := endAssignments[employeeEntity.WorkLocation](ctx, workLocationKind, uc.workLocationRepo, e.ID, *effective); err != nil {
+				return err
+			}
+		}
+		if err := uc.employeeRepo.Update(ctx, e); err != nil {
+			return fmt.Errorf("failed to update employee: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.record(ctx, companyID, e.ID, audit.ActionUpdate, &before, e)
+	return e, nil
+}
+
+// syncMembership activates or deactivates the linked user's membership to
+// match the employee's status. Restoring a membership takes a user seat.
+func (uc *UseCase) syncMembership(ctx context.Context, e *employeeEntity.Employee) error {
+	if e.UserID == nil {
+		return nil
+	}
+	m, err := uc.memberRepo.FindByUserAndCompany(ctx, *e.UserID, e.CompanyID)
+	if err != nil {
+		// The user left the company; there is nothing to sync.
+		return nil
+	}
+	if m.IsActive == e.HasAccess() {
+		return nil
+	}
+
+	if e.HasAccess() {
+		if err := uc.limits.ReserveUserSeat(ctx, e.CompanyID); err != nil {
+			return err
+		}
+	}
+	m.IsActive = e.HasAccess()
+	if err := uc.memberRepo.Update(ctx, m); err != nil {
+		return fmt.Errorf("failed to update membership: %w", err)
+	}
+	return nil
+}
+
+func (uc *UseCase) cancelInvitation(ctx context.Context, e *employeeEntity.Employee) error {
+	inv, _ := uc.invitationRepo.FindPendingByCompanyAndEmail(ctx, e.CompanyID, e.Email)
+	if inv == nil || inv.EmployeeID != e.ID {
+		return nil
+	}
+	inv.Status = employeeEntity.InvitationStatusCancelled
+	if err := uc.invitationRepo.Update(ctx, inv); err != nil {
+		return fmt.Errorf("failed to cancel invitation: %w", err)
+	}
+	return nil
+}
+
+// generateNumber draws numbers from the company's pattern until one is
+// free. Numbers entered by hand may already use a running number.
+func (uc *UseCase) generateNumber(ctx context.Context, c *companyEntity.Company) (string, error) {
+	pattern, err := uc.settings.String(ctx, c.ID, employeeEntity.NumberPatternSetting)
+	if err != nil {
+		return "", fmt.Errorf("failed to load number pattern: %w", err)
+	}
+	scope := employeeEntity.NumberScope(pattern, c.Code, c.Today())
+
+	for i := 0; i < numberAttempts; i++ {
+		n, err := uc.employeeRepo.NextNumber(ctx, c.ID, scope)
+		if err != nil {
+			return "", fmt.Errorf("failed to reserve employee number: %w", err)
+		}
+		number := employeeEntity.FormatNumber(scope, n)
+		if existing, _ := uc.employeeRepo.FindByCompanyAndNumber(ctx, c.ID, number); existing == nil {
+			return number, nil
+		}
+	}
+	return "", errors.New("employee number not available")
+}
+
+// checkIdentity cross-checks the NIK against the regional tables and the
+// employee's birth date and gender, filling those in when they are
+// missing. A 16-digit NPWP that is not 0-prefixed is an individual's NIK
+// and must equal the national ID.
+func (uc *UseCase) checkIdentity(ctx context.Context, e *employeeEntity.Employee) error {
+	if e.NationalID != nil {
+		nik, err := validator.ParseNIK(*e.NationalID)
+		if err != nil {
+			return errors.New("invalid national id")
+		}
+		if err := uc.checkNIKRegion(ctx, nik); err != nil {
+			return err
+		}
+
+		if e.DateOfBirth == nil {
+			birth := nik.BirthDate
+			e.DateOfBirth = &birth
+		} else if !sameBirthDate(*e.DateOfBirth, nik.BirthDate) {
+			return errors.New("national id birth date mismatch")
+		}
+
+		gender := employeeEntity.GenderMale
+		if nik.Female {
+			gender = employeeEntity.GenderFemale
+		}
+		if e.Gender == nil {
+			e.Gender = &gender
+		} else if *e.Gender != gender {
+			return errors.New("national id gender mismatch")
+		}
+	}
+
+	if e.TaxID != nil && len(*e.TaxID) == 16 && (*e.TaxID)[0] != '0' {
+		if e.NationalID != nil && *e.NationalID != *e.TaxID {
+			return errors.New("tax id does not match national id")
+		}
+	}
+	return nil
+}
+
+// checkNIKRegion looks the NIK's district up in the regional tables; a
+// district that is not there fails validation.
+func (uc *UseCase) checkNIKRegion(ctx context.Context, nik *validator.NIK) error {
+	_, err := uc.regionRepo.FindDistrictByFullCode(ctx, nik.DistrictCode)
+	if err == nil {
+		return nil
+	}
+	if err.Error() == "district not found" {
+		return errors.New("national id region not found")
+	}
+	return fmt.Errorf("failed to load district: %w", err)
+}
+
+// checkNationalIDTaken rejects a NIK another employee of the company
+// already has.
+func (uc *UseCase) checkNationalIDTaken(ctx context.Context, e *employeeEntity.Employee) error {
+	if e.NationalID == nil {
+		return nil
+	}
+	existing, _ := uc.employeeRepo.FindByCompanyAndNationalID(ctx, e.CompanyID, *e.NationalID)
+	if existing != nil && existing.ID != e.ID {
+		return errors.New("national id already exists")
+	}
+	return nil
+}
+
+// placement resolves the division and department of an employee. Both
+// must be active and belong to the company; a department inside a division
+// sets the division, and a different division is rejected.
+func (uc *UseCase) placement(ctx context.Context, companyID int64, divisionID, departmentID *int64) (*int64, *int64, error) {
+	if departmentID != nil {
+		d, err := uc.departmentRepo.FindByID(ctx, companyID, *departmentID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !d.IsActive {
+			return nil, nil, errors.New("department not available")
+		}
+		if d.DivisionID != nil {
+			if divisionID != nil && *divisionID != *d.DivisionID {
+				return nil, nil, errors.New("department not in division")
+			}
+			divisionID = d.DivisionID
+		}
+	}
+
+	if divisionID != nil {
+		d, err := uc.divisionRepo.FindByID(ctx, companyID, *divisionID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !d.IsActive {
+			return nil, nil, errors.New("division not available")
+		}
+	}
+	return divisionID, departmentID, nil
+}
+
+// record audits a change with identity numbers and phone masked, so the
+// audit log never exposes them.
+func (uc *UseCase) record(ctx context.Context, companyID, id int64, action string, before, after *employeeEntity.Employee) {
+	var oldValue, newValue interface{}
+	if before != nil {
+		oldValue = before.Masked()
+	}
+	if after != nil {
+		newValue = after.Masked()
+	}
+	audit.RecordHR(ctx, uc.auditor, companyID, "employees", id, action, oldValue, newValue)
+}
+
+// parseDate reads a validated YYYY-MM-DD value; nil and empty mean none.
+func parseDate(s *string) *time.Time {
+	if s == nil || *s == "" {
+		return nil
+	}
+	t, err := time.Parse(dateLayout, *s)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// parseID reads a validated numeric ID; nil and empty mean none.
+func parseID(s *string) *int64 {
+	if s == nil || *s == "" {
+		return nil
+	}
+	id, err := strconv.ParseInt(*s, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
+
+// sameBirthDate compares a birth date with one decoded from a NIK, which
+// only carries a two-digit year.
+func sameBirthDate(a, nik time.Time) bool {
+	return a.Day() == nik.Day() && a.Month() == nik.Month() && a.Year()%100 == nik.Year()%100
+}
+
+// optional trims s and turns empty values into nil.
+func optional(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := strings.TrimSpace(*s)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
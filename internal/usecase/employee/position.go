@@ -0,0 +1,480 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	orgEntity "github.com/haily-id/engine/internal/domain/entity/organization"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+)
+
+// ─── Position Requests ──────────────────────────────────────────
+
+// AssignPositionRequest adds an assignment directly, e.g. a first position
+// or a concurrent secondary one.
+type AssignPositionRequest struct {
+	PositionID string  `json:"position_id" validate:"required,numeric"`
+	StartDate  string  `json:"start_date"  validate:"required,datetime=2006-01-02"`
+	EndDate    *string `json:"end_date"    validate:"omitempty,datetime=2006-01-02"`
+	IsPrimary  bool    `json:"is_primary"`
+	Reason     *string `json:"reason"      validate:"omitempty,max=500"`
+}
+
+// PositionChangeRequest moves the primary assignment to another position
+// from the effective date, which defaults to today in the company's
+// timezone.
+type PositionChangeRequest struct {
+	PositionID    string  `json:"position_id"    validate:"required,numeric"`
+	EffectiveDate *string `json:"effective_date" validate:"omitempty,datetime=2006-01-02"`
+	Reason        *string `json:"reason"         validate:"omitempty,max=500"`
+}
+
+// EndPositionRequest sets the last day of an assignment.
+type EndPositionRequest struct {
+	EndDate string `json:"end_date" validate:"required,datetime=2006-01-02"`
+}
+
+type AsOfRequest struct {
+	AsOf string `query:"as_of" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type CompanyAssignmentsRequest struct {
+	AsOf         string `query:"as_of"         validate:"omitempty,datetime=2006-01-02"`
+	DepartmentID string `query:"department_id" validate:"omitempty,numeric"`
+	PrimaryOnly  bool   `query:"primary_only"`
+}
+
+// ─── Position Results ───────────────────────────────────────────
+
+// Assignment is an assignment with its position, nil once the position is
+// deleted, and its employee where the caller asked for it.
+type Assignment struct {
+	Record   employeeEntity.Position
+	Position *orgEntity.Position
+	Employee *employeeEntity.Employee
+}
+
+// ─── Positions ──────────────────────────────────────────────────
+
+// Timeline returns an employee's assignments oldest first, only those
+// covering asOf when it is set.
+func (uc *UseCase) Timeline(ctx context.Context, companyID, employeeID int64, req AsOfRequest) ([]Assignment, error) {
+	if _, err := uc.find(ctx, companyID, employeeID); err != nil {
+		return nil, err
+	}
+
+	records, err := uc.employeePositionRepo.ListByEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if asOf := parseDate(&req.AsOf); asOf != nil {
+		records = activeOn(records, *asOf)
+	}
+
+	positions, err := uc.positionsByID(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]Assignment, 0, len(records))
+	for _, r := range records {
+		list = append(list, Assignment{Record: r, Position: positions[r.PositionID]})
+	}
+	return list, nil
+}
+
+// Assignments reconstructs who held which position on a date, today by
+// default, terminated employees included.
+func (uc *UseCase) Assignments(ctx context.Context, companyID int64, req CompanyAssignmentsRequest) ([]Assignment, error) {
+	c, err := uc.companyRepo.FindByID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load company: %w", err)
+	}
+	asOf := parseDate(&req.AsOf)
+	if asOf == nil {
+		t := c.Today()
+		asOf = &t
+	}
+	departmentID, _ := strconv.ParseInt(req.DepartmentID, 10, 64)
+
+	records, err := uc.employeePositionRepo.ListByCompanyOn(ctx, companyID, *asOf)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := uc.positionsByID(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(records))
+	for _, r := range records {
+		ids = append(ids, r.EmployeeID)
+	}
+	employees, err := uc.employeeRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*employeeEntity.Employee, len(employees))
+	for i := range employees {
+		byID[employees[i].ID] = &employees[i]
+	}
+
+	list := make([]Assignment, 0, len(records))
+	for _, r := range records {
+		p, e := positions[r.PositionID], byID[r.EmployeeID]
+		if e == nil || (req.PrimaryOnly && !r.IsPrimary) {
+			continue
+		}
+		if departmentID != 0 && (p == nil || p.DepartmentID != departmentID) {
+			continue
+		}
+		list = append(list, Assignment{Record: r, Position: p, Employee: e})
+	}
+	// Records arrive grouped by employee with the primary first; keep that
+	// order within each employee.
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Employee.Name < list[j].Employee.Name
+	})
+	return list, nil
+}
+
+// AssignPosition adds an assignment. A new primary assignment that covers
+// today also moves the employee to the position's department.
+func (uc *UseCase) AssignPosition(ctx context.Context, companyID, employeeID int64, req AssignPositionRequest) (*Assignment, error) {
+	c, err := uc.companyRepo.FindByID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load company: %w", err)
+	}
+	p, err := uc.activePosition(ctx, companyID, req.PositionID)
+	if err != nil {
+		return nil, err
+	}
+
+	start := parseDate(&req.StartDate)
+	end := parseDate(req.EndDate)
+	if end != nil && end.Before(*start) {
+		return nil, errors.New("end date before start date")
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+	record := &employeeEntity.Position{
+		ID:         id,
+		EmployeeID: employeeID,
+		PositionID: p.ID,
+		Period:     employeeEntity.Period{StartDate: *start, EndDate: end},
+		IsPrimary:  req.IsPrimary,
+		ChangeType: employeeEntity.ChangeTypeAssignment,
+		Reason:     optional(req.Reason),
+	}
+
+	var before, after employeeEntity.Employee
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		e, err := uc.lockEmployee(ctx, companyID, employeeID)
+		if err != nil {
+			return err
+		}
+		if e.EmploymentStatus == employeeEntity.EmploymentStatusTerminated {
+			return errors.New("employee not active")
+		}
+
+		records, err := uc.employeePositionRepo.ListByEmployee(ctx, e.ID)
+		if err != nil {
+			return err
+		}
+		if err := checkOverlap(positionKind, records, record); err != nil {
+			return err
+		}
+		if err := uc.employeePositionRepo.Create(ctx, record); err != nil {
+			return fmt.Errorf("failed to create employee position: %w", err)
+		}
+
+		before = *e
+		if record.IsPrimary && record.ActiveOn(c.Today()) {
+			if err := uc.moveToPosition(ctx, companyID, e, p); err != nil {
+				return err
+			}
+		}
+		after = *e
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, positionKind.entity, record.ID, audit.ActionCreate, nil, record)
+	if after.DepartmentID != before.DepartmentID || after.DivisionID != before.DivisionID {
+		uc.record(ctx, companyID, after.ID, audit.ActionUpdate, &before, &after)
+	}
+	return &Assignment{Record: *record, Position: p}, nil
+}
+
+// Promote moves the employee's primary assignment to a position of a
+// higher level.
+func (uc *UseCase) Promote(ctx context.Context, companyID, employeeID int64, req PositionChangeRequest) (*Assignment, error) {
+	return uc.changePosition(ctx, companyID, employeeID, req, employeeEntity.ChangeTypePromotion)
+}
+
+// Transfer moves the employee's primary assignment to another position,
+// typically in another department, at any level.
+func (uc *UseCase) Transfer(ctx context.Context, companyID, employeeID int64, req PositionChangeRequest) (*Assignment, error) {
+	return uc.changePosition(ctx, companyID, employeeID, req, employeeEntity.ChangeTypeTransfer)
+}
+
+// Demote moves the employee's primary assignment to a position of a lower
+// level.
+func (uc *UseCase) Demote(ctx context.Context, companyID, employeeID int64, req PositionChangeRequest) (*Assignment, error) {
+	return uc.changePosition(ctx, companyID, employeeID, req, employeeEntity.ChangeTypeDemotion)
+}
+
+// EndPosition sets the last day of an assignment. It cannot end before it
+// starts, nor be extended into another primary assignment or another
+// assignment to the same position.
+func (uc *UseCase) EndPosition(ctx context.Context, companyID, employeeID, id int64, req EndPositionRequest) (*Assignment, error) {
+	end := parseDate(&req.EndDate)
+
+	var before, record employeeEntity.Position
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.lockEmployee(ctx, companyID, employeeID); err != nil {
+			return err
+		}
+		r, err := uc.employeePositionRepo.FindByID(ctx, employeeID, id)
+		if err != nil {
+			return err
+		}
+		if end.Before(r.StartDate) {
+			return errors.New("end date before start date")
+		}
+
+		before = *r
+		r.EndDate = end
+		records, err := uc.employeePositionRepo.ListByEmployee(ctx, employeeID)
+		if err != nil {
+			return err
+		}
+		if err := checkOverlap(positionKind, records, r); err != nil {
+			return err
+		}
+		if err := uc.employeePositionRepo.Update(ctx, r); err != nil {
+			return fmt.Errorf("failed to update employee position: %w", err)
+		}
+		record = *r
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, positionKind.entity, record.ID, audit.ActionUpdate, before, record)
+
+	positions, err := uc.positionsByID(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	return &Assignment{Record: record, Position: positions[record.PositionID]}, nil
+}
+
+// DeletePosition removes an assignment entered by mistake. Assignments
+// that ended normally stay in the history.
+func (uc *UseCase) DeletePosition(ctx context.Context, companyID, employeeID, id int64) error {
+	var record employeeEntity.Position
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.lockEmployee(ctx, companyID, employeeID); err != nil {
+			return err
+		}
+		r, err := uc.employeePositionRepo.FindByID(ctx, employeeID, id)
+		if err != nil {
+			return err
+		}
+		if err := uc.employeePositionRepo.Delete(ctx, r); err != nil {
+			return fmt.Errorf("failed to delete employee position: %w", err)
+		}
+		record = *r
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, positionKind.entity, record.ID, audit.ActionDelete, record, nil)
+	return nil
+}
+
+// ─── Position Helpers ───────────────────────────────────────────
+
+// changePosition closes the primary assignment covering the effective date
+// on the day before and opens a primary assignment to the new position on
+// that date, in one transaction.
+func (uc *UseCase) changePosition(ctx context.Context, companyID, employeeID int64, req PositionChangeRequest, changeType string) (*Assignment, error) {
+	c, err := uc.companyRepo.FindByID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load company: %w", err)
+	}
+	p, err := uc.activePosition(ctx, companyID, req.PositionID)
+	if err != nil {
+		return nil, err
+	}
+
+	effective := parseDate(req.EffectiveDate)
+	if effective == nil {
+		t := c.Today()
+		effective = &t
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+	record := &employeeEntity.Position{
+		ID:         id,
+		EmployeeID: employeeID,
+		PositionID: p.ID,
+		Period:     employeeEntity.Period{StartDate: *effective},
+		IsPrimary:  true,
+		ChangeType: changeType,
+		Reason:     optional(req.Reason),
+	}
+
+	var closedBefore, closed employeeEntity.Position
+	var before, after employeeEntity.Employee
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		e, err := uc.lockEmployee(ctx, companyID, employeeID)
+		if err != nil {
+			return err
+		}
+		if e.EmploymentStatus == employeeEntity.EmploymentStatusTerminated {
+			return errors.New("employee not active")
+		}
+
+		records, err := uc.employeePositionRepo.ListByEmployee(ctx, e.ID)
+		if err != nil {
+			return err
+		}
+		current, prev, err := succeed(positionKind, records, record)
+		if err != nil {
+			return err
+		}
+		closedBefore = prev
+
+		if changeType != employeeEntity.ChangeTypeTransfer {
+			from, err := uc.positionRepo.FindByID(ctx, companyID, current.PositionID)
+			if err != nil {
+				return err
+			}
+			diff := levelRank(p.Level) - levelRank(from.Level)
+			if changeType == employeeEntity.ChangeTypePromotion && diff <= 0 {
+				return errors.New("position level not higher")
+			}
+			if changeType == employeeEntity.ChangeTypeDemotion && diff >= 0 {
+				return errors.New("position level not lower")
+			}
+		}
+
+		if err := uc.employeePositionRepo.Update(ctx, current); err != nil {
+			return fmt.Errorf("failed to update employee position: %w", err)
+		}
+		if err := uc.employeePositionRepo.Create(ctx, record); err != nil {
+			return fmt.Errorf("failed to create employee position: %w", err)
+		}
+		closed = *current
+
+		before = *e
+		if record.ActiveOn(c.Today()) {
+			if err := uc.moveToPosition(ctx, companyID, e, p); err != nil {
+				return err
+			}
+		}
+		after = *e
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, positionKind.entity, closed.ID, audit.ActionUpdate, closedBefore, closed)
+	audit.RecordHR(ctx, uc.auditor, companyID, positionKind.entity, record.ID, audit.ActionCreate, nil, record)
+	if after.DepartmentID != before.DepartmentID || after.DivisionID != before.DivisionID {
+		uc.record(ctx, companyID, after.ID, audit.ActionUpdate, &before, &after)
+	}
+	return &Assignment{Record: *record, Position: p}, nil
+}
+
+// moveToPosition places the employee in the department, and division, of
+// their current primary position.
+func (uc *UseCase) moveToPosition(ctx context.Context, companyID int64, e *employeeEntity.Employee, p *orgEntity.Position) error {
+	d, err := uc.departmentRepo.FindByID(ctx, companyID, p.DepartmentID)
+	if err != nil {
+		return err
+	}
+	if e.DepartmentID != nil && *e.DepartmentID == d.ID && sameID(e.DivisionID, d.DivisionID) {
+		return nil
+	}
+	e.DepartmentID = &d.ID
+	e.DivisionID = d.DivisionID
+	if err := uc.employeeRepo.Update(ctx, e); err != nil {
+		return fmt.Errorf("failed to update employee: %w", err)
+	}
+	return nil
+}
+
+func (uc *UseCase) lockEmployee(ctx context.Context, companyID, id int64) (*employeeEntity.Employee, error) {
+	e, err := uc.employeeRepo.LockByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if e.CompanyID != companyID {
+		return nil, errors.New("employee not found")
+	}
+	return e, nil
+}
+
+// activePosition resolves an active position of the company.
+func (uc *UseCase) activePosition(ctx context.Context, companyID int64, raw string) (*orgEntity.Position, error) {
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return nil, errors.New("position not found")
+	}
+	p, err := uc.positionRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !p.IsActive {
+		return nil, errors.New("position not available")
+	}
+	return p, nil
+}
+
+func (uc *UseCase) positionsByID(ctx context.Context, companyID int64) (map[int64]*orgEntity.Position, error) {
+	list, err := uc.positionRepo.ListByCompany(ctx, companyID, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*orgEntity.Position, len(list))
+	for i := range list {
+		byID[list[i].ID] = &list[i]
+	}
+	return byID, nil
+}
+
+func levelRank(level string) int {
+	for i, l := range orgEntity.Levels() {
+		if l == level {
+			return i
+		}
+	}
+	return -1
+}
+
+func sameID(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,48 @@
+package employee
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/haily-id/engine/internal/pkg/audit"
+)
+
+// ─── Primary Records ────────────────────────────────────────────
+
+// primaryRecord is a pointer to a record an employee marks one of as
+// primary: a bank account, an emergency contact or an address of a type.
+type primaryRecord[T any] interface {
+	*T
+	Key() int64
+	Primary() bool
+	Demote()
+}
+
+// clearPrimary demotes every primary record other than keepID, saving each
+// with update, and returns them as they were. name is the record's kind in
+// errors.
+func clearPrimary[T any, P primaryRecord[T]](ctx context.Context, name string, update func(context.Context, *T) error, records []T, keepID int64) ([]T, error) {
+	var demoted []T
+	for i := range records {
+		r := P(&records[i])
+		if !r.Primary() || r.Key() == keepID {
+			continue
+		}
+		demoted = append(demoted, records[i])
+		r.Demote()
+		if err := update(ctx, &records[i]); err != nil {
+			return nil, fmt.Errorf("failed to update %s: %w", name, err)
+		}
+	}
+	return demoted, nil
+}
+
+// recordDemoted audits the records clearPrimary demoted through the
+// record's own audit function.
+func recordDemoted[T any, P primaryRecord[T]](ctx context.Context, companyID int64, demoted []T, record func(ctx context.Context, companyID int64, action string, before, after *T)) {
+	for i := range demoted {
+		after := demoted[i]
+		P(&after).Demote()
+		record(ctx, companyID, audit.ActionUpdate, &demoted[i], &after)
+	}
+}
@@ -0,0 +1,415 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+)
+
+// ─── Work Location Requests ─────────────────────────────────────
+
+// AssignWorkLocationRequest adds an assignment directly, e.g. a first
+// location or a concurrent secondary one.
+type AssignWorkLocationRequest struct {
+	AddressID string  `json:"address_id" validate:"required,numeric"`
+	StartDate string  `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate   *string `json:"end_date"   validate:"omitempty,datetime=2006-01-02"`
+	IsPrimary bool    `json:"is_primary"`
+	Reason    *string `json:"reason"     validate:"omitempty,max=500"`
+}
+
+// ReassignWorkLocationRequest moves the primary location to another
+// address from the effective date, which defaults to today in the
+// company's timezone.
+type ReassignWorkLocationRequest struct {
+	AddressID     string  `json:"address_id"     validate:"required,numeric"`
+	EffectiveDate *string `json:"effective_date" validate:"omitempty,datetime=2006-01-02"`
+	Reason        *string `json:"reason"         validate:"omitempty,max=500"`
+}
+
+// EndWorkLocationRequest sets the last day of an assignment.
+type EndWorkLocationRequest struct {
+	EndDate string `json:"end_date" validate:"required,datetime=2006-01-02"`
+}
+
+type CompanyWorkLocationsRequest struct {
+	AsOf        string `query:"as_of"      validate:"omitempty,datetime=2006-01-02"`
+	AddressID   string `query:"address_id" validate:"omitempty,numeric"`
+	PrimaryOnly bool   `query:"primary_only"`
+}
+
+// ─── Work Location Results ──────────────────────────────────────
+
+// LocationAssignment is a work location assignment with its address and
+// its employee where the caller asked for it.
+type LocationAssignment struct {
+	Record   employeeEntity.WorkLocation
+	Address  *companyEntity.Address
+	Employee *employeeEntity.Employee
+}
+
+// LocationHeadcount counts the employees assigned to an address on a
+// date. An employee counts once per address; Primary counts those whose
+// primary location it is.
+type LocationHeadcount struct {
+	Address companyEntity.Address
+	Total   int
+	Primary int
+}
+
+// ─── Work Locations ─────────────────────────────────────────────
+
+// WorkLocations returns an employee's assignments oldest first, only those
+// covering asOf when it is set.
+func (uc *UseCase) WorkLocations(ctx context.Context, companyID, employeeID int64, req AsOfRequest) ([]LocationAssignment, error) {
+	if _, err := uc.find(ctx, companyID, employeeID); err != nil {
+		return nil, err
+	}
+
+	records, err := uc.workLocationRepo.ListByEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if asOf := parseDate(&req.AsOf); asOf != nil {
+		records = activeOn(records, *asOf)
+	}
+
+	addresses, err := uc.addressesByID(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]LocationAssignment, 0, len(records))
+	for _, r := range records {
+		list = append(list, LocationAssignment{Record: r, Address: addresses[r.CompanyAddressID]})
+	}
+	return list, nil
+}
+
+// LocationAssignments lists who works where on a date, today by default,
+// terminated employees included.
+func (uc *UseCase) LocationAssignments(ctx context.Context, companyID int64, req CompanyWorkLocationsRequest) ([]LocationAssignment, error) {
+	c, err := uc.companyRepo.FindByID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load company: %w", err)
+	}
+	asOf := parseDate(&req.AsOf)
+	if asOf == nil {
+		t := c.Today()
+		asOf = &t
+	}
+	addressID, _ := strconv.ParseInt(req.AddressID, 10, 64)
+
+	records, err := uc.workLocationRepo.ListByCompanyOn(ctx, companyID, *asOf, addressID)
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := uc.addressesByID(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(records))
+	for _, r := range records {
+		ids = append(ids, r.EmployeeID)
+	}
+	employees, err := uc.employeeRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*employeeEntity.Employee, len(employees))
+	for i := range employees {
+		byID[employees[i].ID] = &employees[i]
+	}
+
+	list := make([]LocationAssignment, 0, len(records))
+	for _, r := range records {
+		e := byID[r.EmployeeID]
+		if e == nil || (req.PrimaryOnly && !r.IsPrimary) {
+			continue
+		}
+		list = append(list, LocationAssignment{Record: r, Address: addresses[r.CompanyAddressID], Employee: e})
+	}
+	// Records arrive grouped by employee with the primary first; keep that
+	// order within each employee.
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Employee.Name < list[j].Employee.Name
+	})
+	return list, nil
+}
+
+// Headcount counts the employees at each of the company's addresses on a
+// date, today by default. Addresses nobody works at are listed with zero.
+func (uc *UseCase) Headcount(ctx context.Context, companyID int64, req AsOfRequest) ([]LocationHeadcount, error) {
+	c, err := uc.companyRepo.FindByID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load company: %w", err)
+	}
+	asOf := parseDate(&req.AsOf)
+	if asOf == nil {
+		t := c.Today()
+		asOf = &t
+	}
+
+	addresses, err := uc.companyAddressRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	records, err := uc.workLocationRepo.ListByCompanyOn(ctx, companyID, *asOf, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	total := make(map[int64]map[int64]bool, len(addresses))
+	primary := make(map[int64]int, len(addresses))
+	for _, r := range records {
+		if total[r.CompanyAddressID] == nil {
+			total[r.CompanyAddressID] = make(map[int64]bool)
+		}
+		total[r.CompanyAddressID][r.EmployeeID] = true
+		if r.IsPrimary {
+			primary[r.CompanyAddressID]++
+		}
+	}
+
+	list := make([]LocationHeadcount, 0, len(addresses))
+	for _, a := range addresses {
+		list = append(list, LocationHeadcount{
+			Address: a,
+			Total:   len(total[a.ID]),
+			Primary: primary[a.ID],
+		})
+	}
+	return list, nil
+}
+
+// AssignWorkLocation adds an assignment to an active company address.
+func (uc *UseCase) AssignWorkLocation(ctx context.Context, companyID, employeeID int64, req AssignWorkLocationRequest) (*LocationAssignment, error) {
+	a, err := uc.activeAddress(ctx, companyID, req.AddressID)
+	if err != nil {
+		return nil, err
+	}
+
+	start := parseDate(&req.StartDate)
+	end := parseDate(req.EndDate)
+	if end != nil && end.Before(*start) {
+		return nil, errors.New("end date before start date")
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+	record := &employeeEntity.WorkLocation{
+		ID:               id,
+		EmployeeID:       employeeID,
+		CompanyAddressID: a.ID,
+		IsPrimary:        req.IsPrimary,
+		Period:           employeeEntity.Period{StartDate: *start, EndDate: end},
+		Reason:           optional(req.Reason),
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		e, err := uc.lockEmployee(ctx, companyID, employeeID)
+		if err != nil {
+			return err
+		}
+		records, err := uc.workLocationRepo.ListByEmployee(ctx, e.ID)
+		if err != nil {
+			return err
+		}
+		if err := checkOverlap(workLocationKind, records, record); err != nil {
+			return err
+		}
+		if err := uc.workLocationRepo.Create(ctx, record); err != nil {
+			return fmt.Errorf("failed to create work location: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, workLocationKind.entity, record.ID, audit.ActionCreate, nil, record)
+	return &LocationAssignment{Record: *record, Address: a}, nil
+}
+
+// ReassignWorkLocation closes the primary location covering the effective
+// date on the day before and opens a primary assignment to the new address
+// on that date, in one transaction.
+func (uc *UseCase) ReassignWorkLocation(ctx context.Context, companyID, employeeID int64, req ReassignWorkLocationRequest) (*LocationAssignment, error) {
+	c, err := uc.companyRepo.FindByID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load company: %w", err)
+	}
+	a, err := uc.activeAddress(ctx, companyID, req.AddressID)
+	if err != nil {
+		return nil, err
+	}
+
+	effective := parseDate(req.EffectiveDate)
+	if effective == nil {
+		t := c.Today()
+		effective = &t
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+	record := &employeeEntity.WorkLocation{
+		ID:               id,
+		EmployeeID:       employeeID,
+		CompanyAddressID: a.ID,
+		IsPrimary:        true,
+		Period:           employeeEntity.Period{StartDate: *effective},
+		Reason:           optional(req.Reason),
+	}
+
+	var closedBefore, closed employeeEntity.WorkLocation
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		e, err := uc.lockEmployee(ctx, companyID, employeeID)
+		if err != nil {
+			return err
+		}
+		if e.EmploymentStatus == employeeEntity.EmploymentStatusTerminated {
+			return errors.New("employee not active")
+		}
+
+		records, err := uc.workLocationRepo.ListByEmployee(ctx, e.ID)
+		if err != nil {
+			return err
+		}
+		current, prev, err := succeed(workLocationKind, records, record)
+		if err != nil {
+			return err
+		}
+		closedBefore = prev
+
+		if err := uc.workLocationRepo.Update(ctx, current); err != nil {
+			return fmt.Errorf("failed to update work location: %w", err)
+		}
+		if err := uc.workLocationRepo.Create(ctx, record); err != nil {
+			return fmt.Errorf("failed to create work location: %w", err)
+		}
+		closed = *current
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, workLocationKind.entity, closed.ID, audit.ActionUpdate, closedBefore, closed)
+	audit.RecordHR(ctx, uc.auditor, companyID, workLocationKind.entity, record.ID, audit.ActionCreate, nil, record)
+	return &LocationAssignment{Record: *record, Address: a}, nil
+}
+
+// EndWorkLocation sets the last day of an assignment. It cannot end before
+// it starts, nor be extended into another primary location or another
+// assignment to the same address.
+func (uc *UseCase) EndWorkLocation(ctx context.Context, companyID, employeeID, id int64, req EndWorkLocationRequest) (*LocationAssignment, error) {
+	end := parseDate(&req.EndDate)
+
+	var before, record employeeEntity.WorkLocation
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.lockEmployee(ctx, companyID, employeeID); err != nil {
+			return err
+		}
+		r, err := uc.workLocationRepo.FindByID(ctx, employeeID, id)
+		if err != nil {
+			return err
+		}
+		if end.Before(r.StartDate) {
+			return errors.New("end date before start date")
+		}
+
+		before = *r
+		r.EndDate = end
+		records, err := uc.workLocationRepo.ListByEmployee(ctx, employeeID)
+		if err != nil {
+			return err
+		}
+		if err := checkOverlap(workLocationKind, records, r); err != nil {
+			return err
+		}
+		if err := uc.workLocationRepo.Update(ctx, r); err != nil {
+			return fmt.Errorf("failed to update work location: %w", err)
+		}
+		record = *r
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, workLocationKind.entity, record.ID, audit.ActionUpdate, before, record)
+
+	addresses, err := uc.addressesByID(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	return &LocationAssignment{Record: record, Address: addresses[record.CompanyAddressID]}, nil
+}
+
+// DeleteWorkLocation removes an assignment entered by mistake. Assignments
+// that ended normally stay in the history.
+func (uc *UseCase) DeleteWorkLocation(ctx context.Context, companyID, employeeID, id int64) error {
+	var record employeeEntity.WorkLocation
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.lockEmployee(ctx, companyID, employeeID); err != nil {
+			return err
+		}
+		r, err := uc.workLocationRepo.FindByID(ctx, employeeID, id)
+		if err != nil {
+			return err
+		}
+		if err := uc.workLocationRepo.Delete(ctx, r); err != nil {
+			return fmt.Errorf("failed to delete work location: %w", err)
+		}
+		record = *r
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, workLocationKind.entity, record.ID, audit.ActionDelete, record, nil)
+	return nil
+}
+
+// ─── Work Location Helpers ──────────────────────────────────────
+
+// activeAddress resolves an active address of the company.
+func (uc *UseCase) activeAddress(ctx context.Context, companyID int64, raw string) (*companyEntity.Address, error) {
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return nil, errors.New("address not found")
+	}
+	a, err := uc.companyAddressRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !a.IsActive {
+		return nil, errors.New("address not available")
+	}
+	return a, nil
+}
+
+func (uc *UseCase) addressesByID(ctx context.Context, companyID int64) (map[int64]*companyEntity.Address, error) {
+	list, err := uc.companyAddressRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*companyEntity.Address, len(list))
+	for i := range list {
+		byID[list[i].ID] = &list[i]
+	}
+	return byID, nil
+}
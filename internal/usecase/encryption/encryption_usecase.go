@@ -0,0 +1,94 @@
+package encryption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/encryption"
+	"github.com/haily-id/engine/internal/pkg/logger"
+)
+
+const batchSize = 500
+
+// Columns lists every column written through the encrypted serializer.
+// A new encrypted field must be added here for key rotation to reach it.
+var Columns = []repository.EncryptedColumn{
+	{Table: "users", Column: "phone"},
+	{Table: "employees", Column: "phone"},
+	{Table: "employees", Column: "national_id", IndexColumn: "national_id_index"},
+	{Table: "employees", Column: "tax_id", IndexColumn: "tax_id_index"},
+	{Table: "employee_bank_accounts", Column: "account_number"},
+	{Table: "employee_emergency_contacts", Column: "phone"},
+	{Table: "employee_emergency_contacts", Column: "alternative_phone"},
+	{Table: "employee_imports", Column: "rows"},
+}
+
+type UseCase struct {
+	columnRepo repository.EncryptedColumnRepository
+	keyring    *encryption.Keyring
+}
+
+func NewUseCase(columnRepo repository.EncryptedColumnRepository, keyring *encryption.Keyring) *UseCase {
+	return &UseCase{
+		columnRepo: columnRepo,
+		keyring:    keyring,
+	}
+}
+
+// Reencrypt is run daily by the worker. It seals every value that is still
+// plaintext or sealed under an older key with the active key, refreshing
+// blind indexes on the way, so retired keys can be removed from the keyring
+// once it has passed. A row changed while it runs is left for the next run.
+func (uc *UseCase) Reencrypt(ctx context.Context) error {
+	var errs []error
+	for _, col := range Columns {
+		n, err := uc.reencryptColumn(ctx, col)
+		if n > 0 {
+			logger.Infof("Re-encrypted %d values of %s.%s", n, col.Table, col.Column)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s.%s: %w", col.Table, col.Column, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (uc *UseCase) reencryptColumn(ctx context.Context, col repository.EncryptedColumn) (int, error) {
+	prefix := uc.keyring.ActivePrefix()
+	var afterID int64
+	done := 0
+	for {
+		list, err := uc.columnRepo.ListStale(ctx, col, prefix, afterID, batchSize)
+		if err != nil {
+			return done, fmt.Errorf("failed to list values: %w", err)
+		}
+		for _, v := range list {
+			afterID = v.ID
+			plaintext, err := uc.keyring.Decrypt(v.Value)
+			if err != nil {
+				return done, fmt.Errorf("row %d: %w", v.ID, err)
+			}
+			sealed, err := uc.keyring.Encrypt(plaintext)
+			if err != nil {
+				return done, fmt.Errorf("row %d: %w", v.ID, err)
+			}
+			var index *string
+			if col.IndexColumn != "" {
+				i := uc.keyring.BlindIndex(plaintext)
+				index = &i
+			}
+			ok, err := uc.columnRepo.Rewrite(ctx, col, v.ID, v.Value, sealed, index)
+			if err != nil {
+				return done, fmt.Errorf("failed to rewrite row %d: %w", v.ID, err)
+			}
+			if ok {
+				done++
+			}
+		}
+		if len(list) < batchSize {
+			return done, nil
+		}
+	}
+}
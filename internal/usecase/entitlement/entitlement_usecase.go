@@ -0,0 +1,250 @@
+package entitlement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	subscriptionEntity "github.com/haily-id/engine/internal/domain/entity/subscription"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+	redisCache "github.com/haily-id/engine/internal/repository/redis"
+)
+
+const (
+	SourcePlan     = "PLAN"
+	SourceOverride = "OVERRIDE"
+	SourceNone     = "NONE"
+
+	cacheTTL = 10 * time.Minute
+)
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+type SetOverrideRequest struct {
+	IsActive  bool   `json:"is_active"`
+	ExpiresAt *int64 `json:"expires_at" validate:"omitempty,gt=0"`
+}
+
+type SetModuleActiveRequest struct {
+	IsActive *bool `json:"is_active" validate:"required"`
+}
+
+// ─── Results ────────────────────────────────────────────────────
+
+type ModuleEntitlement struct {
+	Module    subscriptionEntity.Module
+	Enabled   bool
+	Source    string
+	ExpiresAt *time.Time
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	moduleRepo        repository.ModuleRepository
+	companyModuleRepo repository.CompanyModuleRepository
+	subRepo           repository.CompanySubscriptionRepository
+	companyRepo       repository.CompanyRepository
+	cache             repository.Cache
+}
+
+func NewUseCase(
+	moduleRepo repository.ModuleRepository,
+	companyModuleRepo repository.CompanyModuleRepository,
+	subRepo repository.CompanySubscriptionRepository,
+	companyRepo repository.CompanyRepository,
+	cache repository.Cache,
+) *UseCase {
+	return &UseCase{
+		moduleRepo:        moduleRepo,
+		companyModuleRepo: companyModuleRepo,
+		subRepo:           subRepo,
+		companyRepo:       companyRepo,
+		cache:             cache,
+	}
+}
+
+// IsEnabled reports whether module code is enabled for the company right now.
+// Results are cached per company in Redis and invalidated whenever the
+// subscription, its plan, or the company's module overrides change.
+func (uc *UseCase) IsEnabled(ctx context.Context, companyID int64, code string) (bool, error) {
+	key := redisCache.EntitlementKey(companyID)
+
+	var enabled map[string]bool
+	if err := uc.cache.Get(ctx, key, &enabled); err == nil {
+		return enabled[code], nil
+	}
+
+	list, err := uc.resolve(ctx, companyID, time.Now())
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	ttl := cacheTTL
+	enabled = make(map[string]bool, len(list))
+	for _, e := range list {
+		enabled[e.Module.Code] = e.Enabled
+		if e.ExpiresAt != nil {
+			if d := e.ExpiresAt.Sub(now); d < ttl {
+				ttl = d
+			}
+		}
+	}
+
+	if ttl > 0 {
+		if err := uc.cache.Set(ctx, key, enabled, ttl); err != nil {
+			logger.Errorf("Failed to cache entitlements for company %d: %v", companyID, err)
+		}
+	}
+
+	return enabled[code], nil
+}
+
+// ListForCompany returns every module with whether it is enabled for the
+// company and why. It always reads from the database.
+func (uc *UseCase) ListForCompany(ctx context.Context, companyID int64) ([]ModuleEntitlement, error) {
+	return uc.resolve(ctx, companyID, time.Now())
+}
+
+// SetOverride grants or withholds a module for one company regardless of its
+// plan, optionally until expires_at.
+func (uc *UseCase) SetOverride(ctx context.Context, companyID, moduleID int64, req SetOverrideRequest) (*subscriptionEntity.CompanyModule, error) {
+	if _, err := uc.companyRepo.FindByID(ctx, companyID); err != nil {
+		return nil, err
+	}
+	if _, err := uc.moduleRepo.FindByID(ctx, moduleID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	cm, err := uc.companyModuleRepo.FindByCompanyAndModule(ctx, companyID, moduleID)
+	if err != nil {
+		id, err := snowflake.Generate()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate ID: %w", err)
+		}
+		cm = &subscriptionEntity.CompanyModule{ID: id, CompanyID: companyID, ModuleID: moduleID}
+	}
+
+	if req.IsActive && !cm.IsActive {
+		cm.EnabledAt = &now
+	}
+	cm.IsActive = req.IsActive
+	cm.ExpiresAt = nil
+	if req.ExpiresAt != nil {
+		t := time.Unix(*req.ExpiresAt, 0)
+		if !t.After(now) {
+			return nil, errors.New("expiry must be in the future")
+		}
+		cm.ExpiresAt = &t
+	}
+
+	if err := uc.companyModuleRepo.Save(ctx, cm); err != nil {
+		return nil, fmt.Errorf("failed to save company module: %w", err)
+	}
+
+	uc.Invalidate(ctx, companyID)
+	return cm, nil
+}
+
+// RemoveOverride drops a company override so the plan decides again.
+func (uc *UseCase) RemoveOverride(ctx context.Context, companyID, moduleID int64) error {
+	if err := uc.companyModuleRepo.Delete(ctx, companyID, moduleID); err != nil {
+		return fmt.Errorf("failed to delete company module: %w", err)
+	}
+	uc.Invalidate(ctx, companyID)
+	return nil
+}
+
+// SetModuleActive switches a module on or off platform-wide.
+func (uc *UseCase) SetModuleActive(ctx context.Context, moduleID int64, req SetModuleActiveRequest) (*subscriptionEntity.Module, error) {
+	m, err := uc.moduleRepo.FindByID(ctx, moduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	m.IsActive = *req.IsActive
+	if err := uc.moduleRepo.Update(ctx, m); err != nil {
+		return nil, fmt.Errorf("failed to update module: %w", err)
+	}
+
+	uc.InvalidateAll(ctx)
+	return m, nil
+}
+
+// Invalidate drops the cached entitlements of one company.
+func (uc *UseCase) Invalidate(ctx context.Context, companyID int64) {
+	if err := uc.cache.Delete(ctx, redisCache.EntitlementKey(companyID)); err != nil {
+		logger.Errorf("Failed to invalidate entitlements for company %d: %v", companyID, err)
+	}
+}
+
+// InvalidateAll drops the cached entitlements of every company, for changes
+// such as plan contents that affect many companies at once.
+func (uc *UseCase) InvalidateAll(ctx context.Context) {
+	if err := uc.cache.DeletePattern(ctx, redisCache.EntitlementPattern()); err != nil {
+		logger.Errorf("Failed to invalidate entitlements: %v", err)
+	}
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+// resolve combines the plan of a usable subscription with the company's
+// overrides. Modules switched off platform-wide are never enabled.
+func (uc *UseCase) resolve(ctx context.Context, companyID int64, now time.Time) ([]ModuleEntitlement, error) {
+	modules, err := uc.moduleRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inPlan := make(map[int64]bool)
+	var planUntil *time.Time
+	if sub, err := uc.subRepo.FindCurrentByCompany(ctx, companyID); err == nil && sub.IsUsable(now) {
+		planModules, err := uc.moduleRepo.ListByPlanID(ctx, sub.PlanID)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range planModules {
+			inPlan[m.ID] = true
+		}
+		if sub.Status == subscriptionEntity.StatusCancelled {
+			end := sub.CurrentPeriodEnd
+			planUntil = &end
+		}
+	}
+
+	overrides, err := uc.companyModuleRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	byModule := make(map[int64]*subscriptionEntity.CompanyModule, len(overrides))
+	for i := range overrides {
+		byModule[overrides[i].ModuleID] = &overrides[i]
+	}
+
+	result := make([]ModuleEntitlement, 0, len(modules))
+	for _, m := range modules {
+		e := ModuleEntitlement{Module: m, Source: SourceNone}
+
+		if cm, ok := byModule[m.ID]; ok && cm.InEffect(now) {
+			e.Source = SourceOverride
+			e.Enabled = cm.IsActive
+			e.ExpiresAt = cm.ExpiresAt
+		} else if inPlan[m.ID] {
+			e.Source = SourcePlan
+			e.Enabled = true
+			e.ExpiresAt = planUntil
+		}
+
+		if !m.IsActive {
+			e.Enabled = false
+		}
+		result = append(result, e)
+	}
+
+	return result, nil
+}
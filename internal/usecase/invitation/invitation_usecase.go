@@ -0,0 +1,463 @@
+package invitation
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	"github.com/haily-id/engine/internal/domain/entity/rbac"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/asynq/tasks"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/i18n"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	"github.com/haily-id/engine/internal/pkg/notify"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+	"github.com/hibiken/asynq"
+)
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+type CreateInvitationRequest struct {
+	Email          string  `json:"email"           validate:"required,email"`
+	Name           string  `json:"name"            validate:"required,min=2,max=255"`
+	RoleID         string  `json:"role_id"         validate:"required,numeric"`
+	EmployeeNumber *string `json:"employee_number" validate:"omitempty,max=50"`
+}
+
+type AcceptInvitationRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// Preview is what an invitee sees before accepting, so the client can send
+// them to Register or Login depending on HasAccount.
+type Preview struct {
+	Invitation  *employeeEntity.Invitation
+	CompanyName string
+	HasAccount  bool
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	invitationRepo repository.EmployeeInvitationRepository
+	employeeRepo   repository.EmployeeRepository
+	memberRepo     repository.UserCompanyRepository
+	roleRepo       repository.RoleRepository
+	companyRepo    repository.CompanyRepository
+	userRepo       repository.UserRepository
+	transactor     repository.Transactor
+	asynqClient    interface {
+		Enqueue(task *asynq.Task, opts ...asynq.Option) error
+	}
+	limits    Limits
+	auditor   audit.Recorder
+	notifier  notify.Notifier
+	acceptURL string
+}
+
+// Limits enforces the plan's member and employee caps. Both methods are
+// called inside the transaction that adds the row they guard.
+type Limits interface {
+	ReserveUserSeat(ctx context.Context, companyID int64) error
+	ReserveEmployee(ctx context.Context, companyID int64) error
+}
+
+type Config struct {
+	// AcceptURL is the frontend page that accepts an invitation; the token
+	// is appended as a query parameter.
+	AcceptURL string
+}
+
+func NewUseCase(
+	invitationRepo repository.EmployeeInvitationRepository,
+	employeeRepo repository.EmployeeRepository,
+	memberRepo repository.UserCompanyRepository,
+	roleRepo repository.RoleRepository,
+	companyRepo repository.CompanyRepository,
+	userRepo repository.UserRepository,
+	transactor repository.Transactor,
+	asynqClient interface {
+		Enqueue(task *asynq.Task, opts ...asynq.Option) error
+	},
+	limits Limits,
+	auditor audit.Recorder,
+	notifier notify.Notifier,
+	cfg Config,
+) *UseCase {
+	return &UseCase{
+		invitationRepo: invitationRepo,
+		employeeRepo:   employeeRepo,
+		memberRepo:     memberRepo,
+		roleRepo:       roleRepo,
+		companyRepo:    companyRepo,
+		userRepo:       userRepo,
+		transactor:     transactor,
+		asynqClient:    asynqClient,
+		limits:         limits,
+		auditor:        auditor,
+		notifier:       notifier,
+		acceptURL:      cfg.AcceptURL,
+	}
+}
+
+func (uc *UseCase) Create(ctx context.Context, companyID, inviterUserID int64, req CreateInvitationRequest) (*employeeEntity.Invitation, error) {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+
+	roleID, err := strconv.ParseInt(req.RoleID, 10, 64)
+	if err != nil {
+		return nil, errors.New("role not found")
+	}
+	role, err := uc.roleRepo.FindByID(ctx, roleID)
+	if err != nil {
+		return nil, errors.New("role not found")
+	}
+	if role.CompanyID != nil && *role.CompanyID != companyID {
+		return nil, errors.New("role not found")
+	}
+	if role.Code == rbac.RoleOwner {
+		return nil, errors.New("role not assignable")
+	}
+
+	if u, _ := uc.userRepo.FindByEmail(ctx, email); u != nil {
+		if m, _ := uc.memberRepo.FindByUserAndCompany(ctx, u.ID, companyID); m != nil {
+			return nil, errors.New("already a member of this company")
+		}
+	}
+
+	if pending, _ := uc.invitationRepo.FindPendingByCompanyAndEmail(ctx, companyID, email); pending != nil {
+		return nil, errors.New("invitation already pending")
+	}
+
+	emp, _ := uc.employeeRepo.FindByCompanyAndEmail(ctx, companyID, email)
+	if emp != nil && emp.UserID != nil {
+		return nil, errors.New("employee already linked to a user")
+	}
+
+	var invitedBy *int64
+	if inviter, _ := uc.employeeRepo.FindByCompanyAndUser(ctx, companyID, inviterUserID); inviter != nil {
+		invitedBy = &inviter.ID
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	invID, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	inv := &employeeEntity.Invitation{
+		ID:        invID,
+		CompanyID: companyID,
+		InvitedBy: invitedBy,
+		RoleID:    role.ID,
+		Email:     email,
+		Name:      req.Name,
+		Token:     token,
+		Status:    employeeEntity.InvitationStatusPending,
+		ExpiresAt: time.Now().Add(employeeEntity.InvitationExpiry),
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if emp == nil {
+			if err := uc.limits.ReserveEmployee(ctx, companyID); err != nil {
+				return err
+			}
+			empID, err := snowflake.Generate()
+			if err != nil {
+				return fmt.Errorf("failed to generate ID: %w", err)
+			}
+			emp = &employeeEntity.Employee{
+				ID:               empID,
+				CompanyID:        companyID,
+				EmployeeNumber:   req.EmployeeNumber,
+				Email:            email,
+				Name:             req.Name,
+				EmploymentStatus: employeeEntity.EmploymentStatusActive,
+				EmploymentType:   employeeEntity.EmploymentTypeFullTime,
+				IsActive:         true,
+			}
+			if err := uc.employeeRepo.Create(ctx, emp); err != nil {
+				return fmt.Errorf("failed to create employee: %w", err)
+			}
+		}
+
+		inv.EmployeeID = emp.ID
+		inv.DivisionID = emp.DivisionID
+		inv.DepartmentID = emp.DepartmentID
+		if err := uc.invitationRepo.Create(ctx, inv); err != nil {
+			return fmt.Errorf("failed to create invitation: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditor.Record(ctx, audit.Entry{
+		CompanyID: companyID,
+		Module:    audit.ModuleGeneral,
+		Entity:    "employee_invitations",
+		EntityID:  inv.ID,
+		Action:    audit.ActionCreate,
+		New:       auditValues(inv),
+	})
+
+	// The employee and invitation are committed by now; failing here would
+	// make a retry run into the duplicate checks. The invitation can be
+	// resent instead.
+	if err := uc.sendInvitation(ctx, inv, inviterUserID); err != nil {
+		logger.Errorf("Failed to send invitation %d: %v", inv.ID, err)
+	}
+
+	return inv, nil
+}
+
+func (uc *UseCase) List(ctx context.Context, companyID int64, status string) ([]employeeEntity.Invitation, error) {
+	return uc.invitationRepo.ListByCompany(ctx, companyID, status)
+}
+
+func (uc *UseCase) Resend(ctx context.Context, companyID, invitationID, inviterUserID int64) (*employeeEntity.Invitation, error) {
+	inv, err := uc.findInCompany(ctx, companyID, invitationID)
+	if err != nil {
+		return nil, err
+	}
+	if inv.Status != employeeEntity.InvitationStatusPending && inv.Status != employeeEntity.InvitationStatusExpired {
+		return nil, errors.New("invitation is not pending")
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	inv.Token = token
+	inv.Status = employeeEntity.InvitationStatusPending
+	inv.ExpiresAt = time.Now().Add(employeeEntity.InvitationExpiry)
+	if err := uc.invitationRepo.Update(ctx, inv); err != nil {
+		return nil, fmt.Errorf("failed to update invitation: %w", err)
+	}
+
+	if err := uc.sendInvitation(ctx, inv, inviterUserID); err != nil {
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+func (uc *UseCase) Cancel(ctx context.Context, companyID, invitationID int64) error {
+	inv, err := uc.findInCompany(ctx, companyID, invitationID)
+	if err != nil {
+		return err
+	}
+	if inv.Status != employeeEntity.InvitationStatusPending {
+		return errors.New("invitation is not pending")
+	}
+
+	old := auditValues(inv)
+	inv.Status = employeeEntity.InvitationStatusCancelled
+	if err := uc.invitationRepo.Update(ctx, inv); err != nil {
+		return fmt.Errorf("failed to cancel invitation: %w", err)
+	}
+
+	uc.auditor.Record(ctx, audit.Entry{
+		CompanyID: companyID,
+		Module:    audit.ModuleGeneral,
+		Entity:    "employee_invitations",
+		EntityID:  inv.ID,
+		Action:    audit.ActionUpdate,
+		Old:       old,
+		New:       auditValues(inv),
+	})
+	return nil
+}
+
+func (uc *UseCase) Preview(ctx context.Context, token string) (*Preview, error) {
+	inv, err := uc.invitationRepo.FindByToken(ctx, token)
+	if err != nil {
+		return nil, errors.New("invitation not found")
+	}
+
+	c, err := uc.companyRepo.FindByID(ctx, inv.CompanyID)
+	if err != nil {
+		return nil, errors.New("invitation not found")
+	}
+
+	u, _ := uc.userRepo.FindByEmail(ctx, inv.Email)
+
+	return &Preview{
+		Invitation:  inv,
+		CompanyName: c.Name,
+		HasAccount:  u != nil,
+	}, nil
+}
+
+// Accept links the invited employee record to userID and makes the user a
+// member of the company with the invited role. The user must be signed in
+// with the invited email, either after Register + VerifyEmail or Login.
+func (uc *UseCase) Accept(ctx context.Context, userID int64, req AcceptInvitationRequest) (*employeeEntity.Invitation, error) {
+	inv, err := uc.invitationRepo.FindByToken(ctx, req.Token)
+	if err != nil {
+		return nil, errors.New("invitation not found")
+	}
+
+	if inv.Status != employeeEntity.InvitationStatusPending {
+		return nil, errors.New("invitation is not pending")
+	}
+
+	if time.Now().After(inv.ExpiresAt) {
+		inv.Status = employeeEntity.InvitationStatusExpired
+		_ = uc.invitationRepo.Update(ctx, inv)
+		return nil, errors.New("invitation expired")
+	}
+
+	u, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+	if !strings.EqualFold(u.Email, inv.Email) {
+		return nil, errors.New("invitation email mismatch")
+	}
+
+	if m, _ := uc.memberRepo.FindByUserAndCompany(ctx, userID, inv.CompanyID); m != nil {
+		return nil, errors.New("already a member of this company")
+	}
+
+	memberID, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.limits.ReserveUserSeat(ctx, inv.CompanyID); err != nil {
+			return err
+		}
+
+		emp, err := uc.employeeRepo.FindByID(ctx, inv.EmployeeID)
+		if err != nil {
+			return errors.New("employee not found")
+		}
+		if emp.UserID != nil && *emp.UserID != userID {
+			return errors.New("employee already linked to a user")
+		}
+
+		emp.UserID = &userID
+		emp.IsActive = true
+		if err := uc.employeeRepo.Update(ctx, emp); err != nil {
+			return fmt.Errorf("failed to link employee: %w", err)
+		}
+
+		if err := uc.memberRepo.Create(ctx, &companyEntity.UserCompany{
+			ID:        memberID,
+			UserID:    userID,
+			CompanyID: inv.CompanyID,
+			RoleID:    inv.RoleID,
+			IsActive:  true,
+			JoinedAt:  time.Now(),
+		}); err != nil {
+			return fmt.Errorf("failed to create membership: %w", err)
+		}
+
+		inv.Status = employeeEntity.InvitationStatusAccepted
+		if err := uc.invitationRepo.Update(ctx, inv); err != nil {
+			return fmt.Errorf("failed to update invitation: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditor.Record(ctx, audit.Entry{
+		CompanyID: inv.CompanyID,
+		Module:    audit.ModuleGeneral,
+		Entity:    "employee_invitations",
+		EntityID:  inv.ID,
+		Action:    audit.ActionUpdate,
+		Old:       map[string]interface{}{"Status": employeeEntity.InvitationStatusPending},
+		New:       auditValues(inv),
+	})
+
+	return inv, nil
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func (uc *UseCase) findInCompany(ctx context.Context, companyID, invitationID int64) (*employeeEntity.Invitation, error) {
+	inv, err := uc.invitationRepo.FindByID(ctx, invitationID)
+	if err != nil || inv.CompanyID != companyID {
+		return nil, errors.New("invitation not found")
+	}
+	return inv, nil
+}
+
+// sendInvitation emails the invitee and, when they already have an account,
+// notifies them in the app as well.
+func (uc *UseCase) sendInvitation(ctx context.Context, inv *employeeEntity.Invitation, inviterUserID int64) error {
+	c, err := uc.companyRepo.FindByID(ctx, inv.CompanyID)
+	if err != nil {
+		return fmt.Errorf("failed to load company: %w", err)
+	}
+
+	inviterName := c.Name
+	if inviter, err := uc.userRepo.FindByID(ctx, inviterUserID); err == nil {
+		inviterName = inviter.Name
+	}
+
+	acceptURL := fmt.Sprintf("%s?token=%s", uc.acceptURL, inv.Token)
+	lang := i18n.FromContext(ctx)
+	task, err := tasks.NewSendInvitationEmailTask(inv.Email, inv.Name, c.Name, inviterName, acceptURL, lang)
+	if err != nil {
+		return fmt.Errorf("failed to create email task: %w", err)
+	}
+
+	if err := uc.asynqClient.Enqueue(task, asynq.Queue("default")); err != nil {
+		return fmt.Errorf("failed to enqueue email task: %w", err)
+	}
+
+	if invitee, err := uc.userRepo.FindByEmail(ctx, inv.Email); err == nil {
+		content := i18n.InvitationNotification(c.Name, inviterName, lang)
+		uc.notifier.Notify(ctx, notify.Notice{
+			UserID:    invitee.ID,
+			CompanyID: inv.CompanyID,
+			Type:      notify.TypeInvitationReceived,
+			Title:     content.Title,
+			Body:      content.Body,
+			Data: map[string]string{
+				"invitation_id": strconv.FormatInt(inv.ID, 10),
+				"token":         inv.Token,
+			},
+		})
+	}
+
+	return nil
+}
+
+func generateToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// auditValues is what the audit log keeps of an invitation; the token is
+// left out.
+func auditValues(inv *employeeEntity.Invitation) map[string]interface{} {
+	return map[string]interface{}{
+		"Email":      inv.Email,
+		"EmployeeID": inv.EmployeeID,
+		"RoleID":     inv.RoleID,
+		"Status":     inv.Status,
+	}
+}
@@ -0,0 +1,214 @@
+package leave
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	leaveEntity "github.com/haily-id/engine/internal/domain/entity/leave"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+)
+
+// ─── Balances ───────────────────────────────────────────────────
+
+// Balances returns an employee's balances of a year, one per active leave
+// type that keeps a balance. Balances missing so far are opened on the
+// way, so the figures are there before the first request.
+func (uc *UseCase) Balances(ctx context.Context, companyID int64, actor Actor, employeeID *string, req BalanceRequest) ([]BalanceView, error) {
+	c, err := uc.companyOf(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	e, err := uc.subject(ctx, companyID, actor, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	types, err := uc.typeRepo.ListByCompany(ctx, companyID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	year := req.Year
+	if year == 0 {
+		year = c.Today().Year()
+	}
+	asOf := clamp(c.Today(), year)
+
+	views := make([]BalanceView, 0, len(types))
+	for i := range types {
+		t := &types[i]
+		if !t.HasBalance() {
+			continue
+		}
+		b, err := uc.balanceRepo.Find(ctx, e.ID, t.ID, year)
+		if err != nil {
+			if err.Error() != "leave balance not found" {
+				return nil, err
+			}
+			if b, err = uc.open(ctx, e, t, year); err != nil {
+				return nil, err
+			}
+		}
+		view, err := uc.balanceView(ctx, t, b, asOf)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, *view)
+	}
+	return views, nil
+}
+
+// AdjustBalance corrects an employee's balance of one leave type and year
+// by req.Days, opening the balance first when needed.
+func (uc *UseCase) AdjustBalance(ctx context.Context, companyID, employeeID int64, req AdjustBalanceRequest) (*BalanceView, error) {
+	c, err := uc.companyOf(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	e, err := uc.employeeRepo.FindByID(ctx, employeeID)
+	if err != nil || e.CompanyID != companyID {
+		return nil, errors.New("employee not found")
+	}
+	typeID, _ := strconv.ParseInt(req.LeaveTypeID, 10, 64)
+	t, err := uc.typeRepo.FindByID(ctx, companyID, typeID)
+	if err != nil {
+		return nil, err
+	}
+	if !t.HasBalance() {
+		return nil, errors.New("leave type has no balance")
+	}
+
+	var b *leaveEntity.Balance
+	var before leaveEntity.Balance
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if b, err = uc.lock(ctx, e, t, req.Year); err != nil {
+			return err
+		}
+		before = *b
+		b.Adjustment += req.Days
+		return uc.balanceRepo.Update(ctx, b)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "leave_balances", b.ID, audit.ActionUpdate, before, b)
+	return uc.balanceView(ctx, t, b, clamp(c.Today(), req.Year))
+}
+
+// lock returns the balance row locked for the surrounding transaction,
+// opening it when the employee has none yet.
+func (uc *UseCase) lock(ctx context.Context, e *employeeEntity.Employee, t *leaveEntity.Type, year int) (*leaveEntity.Balance, error) {
+	b, err := uc.balanceRepo.Lock(ctx, e.ID, t.ID, year)
+	if err == nil {
+		return b, nil
+	}
+	if err.Error() != "leave balance not found" {
+		return nil, err
+	}
+	if _, err := uc.open(ctx, e, t, year); err != nil {
+		return nil, err
+	}
+	return uc.balanceRepo.Lock(ctx, e.ID, t.ID, year)
+}
+
+// open creates an employee's balance of a year. Employees hired during the
+// year are entitled to the months from their hire month on, those hired
+// later to nothing. Up to the type's MaxCarryOverDays of what was left of
+// the previous year's balance carries over.
+func (uc *UseCase) open(ctx context.Context, e *employeeEntity.Employee, t *leaveEntity.Type, year int) (*leaveEntity.Balance, error) {
+	annualDays, err := uc.annualDays(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	b := &leaveEntity.Balance{
+		ID:           id,
+		CompanyID:    e.CompanyID,
+		EmployeeID:   e.ID,
+		LeaveTypeID:  t.ID,
+		Year:         year,
+		Entitled:     annualDays,
+		AccrualStart: time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if h := e.HireDate; h != nil && h.Year() >= year {
+		if h.Year() > year {
+			b.Entitled = 0
+			b.AccrualStart = time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+		} else {
+			b.Entitled = annualDays * (13 - int(h.Month())) / 12
+			b.AccrualStart = time.Date(year, h.Month(), 1, 0, 0, 0, 0, time.UTC)
+		}
+	}
+
+	if t.MaxCarryOverDays > 0 {
+		prev, err := uc.balanceRepo.Find(ctx, e.ID, t.ID, year-1)
+		if err != nil && err.Error() != "leave balance not found" {
+			return nil, err
+		}
+		if prev != nil {
+			left := prev.Available(t.Accrual, annualDays, time.Date(year-1, time.December, 31, 0, 0, 0, 0, time.UTC))
+			b.CarriedOver = max(0, min(left, t.MaxCarryOverDays))
+		}
+		if b.CarriedOver > 0 && t.CarryOverMonths > 0 {
+			expires := time.Date(year, time.Month(t.CarryOverMonths)+1, 0, 0, 0, 0, 0, time.UTC)
+			b.CarryOverExpiresOn = &expires
+		}
+	}
+
+	if err := uc.balanceRepo.Create(ctx, b); err != nil {
+		return nil, fmt.Errorf("failed to create leave balance: %w", err)
+	}
+	return b, nil
+}
+
+// annualDays is the type's yearly entitlement, the company's
+// hr.leave.max_days when the type sets none.
+func (uc *UseCase) annualDays(ctx context.Context, t *leaveEntity.Type) (int, error) {
+	if t.AnnualDays != nil {
+		return *t.AnnualDays, nil
+	}
+	days, err := uc.settings.Int(ctx, t.CompanyID, leaveEntity.MaxDaysSetting)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read leave setting: %w", err)
+	}
+	return int(days), nil
+}
+
+func (uc *UseCase) balanceView(ctx context.Context, t *leaveEntity.Type, b *leaveEntity.Balance, asOf time.Time) (*BalanceView, error) {
+	annualDays, err := uc.annualDays(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	return &BalanceView{
+		Type:       t,
+		Balance:    b,
+		AnnualDays: annualDays,
+		AsOf:       asOf,
+		Accrued:    b.Accrued(t.Accrual, annualDays, asOf),
+		Expired:    b.Expired(asOf),
+		Available:  b.Available(t.Accrual, annualDays, asOf),
+	}, nil
+}
+
+// clamp moves date into year: to its first day for later years, its last
+// for earlier ones.
+func clamp(date time.Time, year int) time.Time {
+	switch {
+	case date.Year() < year:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	case date.Year() > year:
+		return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	}
+	return date
+}
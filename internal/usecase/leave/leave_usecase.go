@@ -0,0 +1,282 @@
+// Package leave manages leave types, yearly balances and leave requests
+// with their approval chains, and keeps employees ON_LEAVE while approved
+// leave runs.
+package leave
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	leaveEntity "github.com/haily-id/engine/internal/domain/entity/leave"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/holiday"
+	"github.com/haily-id/engine/internal/pkg/notify"
+	"github.com/haily-id/engine/internal/usecase/employee"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	dateLayout      = "2006-01-02"
+)
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+// CreateTypeRequest defines a leave type. Accrual defaults to ANNUAL;
+// annual_days defaults to the company's hr.leave.max_days.
+type CreateTypeRequest struct {
+	Code              string  `json:"code"                 validate:"required,min=1,max=50,alphanum"`
+	Name              string  `json:"name"                 validate:"required,min=2,max=255"`
+	Description       *string `json:"description"          validate:"omitempty,max=1000"`
+	IsPaid            *bool   `json:"is_paid"`
+	Accrual           string  `json:"accrual"              validate:"omitempty,oneof=ANNUAL MONTHLY NONE"`
+	AnnualDays        *int    `json:"annual_days"          validate:"omitempty,min=0,max=365"`
+	MaxCarryOverDays  int     `json:"max_carry_over_days"  validate:"min=0,max=365"`
+	CarryOverMonths   int     `json:"carry_over_months"    validate:"min=0,max=12"`
+	MaxDaysPerRequest *int    `json:"max_days_per_request" validate:"omitempty,min=1,max=365"`
+	RequiresApproval  *bool   `json:"requires_approval"`
+}
+
+// UpdateTypeRequest cannot change the code. Changes to the entitlement
+// apply to balances opened afterwards.
+type UpdateTypeRequest struct {
+	Name              *string `json:"name"                 validate:"omitempty,min=2,max=255"`
+	Description       *string `json:"description"          validate:"omitempty,max=1000"`
+	IsPaid            *bool   `json:"is_paid"`
+	Accrual           *string `json:"accrual"              validate:"omitempty,oneof=ANNUAL MONTHLY NONE"`
+	AnnualDays        *int    `json:"annual_days"          validate:"omitempty,min=0,max=365"`
+	MaxCarryOverDays  *int    `json:"max_carry_over_days"  validate:"omitempty,min=0,max=365"`
+	CarryOverMonths   *int    `json:"carry_over_months"    validate:"omitempty,min=0,max=12"`
+	MaxDaysPerRequest *int    `json:"max_days_per_request" validate:"omitempty,min=1,max=365"`
+	RequiresApproval  *bool   `json:"requires_approval"`
+	IsActive          *bool   `json:"is_active"`
+}
+
+// SubmitRequest asks for leave. EmployeeID defaults to the member's own
+// employee record; only owners and admins may file for someone else.
+type SubmitRequest struct {
+	EmployeeID  *string `json:"employee_id"   validate:"omitempty,numeric"`
+	LeaveTypeID string  `json:"leave_type_id" validate:"required,numeric"`
+	StartDate   string  `json:"start_date"    validate:"required,datetime=2006-01-02"`
+	EndDate     string  `json:"end_date"      validate:"required,datetime=2006-01-02"`
+	Reason      *string `json:"reason"        validate:"omitempty,max=500"`
+}
+
+type ApproveRequest struct {
+	Note *string `json:"note" validate:"omitempty,max=500"`
+}
+
+type RejectRequest struct {
+	Note string `json:"note" validate:"required,max=500"`
+}
+
+type ListRequest struct {
+	EmployeeID  string `query:"employee_id"   validate:"omitempty,numeric"`
+	LeaveTypeID string `query:"leave_type_id" validate:"omitempty,numeric"`
+	Status      string `query:"status"        validate:"omitempty,oneof=PENDING APPROVED REJECTED CANCELLED"`
+	From        string `query:"from"          validate:"omitempty,datetime=2006-01-02"`
+	To          string `query:"to"            validate:"omitempty,datetime=2006-01-02"`
+	Offset      int    `query:"offset"        validate:"min=0"`
+	Limit       int    `query:"limit"         validate:"min=0,max=100"`
+}
+
+// BalanceRequest selects the year of the balances, the current one in
+// the company's timezone by default.
+type BalanceRequest struct {
+	Year int `query:"year" validate:"omitempty,min=2000,max=2100"`
+}
+
+// AdjustBalanceRequest corrects a balance by Days, negative to take days
+// away.
+type AdjustBalanceRequest struct {
+	LeaveTypeID string `json:"leave_type_id" validate:"required,numeric"`
+	Year        int    `json:"year"          validate:"required,min=2000,max=2100"`
+	Days        int    `json:"days"          validate:"required,min=-365,max=365"`
+}
+
+type HolidayRequest struct {
+	Year int `query:"year" validate:"omitempty,min=2000,max=2100"`
+}
+
+// Actor is the member acting on leave. Owners and admins, Admin, manage
+// the leave of every employee; other members their own and the requests
+// they approve.
+type Actor struct {
+	UserID int64
+	Admin  bool
+}
+
+// ─── Results ────────────────────────────────────────────────────
+
+// BalanceView is a balance with its figures worked out as of AsOf: today
+// for the current year, the last day of past years and the first of
+// future ones.
+type BalanceView struct {
+	Type       *leaveEntity.Type
+	Balance    *leaveEntity.Balance
+	AnnualDays int
+	AsOf       time.Time
+	Accrued    int
+	Expired    int
+	Available  int
+}
+
+// RequestView is a request with its employee and leave type.
+type RequestView struct {
+	Request  leaveEntity.Request
+	Employee *employeeEntity.Employee
+	Type     *leaveEntity.Type
+}
+
+type Page struct {
+	Requests []RequestView
+	Total    int64
+	Offset   int
+	Limit    int
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	typeRepo       repository.LeaveTypeRepository
+	balanceRepo    repository.LeaveBalanceRepository
+	requestRepo    repository.LeaveRequestRepository
+	employeeRepo   repository.EmployeeRepository
+	companyRepo    repository.CompanyRepository
+	divisionRepo   repository.DivisionRepository
+	departmentRepo repository.DepartmentRepository
+	memberRepo     repository.UserCompanyRepository
+	transactor     repository.Transactor
+	calendar       *holiday.Calendar
+	employees      Employees
+	settings       Settings
+	notifier       notify.Notifier
+	auditor        audit.Recorder
+}
+
+// Employees flips the employment status as leave starts and ends, through
+// the same lifecycle actions as the employee endpoints.
+type Employees interface {
+	PutOnLeave(ctx context.Context, companyID, id int64, req employee.StatusChangeRequest) (*employeeEntity.Employee, error)
+	Reactivate(ctx context.Context, companyID, id int64, req employee.StatusChangeRequest) (*employeeEntity.Employee, error)
+}
+
+// Settings reads the default annual entitlement.
+type Settings interface {
+	Int(ctx context.Context, companyID int64, key string) (int64, error)
+}
+
+func NewUseCase(
+	typeRepo repository.LeaveTypeRepository,
+	balanceRepo repository.LeaveBalanceRepository,
+	requestRepo repository.LeaveRequestRepository,
+	employeeRepo repository.EmployeeRepository,
+	companyRepo repository.CompanyRepository,
+	divisionRepo repository.DivisionRepository,
+	departmentRepo repository.DepartmentRepository,
+	memberRepo repository.UserCompanyRepository,
+	transactor repository.Transactor,
+	calendar *holiday.Calendar,
+	employees Employees,
+	settings Settings,
+	notifier notify.Notifier,
+	auditor audit.Recorder,
+) *UseCase {
+	return &UseCase{
+		typeRepo:       typeRepo,
+		balanceRepo:    balanceRepo,
+		requestRepo:    requestRepo,
+		employeeRepo:   employeeRepo,
+		companyRepo:    companyRepo,
+		divisionRepo:   divisionRepo,
+		departmentRepo: departmentRepo,
+		memberRepo:     memberRepo,
+		transactor:     transactor,
+		calendar:       calendar,
+		employees:      employees,
+		settings:       settings,
+		notifier:       notifier,
+		auditor:        auditor,
+	}
+}
+
+// Holidays lists the public holidays of a year, the current one by
+// default.
+func (uc *UseCase) Holidays(ctx context.Context, companyID int64, req HolidayRequest) ([]holiday.Holiday, error) {
+	year := req.Year
+	if year == 0 {
+		c, err := uc.companyOf(ctx, companyID)
+		if err != nil {
+			return nil, err
+		}
+		year = c.Today().Year()
+	}
+	return uc.calendar.Year(year), nil
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+// companyOf loads the company, for its timezone and locale.
+func (uc *UseCase) companyOf(ctx context.Context, companyID int64) (*companyEntity.Company, error) {
+	c, err := uc.companyRepo.FindByID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load company: %w", err)
+	}
+	return c, nil
+}
+
+// self returns the actor's own employee record in the company.
+func (uc *UseCase) self(ctx context.Context, companyID int64, actor Actor) (*employeeEntity.Employee, error) {
+	e, err := uc.employeeRepo.FindByCompanyAndUser(ctx, companyID, actor.UserID)
+	if err != nil {
+		return nil, errors.New("employee not found")
+	}
+	return e, nil
+}
+
+// subject resolves the employee an action is about: the actor's own
+// record when id is empty, anyone's for owners and admins.
+func (uc *UseCase) subject(ctx context.Context, companyID int64, actor Actor, id *string) (*employeeEntity.Employee, error) {
+	if id == nil || *id == "" {
+		return uc.self(ctx, companyID, actor)
+	}
+	employeeID, _ := strconv.ParseInt(*id, 10, 64)
+	e, err := uc.employeeRepo.FindByID(ctx, employeeID)
+	if err != nil || e.CompanyID != companyID {
+		return nil, errors.New("employee not found")
+	}
+	if !actor.Admin && (e.UserID == nil || *e.UserID != actor.UserID) {
+		return nil, errors.New("leave access denied")
+	}
+	return e, nil
+}
+
+// parseDate reads a validated YYYY-MM-DD value; nil and empty mean none.
+func parseDate(s *string) *time.Time {
+	if s == nil || *s == "" {
+		return nil
+	}
+	t, err := time.Parse(dateLayout, *s)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+func optional(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := strings.TrimSpace(*s)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
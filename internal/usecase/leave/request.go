@@ -0,0 +1,606 @@
+package leave
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	leaveEntity "github.com/haily-id/engine/internal/domain/entity/leave"
+	"github.com/haily-id/engine/internal/domain/entity/rbac"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/i18n"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	"github.com/haily-id/engine/internal/pkg/notify"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+)
+
+// ─── Requests ───────────────────────────────────────────────────
+
+// Submit files a leave request. The days are the working days between the
+// dates, weekends and public holidays left out, and are held against the
+// balance until the request is decided, carried-over days first. Types
+// that need no approval are approved straight away.
+func (uc *UseCase) Submit(ctx context.Context, companyID int64, actor Actor, req SubmitRequest) (*RequestView, error) {
+	c, err := uc.companyOf(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	e, err := uc.subject(ctx, companyID, actor, req.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	if !e.HasAccess() {
+		return nil, errors.New("employee not active")
+	}
+
+	typeID, _ := strconv.ParseInt(req.LeaveTypeID, 10, 64)
+	t, err := uc.typeRepo.FindByID(ctx, companyID, typeID)
+	if err != nil {
+		return nil, err
+	}
+	if !t.IsActive {
+		return nil, errors.New("leave type inactive")
+	}
+
+	start, end := *parseDate(&req.StartDate), *parseDate(&req.EndDate)
+	if end.Before(start) {
+		return nil, errors.New("end date before start date")
+	}
+	if start.Year() != end.Year() {
+		return nil, errors.New("leave spans years")
+	}
+	// Without the year's holidays every weekday would count as a working
+	// day.
+	if !uc.calendar.Covers(start.Year()) {
+		return nil, errors.New("holiday calendar not available")
+	}
+	days := uc.calendar.WorkingDays(start, end)
+	if days == 0 {
+		return nil, errors.New("no working days in leave")
+	}
+	if t.MaxDaysPerRequest != nil && days > *t.MaxDaysPerRequest {
+		return nil, errors.New("leave exceeds max days per request")
+	}
+
+	overlapping, err := uc.requestRepo.ListOverlapping(ctx, e.ID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if len(overlapping) > 0 {
+		return nil, errors.New("leave overlaps")
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+	r := &leaveEntity.Request{
+		ID:          id,
+		CompanyID:   companyID,
+		EmployeeID:  e.ID,
+		LeaveTypeID: t.ID,
+		StartDate:   start,
+		EndDate:     end,
+		Days:        days,
+		Reason:      optional(req.Reason),
+		Status:      leaveEntity.RequestStatusPending,
+		RequestedBy: actor.UserID,
+	}
+	if t.RequiresApproval {
+		if r.Approvals, err = uc.chain(ctx, companyID, e, r.ID); err != nil {
+			return nil, err
+		}
+	} else {
+		now := time.Now()
+		r.Status = leaveEntity.RequestStatusApproved
+		r.DecidedAt = &now
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if t.HasBalance() {
+			annualDays, err := uc.annualDays(ctx, t)
+			if err != nil {
+				return err
+			}
+			b, err := uc.lock(ctx, e, t, start.Year())
+			if err != nil {
+				return err
+			}
+			if days > b.Available(t.Accrual, annualDays, start) {
+				return errors.New("insufficient leave balance")
+			}
+			r.BalanceID = &b.ID
+			r.CarryOverDays = min(days, b.CarryOverLeft(start))
+			b.CarryOverUsed += r.CarryOverDays
+			if r.Status == leaveEntity.RequestStatusApproved {
+				b.Used += days
+			} else {
+				b.Pending += days
+			}
+			if err := uc.balanceRepo.Update(ctx, b); err != nil {
+				return err
+			}
+		}
+		return uc.requestRepo.Create(ctx, r)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "leave_requests", r.ID, audit.ActionCreate, nil, r)
+
+	if r.Status == leaveEntity.RequestStatusApproved {
+		uc.sync(ctx, c, e.ID)
+	} else {
+		uc.notifyApprover(ctx, c, r, e, t)
+	}
+	return &RequestView{Request: *r, Employee: e, Type: t}, nil
+}
+
+// Get returns a request to its employee, its approvers and HR.
+func (uc *UseCase) Get(ctx context.Context, companyID int64, actor Actor, id int64) (*RequestView, error) {
+	r, err := uc.requestRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+	e, err := uc.employeeRepo.FindByID(ctx, r.EmployeeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load employee: %w", err)
+	}
+	if !actor.Admin && !ownedBy(e, actor) {
+		self, err := uc.self(ctx, companyID, actor)
+		if err != nil || !approvedBy(r, self.ID) {
+			return nil, errors.New("leave request not found")
+		}
+	}
+	t, err := uc.typeRepo.FindByID(ctx, companyID, r.LeaveTypeID)
+	if err != nil {
+		return nil, err
+	}
+	return &RequestView{Request: *r, Employee: e, Type: t}, nil
+}
+
+// List returns a page of requests, latest start first. Members other than
+// owners and admins only see their own.
+func (uc *UseCase) List(ctx context.Context, companyID int64, actor Actor, req ListRequest) (*Page, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	f := repository.LeaveRequestFilter{
+		CompanyID: companyID,
+		Status:    req.Status,
+		From:      parseDate(&req.From),
+		To:        parseDate(&req.To),
+	}
+	f.LeaveTypeID, _ = strconv.ParseInt(req.LeaveTypeID, 10, 64)
+	if actor.Admin {
+		f.EmployeeID, _ = strconv.ParseInt(req.EmployeeID, 10, 64)
+	} else {
+		self, err := uc.self(ctx, companyID, actor)
+		if err != nil {
+			return nil, err
+		}
+		f.EmployeeID = self.ID
+	}
+
+	list, total, err := uc.requestRepo.List(ctx, f, req.Offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	views, err := uc.views(ctx, companyID, list)
+	if err != nil {
+		return nil, err
+	}
+	return &Page{Requests: views, Total: total, Offset: req.Offset, Limit: limit}, nil
+}
+
+// Awaiting returns the pending requests whose current step is the actor's
+// to decide, oldest first.
+func (uc *UseCase) Awaiting(ctx context.Context, companyID int64, actor Actor) ([]RequestView, error) {
+	self, err := uc.self(ctx, companyID, actor)
+	if err != nil {
+		return nil, err
+	}
+	list, err := uc.requestRepo.ListAwaiting(ctx, companyID, self.ID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.views(ctx, companyID, list)
+}
+
+// Approve approves the current step of a pending request, as its approver
+// or as an owner or admin. The last step approves the request, moving its
+// days from pending to used.
+func (uc *UseCase) Approve(ctx context.Context, companyID int64, actor Actor, id int64, req ApproveRequest) (*RequestView, error) {
+	c, err := uc.companyOf(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *leaveEntity.Request
+	var before leaveEntity.Request
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if r, err = uc.decidable(ctx, companyID, actor, id); err != nil {
+			return err
+		}
+		before = *r
+		before.Approvals = append([]leaveEntity.Approval(nil), r.Approvals...)
+
+		now := time.Now()
+		if step := r.CurrentStep(); step != nil {
+			step.Status = leaveEntity.ApprovalStatusApproved
+			step.DecidedBy = &actor.UserID
+			step.DecidedAt = &now
+			step.Note = optional(req.Note)
+			if err := uc.requestRepo.UpdateApproval(ctx, step); err != nil {
+				return err
+			}
+		}
+		if r.CurrentStep() != nil {
+			return nil
+		}
+
+		r.Status = leaveEntity.RequestStatusApproved
+		r.DecidedAt = &now
+		r.DecisionNote = optional(req.Note)
+		if r.BalanceID != nil {
+			b, err := uc.balanceRepo.LockByID(ctx, *r.BalanceID)
+			if err != nil {
+				return err
+			}
+			b.Pending -= r.Days
+			b.Used += r.Days
+			if err := uc.balanceRepo.Update(ctx, b); err != nil {
+				return err
+			}
+		}
+		return uc.requestRepo.Update(ctx, r)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "leave_requests", r.ID, audit.ActionUpdate, before, r)
+
+	view, err := uc.view(ctx, companyID, r)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status == leaveEntity.RequestStatusApproved {
+		uc.notifyDecision(ctx, c, r, view.Employee, view.Type)
+		uc.sync(ctx, c, r.EmployeeID)
+	} else {
+		uc.notifyApprover(ctx, c, r, view.Employee, view.Type)
+	}
+	return view, nil
+}
+
+// Reject turns a pending request down at its current step and gives its
+// days back to the balance.
+func (uc *UseCase) Reject(ctx context.Context, companyID int64, actor Actor, id int64, req RejectRequest) (*RequestView, error) {
+	c, err := uc.companyOf(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *leaveEntity.Request
+	var before leaveEntity.Request
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if r, err = uc.decidable(ctx, companyID, actor, id); err != nil {
+			return err
+		}
+		before = *r
+		before.Approvals = append([]leaveEntity.Approval(nil), r.Approvals...)
+
+		now := time.Now()
+		note := optional(&req.Note)
+		if step := r.CurrentStep(); step != nil {
+			step.Status = leaveEntity.ApprovalStatusRejected
+			step.DecidedBy = &actor.UserID
+			step.DecidedAt = &now
+			step.Note = note
+			if err := uc.requestRepo.UpdateApproval(ctx, step); err != nil {
+				return err
+			}
+		}
+
+		r.Status = leaveEntity.RequestStatusRejected
+		r.DecidedAt = &now
+		r.DecisionNote = note
+		if err := uc.release(ctx, r, before.Status); err != nil {
+			return err
+		}
+		return uc.requestRepo.Update(ctx, r)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "leave_requests", r.ID, audit.ActionUpdate, before, r)
+
+	view, err := uc.view(ctx, companyID, r)
+	if err != nil {
+		return nil, err
+	}
+	uc.notifyDecision(ctx, c, r, view.Employee, view.Type)
+	return view, nil
+}
+
+// Cancel withdraws a request, as its employee or as an owner or admin.
+// Pending requests can always be cancelled, approved ones until the leave
+// starts. The days go back to the balance.
+func (uc *UseCase) Cancel(ctx context.Context, companyID int64, actor Actor, id int64) (*RequestView, error) {
+	c, err := uc.companyOf(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *leaveEntity.Request
+	var before leaveEntity.Request
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if r, err = uc.requestRepo.LockByID(ctx, companyID, id); err != nil {
+			return err
+		}
+		if !actor.Admin {
+			e, err := uc.employeeRepo.FindByID(ctx, r.EmployeeID)
+			if err != nil || !ownedBy(e, actor) {
+				return errors.New("leave request not found")
+			}
+		}
+		switch r.Status {
+		case leaveEntity.RequestStatusPending:
+		case leaveEntity.RequestStatusApproved:
+			if !c.Today().Before(r.StartDate) {
+				return errors.New("leave already started")
+			}
+		default:
+			return errors.New("leave request not cancellable")
+		}
+		before = *r
+
+		now := time.Now()
+		r.Status = leaveEntity.RequestStatusCancelled
+		r.DecidedAt = &now
+		if err := uc.release(ctx, r, before.Status); err != nil {
+			return err
+		}
+		return uc.requestRepo.Update(ctx, r)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "leave_requests", r.ID, audit.ActionUpdate, before, r)
+	return uc.view(ctx, companyID, r)
+}
+
+// ─── Request helpers ────────────────────────────────────────────
+
+// decidable locks a pending request the actor may decide: owners and
+// admins any, approvers the ones at their step.
+func (uc *UseCase) decidable(ctx context.Context, companyID int64, actor Actor, id int64) (*leaveEntity.Request, error) {
+	r, err := uc.requestRepo.LockByID(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status != leaveEntity.RequestStatusPending {
+		return nil, errors.New("leave request not pending")
+	}
+	if actor.Admin {
+		return r, nil
+	}
+	step := r.CurrentStep()
+	if step == nil {
+		return nil, errors.New("not an approver")
+	}
+	self, err := uc.self(ctx, companyID, actor)
+	if err != nil || self.ID != step.ApproverID {
+		return nil, errors.New("not an approver")
+	}
+	return r, nil
+}
+
+// release gives the days of a request that was in status back to its
+// balance.
+func (uc *UseCase) release(ctx context.Context, r *leaveEntity.Request, status string) error {
+	if r.BalanceID == nil {
+		return nil
+	}
+	b, err := uc.balanceRepo.LockByID(ctx, *r.BalanceID)
+	if err != nil {
+		return err
+	}
+	if status == leaveEntity.RequestStatusApproved {
+		b.Used -= r.Days
+	} else {
+		b.Pending -= r.Days
+	}
+	b.CarryOverUsed -= r.CarryOverDays
+	return uc.balanceRepo.Update(ctx, b)
+}
+
+// chain builds the approval chain of an employee's request: the head of
+// their department, then the head of their division, that of their
+// department's division when they have none. Heads who are the employee
+// themselves, have no account or no longer work there are skipped.
+func (uc *UseCase) chain(ctx context.Context, companyID int64, e *employeeEntity.Employee, requestID int64) ([]leaveEntity.Approval, error) {
+	var heads []*int64
+	divisionID := e.DivisionID
+	if e.DepartmentID != nil {
+		d, err := uc.departmentRepo.FindByID(ctx, companyID, *e.DepartmentID)
+		if err == nil {
+			heads = append(heads, d.HeadEmployeeID)
+			if divisionID == nil {
+				divisionID = d.DivisionID
+			}
+		}
+	}
+	if divisionID != nil {
+		if d, err := uc.divisionRepo.FindByID(ctx, companyID, *divisionID); err == nil {
+			heads = append(heads, d.HeadEmployeeID)
+		}
+	}
+
+	var chain []leaveEntity.Approval
+	seen := map[int64]bool{e.ID: true}
+	for _, headID := range heads {
+		if headID == nil || seen[*headID] {
+			continue
+		}
+		seen[*headID] = true
+		head, err := uc.employeeRepo.FindByID(ctx, *headID)
+		if err != nil || head.CompanyID != companyID || !head.HasAccess() || head.UserID == nil {
+			continue
+		}
+		id, err := snowflake.Generate()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate ID: %w", err)
+		}
+		chain = append(chain, leaveEntity.Approval{
+			ID:         id,
+			RequestID:  requestID,
+			Level:      len(chain) + 1,
+			ApproverID: head.ID,
+			Status:     leaveEntity.ApprovalStatusPending,
+		})
+	}
+	return chain, nil
+}
+
+func (uc *UseCase) view(ctx context.Context, companyID int64, r *leaveEntity.Request) (*RequestView, error) {
+	e, err := uc.employeeRepo.FindByID(ctx, r.EmployeeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load employee: %w", err)
+	}
+	t, err := uc.typeRepo.FindByID(ctx, companyID, r.LeaveTypeID)
+	if err != nil {
+		return nil, err
+	}
+	return &RequestView{Request: *r, Employee: e, Type: t}, nil
+}
+
+// views attaches employees and types to a list of requests.
+func (uc *UseCase) views(ctx context.Context, companyID int64, list []leaveEntity.Request) ([]RequestView, error) {
+	ids := make([]int64, 0, len(list))
+	for _, r := range list {
+		ids = append(ids, r.EmployeeID)
+	}
+	employees, err := uc.employeeRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	types, err := uc.typeRepo.ListByCompany(ctx, companyID, false)
+	if err != nil {
+		return nil, err
+	}
+	employeesByID := make(map[int64]*employeeEntity.Employee, len(employees))
+	for i := range employees {
+		employeesByID[employees[i].ID] = &employees[i]
+	}
+	typesByID := make(map[int64]*leaveEntity.Type, len(types))
+	for i := range types {
+		typesByID[types[i].ID] = &types[i]
+	}
+
+	views := make([]RequestView, 0, len(list))
+	for _, r := range list {
+		views = append(views, RequestView{
+			Request:  r,
+			Employee: employeesByID[r.EmployeeID],
+			Type:     typesByID[r.LeaveTypeID],
+		})
+	}
+	return views, nil
+}
+
+func ownedBy(e *employeeEntity.Employee, actor Actor) bool {
+	return e.UserID != nil && *e.UserID == actor.UserID
+}
+
+func approvedBy(r *leaveEntity.Request, employeeID int64) bool {
+	for _, a := range r.Approvals {
+		if a.ApproverID == employeeID {
+			return true
+		}
+	}
+	return false
+}
+
+// ─── Notifications ──────────────────────────────────────────────
+
+// notifyApprover tells the approver of a pending request's current step
+// about it, or the company's owners and admins when it has no chain.
+func (uc *UseCase) notifyApprover(ctx context.Context, c *companyEntity.Company, r *leaveEntity.Request, e *employeeEntity.Employee, t *leaveEntity.Type) {
+	var userIDs []int64
+	if step := r.CurrentStep(); step != nil {
+		approver, err := uc.employeeRepo.FindByID(ctx, step.ApproverID)
+		if err != nil {
+			logger.Errorf("Failed to load approver %d of leave request %d: %v", step.ApproverID, r.ID, err)
+			return
+		}
+		if approver.UserID != nil {
+			userIDs = append(userIDs, *approver.UserID)
+		}
+	} else {
+		members, err := uc.memberRepo.ListActiveByRoles(ctx, c.ID, []string{rbac.RoleOwner, rbac.RoleAdmin})
+		if err != nil {
+			logger.Errorf("Failed to list admins of company %d for leave request: %v", c.ID, err)
+			return
+		}
+		for _, m := range members {
+			if e.UserID == nil || m.UserID != *e.UserID {
+				userIDs = append(userIDs, m.UserID)
+			}
+		}
+	}
+
+	content := i18n.LeaveRequestNotification(c.Name, e.Name, t.Name, r.StartDate, r.EndDate, r.Days, i18n.Detect(c.Locale))
+	for _, userID := range userIDs {
+		uc.notifier.Notify(ctx, notify.Notice{
+			UserID:    userID,
+			CompanyID: c.ID,
+			Type:      notify.TypeLeaveRequest,
+			Title:     content.Title,
+			Body:      content.Body,
+			Data:      requestData(r),
+		})
+	}
+}
+
+// notifyDecision tells the employee their request was approved or
+// rejected.
+func (uc *UseCase) notifyDecision(ctx context.Context, c *companyEntity.Company, r *leaveEntity.Request, e *employeeEntity.Employee, t *leaveEntity.Type) {
+	if e.UserID == nil {
+		return
+	}
+	approved := r.Status == leaveEntity.RequestStatusApproved
+	content := i18n.LeaveDecisionNotification(c.Name, t.Name, r.StartDate, r.EndDate, approved, i18n.Detect(c.Locale))
+	uc.notifier.Notify(ctx, notify.Notice{
+		UserID:    *e.UserID,
+		CompanyID: c.ID,
+		Type:      notify.TypeLeaveDecision,
+		Title:     content.Title,
+		Body:      content.Body,
+		Data:      requestData(r),
+	})
+}
+
+func requestData(r *leaveEntity.Request) map[string]string {
+	return map[string]string{
+		"leave_request_id": strconv.FormatInt(r.ID, 10),
+		"employee_id":      strconv.FormatInt(r.EmployeeID, 10),
+	}
+}
@@ -0,0 +1,119 @@
+package leave
+
+import (
+	"context"
+	"time"
+
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	leaveEntity "github.com/haily-id/engine/internal/domain/entity/leave"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	"github.com/haily-id/engine/internal/usecase/employee"
+)
+
+// ─── Employment status ──────────────────────────────────────────
+
+// SyncStatus puts employees whose approved leave runs today ON_LEAVE and
+// returns those whose leave ended or was cancelled to ACTIVE. Only leave
+// that put an employee ON_LEAVE sets them back, so statuses HR set by hand
+// are left alone. It runs hourly from the worker, as each company's day
+// starts in its own timezone.
+func (uc *UseCase) SyncStatus(ctx context.Context) error {
+	now := time.Now().UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	list, err := uc.requestRepo.ListForStatusSync(ctx, 0, day.AddDate(0, 0, -1), day.AddDate(0, 0, 1))
+	if err != nil {
+		return err
+	}
+
+	byEmployee := make(map[int64][]leaveEntity.Request)
+	var order []int64
+	for _, r := range list {
+		if _, ok := byEmployee[r.EmployeeID]; !ok {
+			order = append(order, r.EmployeeID)
+		}
+		byEmployee[r.EmployeeID] = append(byEmployee[r.EmployeeID], r)
+	}
+
+	companies := make(map[int64]*companyEntity.Company)
+	for _, employeeID := range order {
+		requests := byEmployee[employeeID]
+		c, ok := companies[requests[0].CompanyID]
+		if !ok {
+			if c, err = uc.companyOf(ctx, requests[0].CompanyID); err != nil {
+				logger.Errorf("Leave status sync skipped employee %d: %v", employeeID, err)
+				continue
+			}
+			companies[c.ID] = c
+		}
+		uc.apply(ctx, c, employeeID, requests)
+	}
+	return nil
+}
+
+// sync brings one employee's status in line with their leave right after
+// a request is approved.
+func (uc *UseCase) sync(ctx context.Context, c *companyEntity.Company, employeeID int64) {
+	d := c.Today()
+	requests, err := uc.requestRepo.ListForStatusSync(ctx, employeeID, d, d)
+	if err != nil {
+		logger.Errorf("Failed to list leave of employee %d for status sync: %v", employeeID, err)
+		return
+	}
+	uc.apply(ctx, c, employeeID, requests)
+}
+
+// apply moves an employee to ON_LEAVE while one of their approved
+// requests covers today and back to ACTIVE after, marking the requests
+// that hold them ON_LEAVE. When leave is taken over by the next request,
+// the status stays and the mark moves along. Failures are logged.
+func (uc *UseCase) apply(ctx context.Context, c *companyEntity.Company, employeeID int64, requests []leaveEntity.Request) {
+	e, err := uc.employeeRepo.FindByID(ctx, employeeID)
+	if err != nil {
+		logger.Errorf("Failed to load employee %d for leave status sync: %v", employeeID, err)
+		return
+	}
+
+	d := c.Today()
+	effective := d.Format(dateLayout)
+	change := employee.StatusChangeRequest{EffectiveDate: &effective}
+
+	applied := false
+	var covering []*leaveEntity.Request
+	for i := range requests {
+		r := &requests[i]
+		applied = applied || r.StatusApplied
+		if r.Status == leaveEntity.RequestStatusApproved && r.ActiveOn(d) {
+			covering = append(covering, r)
+		}
+	}
+
+	hold := false
+	switch {
+	case len(covering) > 0 && e.EmploymentStatus == employeeEntity.EmploymentStatusActive:
+		if _, err := uc.employees.PutOnLeave(ctx, c.ID, e.ID, change); err != nil {
+			logger.Errorf("Failed to put employee %d on leave: %v", e.ID, err)
+			return
+		}
+		hold = true
+	case len(covering) > 0 && e.EmploymentStatus == employeeEntity.EmploymentStatusOnLeave:
+		hold = applied
+	case len(covering) == 0 && applied && e.EmploymentStatus == employeeEntity.EmploymentStatusOnLeave:
+		if _, err := uc.employees.Reactivate(ctx, c.ID, e.ID, change); err != nil {
+			logger.Errorf("Failed to reactivate employee %d after leave: %v", e.ID, err)
+			return
+		}
+	}
+
+	for i := range requests {
+		r := &requests[i]
+		want := hold && r.Status == leaveEntity.RequestStatusApproved && r.ActiveOn(d)
+		if r.StatusApplied == want {
+			continue
+		}
+		if err := uc.requestRepo.SetStatusApplied(ctx, r.ID, want); err != nil {
+			logger.Errorf("Failed to mark leave request %d: %v", r.ID, err)
+		}
+	}
+}
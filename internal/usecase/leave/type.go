@@ -0,0 +1,142 @@
+package leave
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	leaveEntity "github.com/haily-id/engine/internal/domain/entity/leave"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+)
+
+// ─── Leave Types ────────────────────────────────────────────────
+
+func (uc *UseCase) ListTypes(ctx context.Context, companyID int64, activeOnly bool) ([]leaveEntity.Type, error) {
+	return uc.typeRepo.ListByCompany(ctx, companyID, activeOnly)
+}
+
+func (uc *UseCase) GetType(ctx context.Context, companyID, id int64) (*leaveEntity.Type, error) {
+	return uc.typeRepo.FindByID(ctx, companyID, id)
+}
+
+func (uc *UseCase) CreateType(ctx context.Context, companyID int64, req CreateTypeRequest) (*leaveEntity.Type, error) {
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	if existing, _ := uc.typeRepo.FindByCode(ctx, companyID, code); existing != nil {
+		return nil, errors.New("leave type code already exists")
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	t := &leaveEntity.Type{
+		ID:                id,
+		CompanyID:         companyID,
+		Code:              code,
+		Name:              strings.TrimSpace(req.Name),
+		Description:       req.Description,
+		IsPaid:            true,
+		Accrual:           leaveEntity.AccrualAnnual,
+		AnnualDays:        req.AnnualDays,
+		MaxCarryOverDays:  req.MaxCarryOverDays,
+		CarryOverMonths:   req.CarryOverMonths,
+		MaxDaysPerRequest: req.MaxDaysPerRequest,
+		RequiresApproval:  true,
+		IsActive:          true,
+	}
+	if req.IsPaid != nil {
+		t.IsPaid = *req.IsPaid
+	}
+	if req.Accrual != "" {
+		t.Accrual = req.Accrual
+	}
+	if req.RequiresApproval != nil {
+		t.RequiresApproval = *req.RequiresApproval
+	}
+	if err := uc.typeRepo.Create(ctx, t); err != nil {
+		return nil, fmt.Errorf("failed to create leave type: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "leave_types", t.ID, audit.ActionCreate, nil, t)
+	return t, nil
+}
+
+// UpdateType changes a leave type. Balances already opened keep the
+// entitlement they were opened with.
+func (uc *UseCase) UpdateType(ctx context.Context, companyID, id int64, req UpdateTypeRequest) (*leaveEntity.Type, error) {
+	t, err := uc.typeRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *t
+
+	if req.Name != nil {
+		t.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		t.Description = req.Description
+	}
+	if req.IsPaid != nil {
+		t.IsPaid = *req.IsPaid
+	}
+	if req.Accrual != nil {
+		t.Accrual = *req.Accrual
+	}
+	if req.AnnualDays != nil {
+		t.AnnualDays = req.AnnualDays
+	}
+	if req.MaxCarryOverDays != nil {
+		t.MaxCarryOverDays = *req.MaxCarryOverDays
+	}
+	if req.CarryOverMonths != nil {
+		t.CarryOverMonths = *req.CarryOverMonths
+	}
+	if req.MaxDaysPerRequest != nil {
+		t.MaxDaysPerRequest = req.MaxDaysPerRequest
+	}
+	if req.RequiresApproval != nil {
+		t.RequiresApproval = *req.RequiresApproval
+	}
+	if req.IsActive != nil {
+		t.IsActive = *req.IsActive
+	}
+
+	if err := uc.typeRepo.Update(ctx, t); err != nil {
+		return nil, fmt.Errorf("failed to update leave type: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "leave_types", t.ID, audit.ActionUpdate, before, t)
+	return t, nil
+}
+
+// DeleteType removes a leave type with no requests awaiting approval.
+// Decided requests keep pointing at it.
+func (uc *UseCase) DeleteType(ctx context.Context, companyID, id int64) error {
+	t, err := uc.typeRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return err
+	}
+
+	_, pending, err := uc.requestRepo.List(ctx, repository.LeaveRequestFilter{
+		CompanyID:   companyID,
+		LeaveTypeID: t.ID,
+		Status:      leaveEntity.RequestStatusPending,
+	}, 0, 1)
+	if err != nil {
+		return err
+	}
+	if pending > 0 {
+		return errors.New("leave type in use")
+	}
+
+	if err := uc.typeRepo.Delete(ctx, t); err != nil {
+		return fmt.Errorf("failed to delete leave type: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "leave_types", t.ID, audit.ActionDelete, t, nil)
+	return nil
+}
@@ -0,0 +1,71 @@
+package notification
+
+import "sync"
+
+// subscriberBuffer is how many events a slow stream may fall behind before
+// further events for it are dropped. Clients resync from the list endpoint.
+const subscriberBuffer = 16
+
+// hub hands events received from pub/sub to the streams open on this
+// process.
+type hub struct {
+	mu     sync.Mutex
+	subs   map[int64]map[chan Event]struct{}
+	closed bool
+}
+
+func newHub() *hub {
+	return &hub{subs: make(map[int64]map[chan Event]struct{})}
+}
+
+func (h *hub) subscribe(userID int64) (chan Event, func()) {
+	ch := make(chan Event, subscriberBuffer)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		close(ch)
+		return ch, func() {}
+	}
+	if h.subs[userID] == nil {
+		h.subs[userID] = make(map[chan Event]struct{})
+	}
+	h.subs[userID][ch] = struct{}{}
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, ok := h.subs[userID][ch]; !ok {
+			return
+		}
+		delete(h.subs[userID], ch)
+		if len(h.subs[userID]) == 0 {
+			delete(h.subs, userID)
+		}
+		close(ch)
+	}
+}
+
+func (h *hub) dispatch(ev Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subs[ev.UserID] {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+// close ends every open stream; later subscriptions get a closed channel.
+func (h *hub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for userID, chans := range h.subs {
+		for ch := range chans {
+			close(ch)
+		}
+		delete(h.subs, userID)
+	}
+}
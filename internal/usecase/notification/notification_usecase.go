@@ -0,0 +1,192 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	notificationEntity "github.com/haily-id/engine/internal/domain/entity/notification"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	"github.com/haily-id/engine/internal/pkg/notify"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+	redisCache "github.com/haily-id/engine/internal/repository/redis"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+
+	resubscribeDelay = 5 * time.Second
+)
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+type ListRequest struct {
+	UnreadOnly bool `query:"unread"`
+	Offset     int  `query:"offset" validate:"omitempty,gte=0"`
+	Limit      int  `query:"limit"  validate:"omitempty,min=1,max=100"`
+}
+
+// ─── Results ────────────────────────────────────────────────────
+
+type Page struct {
+	Notifications []notificationEntity.Notification
+	Total         int64
+	Offset        int
+	Limit         int
+}
+
+// Event is what streams receive: a new notification, or only a changed
+// unread count after notifications were read.
+type Event struct {
+	UserID       int64                            `json:"user_id"`
+	Notification *notificationEntity.Notification `json:"notification,omitempty"`
+	UnreadCount  int64                            `json:"unread_count"`
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	notificationRepo repository.NotificationRepository
+	pubsub           repository.PubSub
+	hub              *hub
+}
+
+func NewUseCase(
+	notificationRepo repository.NotificationRepository,
+	pubsub repository.PubSub,
+) *UseCase {
+	return &UseCase{
+		notificationRepo: notificationRepo,
+		pubsub:           pubsub,
+		hub:              newHub(),
+	}
+}
+
+// Notify stores n and publishes it to the user's open streams on every API
+// process.
+func (uc *UseCase) Notify(ctx context.Context, n notify.Notice) {
+	id, err := snowflake.Generate()
+	if err != nil {
+		logger.Errorf("Failed to generate notification ID: %v", err)
+		return
+	}
+
+	row := &notificationEntity.Notification{
+		ID:        id,
+		UserID:    n.UserID,
+		Type:      n.Type,
+		Title:     n.Title,
+		Body:      n.Body,
+		CreatedAt: time.Now(),
+	}
+	if n.CompanyID != 0 {
+		row.CompanyID = &n.CompanyID
+	}
+	if len(n.Data) > 0 {
+		data, err := json.Marshal(n.Data)
+		if err != nil {
+			logger.Errorf("Failed to encode notification data: %v", err)
+			return
+		}
+		text := string(data)
+		row.Data = &text
+	}
+
+	if err := uc.notificationRepo.Create(ctx, row); err != nil {
+		logger.Errorf("Failed to create %s notification for user %d: %v", n.Type, n.UserID, err)
+		return
+	}
+
+	uc.publish(ctx, n.UserID, row)
+}
+
+func (uc *UseCase) List(ctx context.Context, userID int64, req ListRequest) (*Page, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	list, total, err := uc.notificationRepo.ListByUser(ctx, userID, req.UnreadOnly, req.Offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &Page{Notifications: list, Total: total, Offset: req.Offset, Limit: limit}, nil
+}
+
+func (uc *UseCase) UnreadCount(ctx context.Context, userID int64) (int64, error) {
+	return uc.notificationRepo.CountUnread(ctx, userID)
+}
+
+func (uc *UseCase) MarkRead(ctx context.Context, userID, id int64) error {
+	if err := uc.notificationRepo.MarkRead(ctx, userID, id, time.Now()); err != nil {
+		return err
+	}
+	uc.publish(ctx, userID, nil)
+	return nil
+}
+
+func (uc *UseCase) MarkAllRead(ctx context.Context, userID int64) error {
+	changed, err := uc.notificationRepo.MarkAllRead(ctx, userID, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	if changed > 0 {
+		uc.publish(ctx, userID, nil)
+	}
+	return nil
+}
+
+// Subscribe opens a stream of the user's events on this process. The
+// channel is closed by the returned cancel function or when Run stops.
+func (uc *UseCase) Subscribe(userID int64) (<-chan Event, func()) {
+	return uc.hub.subscribe(userID)
+}
+
+// Run receives events published by any process and hands them to the
+// streams open here, until ctx is done. Every API process runs it once.
+func (uc *UseCase) Run(ctx context.Context) {
+	defer uc.hub.close()
+
+	for ctx.Err() == nil {
+		messages, err := uc.pubsub.Subscribe(ctx, redisCache.NotificationChannel())
+		if err != nil {
+			logger.Errorf("Failed to subscribe to notifications, retrying: %v", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(resubscribeDelay):
+			}
+			continue
+		}
+
+		for data := range messages {
+			var ev Event
+			if err := json.Unmarshal(data, &ev); err != nil {
+				logger.Errorf("Failed to decode notification event: %v", err)
+				continue
+			}
+			uc.hub.dispatch(ev)
+		}
+	}
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func (uc *UseCase) publish(ctx context.Context, userID int64, n *notificationEntity.Notification) {
+	count, err := uc.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		logger.Errorf("Failed to count unread notifications for user %d: %v", userID, err)
+		return
+	}
+
+	ev := Event{UserID: userID, Notification: n, UnreadCount: count}
+	if err := uc.pubsub.Publish(ctx, redisCache.NotificationChannel(), ev); err != nil {
+		logger.Errorf("Failed to publish notification event for user %d: %v", userID, err)
+	}
+}
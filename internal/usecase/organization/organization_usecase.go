@@ -0,0 +1,597 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	orgEntity "github.com/haily-id/engine/internal/domain/entity/organization"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+)
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+type CreateDivisionRequest struct {
+	Name           string  `json:"name"             validate:"required,min=2,max=255"`
+	Code           string  `json:"code"             validate:"required,min=1,max=50,alphanum"`
+	Description    *string `json:"description"      validate:"omitempty,max=1000"`
+	HeadEmployeeID *string `json:"head_employee_id" validate:"omitempty,numeric"`
+}
+
+// UpdateDivisionRequest cannot change the code. An empty head_employee_id
+// removes the head.
+type UpdateDivisionRequest struct {
+	Name           *string `json:"name"             validate:"omitempty,min=2,max=255"`
+	Description    *string `json:"description"      validate:"omitempty,max=1000"`
+	HeadEmployeeID *string `json:"head_employee_id" validate:"omitempty,numeric"`
+	IsActive       *bool   `json:"is_active"`
+}
+
+type CreateDepartmentRequest struct {
+	DivisionID     *string `json:"division_id"      validate:"omitempty,numeric"`
+	Name           string  `json:"name"             validate:"required,min=2,max=255"`
+	Code           string  `json:"code"             validate:"required,min=1,max=50,alphanum"`
+	Description    *string `json:"description"      validate:"omitempty,max=1000"`
+	HeadEmployeeID *string `json:"head_employee_id" validate:"omitempty,numeric"`
+}
+
+// UpdateDepartmentRequest cannot change the code. An empty division_id or
+// head_employee_id removes the division or head.
+type UpdateDepartmentRequest struct {
+	DivisionID     *string `json:"division_id"      validate:"omitempty,numeric"`
+	Name           *string `json:"name"             validate:"omitempty,min=2,max=255"`
+	Description    *string `json:"description"      validate:"omitempty,max=1000"`
+	HeadEmployeeID *string `json:"head_employee_id" validate:"omitempty,numeric"`
+	IsActive       *bool   `json:"is_active"`
+}
+
+type CreatePositionRequest struct {
+	DepartmentID string  `json:"department_id" validate:"required,numeric"`
+	Title        string  `json:"title"         validate:"required,min=2,max=255"`
+	Code         string  `json:"code"          validate:"required,min=1,max=50,alphanum"`
+	Level        string  `json:"level"         validate:"required,oneof=JUNIOR MID SENIOR LEAD MANAGER DIRECTOR"`
+	Description  *string `json:"description"   validate:"omitempty,max=1000"`
+}
+
+// UpdatePositionRequest cannot change the code.
+type UpdatePositionRequest struct {
+	DepartmentID *string `json:"department_id" validate:"omitempty,numeric"`
+	Title        *string `json:"title"         validate:"omitempty,min=2,max=255"`
+	Level        *string `json:"level"         validate:"omitempty,oneof=JUNIOR MID SENIOR LEAD MANAGER DIRECTOR"`
+	Description  *string `json:"description"   validate:"omitempty,max=1000"`
+	IsActive     *bool   `json:"is_active"`
+}
+
+// ─── Results ────────────────────────────────────────────────────
+
+// Tree is a company's whole structure. Departments without a division are
+// listed at the top level next to the divisions.
+type Tree struct {
+	Divisions   []DivisionNode
+	Departments []DepartmentNode
+}
+
+type DivisionNode struct {
+	Division    *orgEntity.Division
+	Departments []DepartmentNode
+}
+
+type DepartmentNode struct {
+	Department *orgEntity.Department
+	Positions  []orgEntity.Position
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	divisionRepo         repository.DivisionRepository
+	departmentRepo       repository.DepartmentRepository
+	positionRepo         repository.PositionRepository
+	employeeRepo         repository.EmployeeRepository
+	employeePositionRepo repository.EmployeePositionRepository
+	auditor              audit.Recorder
+}
+
+func NewUseCase(
+	divisionRepo repository.DivisionRepository,
+	departmentRepo repository.DepartmentRepository,
+	positionRepo repository.PositionRepository,
+	employeeRepo repository.EmployeeRepository,
+	employeePositionRepo repository.EmployeePositionRepository,
+	auditor audit.Recorder,
+) *UseCase {
+	return &UseCase{
+		divisionRepo:         divisionRepo,
+		departmentRepo:       departmentRepo,
+		positionRepo:         positionRepo,
+		employeeRepo:         employeeRepo,
+		employeePositionRepo: employeePositionRepo,
+		auditor:              auditor,
+	}
+}
+
+// ─── Divisions ──────────────────────────────────────────────────
+
+func (uc *UseCase) ListDivisions(ctx context.Context, companyID int64, activeOnly bool) ([]orgEntity.Division, error) {
+	return uc.divisionRepo.ListByCompany(ctx, companyID, activeOnly)
+}
+
+func (uc *UseCase) GetDivision(ctx context.Context, companyID, id int64) (*orgEntity.Division, error) {
+	return uc.divisionRepo.FindByID(ctx, companyID, id)
+}
+
+func (uc *UseCase) CreateDivision(ctx context.Context, companyID int64, req CreateDivisionRequest) (*orgEntity.Division, error) {
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	if existing, _ := uc.divisionRepo.FindByCode(ctx, companyID, code); existing != nil {
+		return nil, errors.New("division code already exists")
+	}
+
+	headID, err := uc.checkHead(ctx, companyID, req.HeadEmployeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	d := &orgEntity.Division{
+		ID:             id,
+		CompanyID:      companyID,
+		Name:           strings.TrimSpace(req.Name),
+		Code:           code,
+		Description:    req.Description,
+		HeadEmployeeID: headID,
+		IsActive:       true,
+	}
+	if err := uc.divisionRepo.Create(ctx, d); err != nil {
+		return nil, fmt.Errorf("failed to create division: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "divisions", d.ID, audit.ActionCreate, nil, d)
+	return d, nil
+}
+
+// UpdateDivision changes a division. It cannot be deactivated while
+// employees who are not terminated are assigned to it.
+func (uc *UseCase) UpdateDivision(ctx context.Context, companyID, id int64, req UpdateDivisionRequest) (*orgEntity.Division, error) {
+	d, err := uc.divisionRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *d
+
+	if req.Name != nil {
+		d.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		d.Description = req.Description
+	}
+	if req.HeadEmployeeID != nil {
+		if d.HeadEmployeeID, err = uc.checkHead(ctx, companyID, req.HeadEmployeeID); err != nil {
+			return nil, err
+		}
+	}
+	if req.IsActive != nil {
+		if d.IsActive && !*req.IsActive {
+			count, err := uc.employeeRepo.CountActiveByDivision(ctx, d.ID)
+			if err != nil {
+				return nil, err
+			}
+			if count > 0 {
+				return nil, errors.New("division has active employees")
+			}
+		}
+		d.IsActive = *req.IsActive
+	}
+
+	if err := uc.divisionRepo.Update(ctx, d); err != nil {
+		return nil, fmt.Errorf("failed to update division: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "divisions", d.ID, audit.ActionUpdate, before, d)
+	return d, nil
+}
+
+// DeleteDivision removes a division that has no departments and no employees
+// who are not terminated.
+func (uc *UseCase) DeleteDivision(ctx context.Context, companyID, id int64) error {
+	d, err := uc.divisionRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return err
+	}
+
+	count, err := uc.employeeRepo.CountActiveByDivision(ctx, d.ID)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.New("division has active employees")
+	}
+
+	departments, err := uc.departmentRepo.CountByDivision(ctx, d.ID)
+	if err != nil {
+		return err
+	}
+	if departments > 0 {
+		return errors.New("division in use")
+	}
+
+	if err := uc.divisionRepo.Delete(ctx, d); err != nil {
+		return fmt.Errorf("failed to delete division: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "divisions", d.ID, audit.ActionDelete, d, nil)
+	return nil
+}
+
+// ─── Departments ────────────────────────────────────────────────
+
+// ListDepartments returns the company's departments, only those of one
+// division when divisionID is not 0.
+func (uc *UseCase) ListDepartments(ctx context.Context, companyID, divisionID int64, activeOnly bool) ([]orgEntity.Department, error) {
+	return uc.departmentRepo.ListByCompany(ctx, companyID, divisionID, activeOnly)
+}
+
+func (uc *UseCase) GetDepartment(ctx context.Context, companyID, id int64) (*orgEntity.Department, error) {
+	return uc.departmentRepo.FindByID(ctx, companyID, id)
+}
+
+func (uc *UseCase) CreateDepartment(ctx context.Context, companyID int64, req CreateDepartmentRequest) (*orgEntity.Department, error) {
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	if existing, _ := uc.departmentRepo.FindByCode(ctx, companyID, code); existing != nil {
+		return nil, errors.New("department code already exists")
+	}
+
+	divisionID, err := uc.checkDivision(ctx, companyID, req.DivisionID)
+	if err != nil {
+		return nil, err
+	}
+	headID, err := uc.checkHead(ctx, companyID, req.HeadEmployeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	d := &orgEntity.Department{
+		ID:             id,
+		CompanyID:      companyID,
+		DivisionID:     divisionID,
+		Name:           strings.TrimSpace(req.Name),
+		Code:           code,
+		Description:    req.Description,
+		HeadEmployeeID: headID,
+		IsActive:       true,
+	}
+	if err := uc.departmentRepo.Create(ctx, d); err != nil {
+		return nil, fmt.Errorf("failed to create department: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "departments", d.ID, audit.ActionCreate, nil, d)
+	return d, nil
+}
+
+// UpdateDepartment changes a department. It cannot be deactivated while
+// employees who are not terminated are assigned to it.
+func (uc *UseCase) UpdateDepartment(ctx context.Context, companyID, id int64, req UpdateDepartmentRequest) (*orgEntity.Department, error) {
+	d, err := uc.departmentRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *d
+
+	if req.DivisionID != nil {
+		if d.DivisionID, err = uc.checkDivision(ctx, companyID, req.DivisionID); err != nil {
+			return nil, err
+		}
+	}
+	if req.Name != nil {
+		d.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		d.Description = req.Description
+	}
+	if req.HeadEmployeeID != nil {
+		if d.HeadEmployeeID, err = uc.checkHead(ctx, companyID, req.HeadEmployeeID); err != nil {
+			return nil, err
+		}
+	}
+	if req.IsActive != nil {
+		if d.IsActive && !*req.IsActive {
+			count, err := uc.employeeRepo.CountActiveByDepartment(ctx, d.ID)
+			if err != nil {
+				return nil, err
+			}
+			if count > 0 {
+				return nil, errors.New("department has active employees")
+			}
+		}
+		d.IsActive = *req.IsActive
+	}
+
+	if err := uc.departmentRepo.Update(ctx, d); err != nil {
+		return nil, fmt.Errorf("failed to update department: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "departments", d.ID, audit.ActionUpdate, before, d)
+	return d, nil
+}
+
+// DeleteDepartment removes a department that has no positions and no
+// employees who are not terminated.
+func (uc *UseCase) DeleteDepartment(ctx context.Context, companyID, id int64) error {
+	d, err := uc.departmentRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return err
+	}
+
+	count, err := uc.employeeRepo.CountActiveByDepartment(ctx, d.ID)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.New("department has active employees")
+	}
+
+	positions, err := uc.positionRepo.CountByDepartment(ctx, d.ID)
+	if err != nil {
+		return err
+	}
+	if positions > 0 {
+		return errors.New("department in use")
+	}
+
+	if err := uc.departmentRepo.Delete(ctx, d); err != nil {
+		return fmt.Errorf("failed to delete department: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "departments", d.ID, audit.ActionDelete, d, nil)
+	return nil
+}
+
+// ─── Positions ──────────────────────────────────────────────────
+
+// ListPositions returns the company's positions, only those of one
+// department when departmentID is not 0.
+func (uc *UseCase) ListPositions(ctx context.Context, companyID, departmentID int64, activeOnly bool) ([]orgEntity.Position, error) {
+	return uc.positionRepo.ListByCompany(ctx, companyID, departmentID, activeOnly)
+}
+
+func (uc *UseCase) GetPosition(ctx context.Context, companyID, id int64) (*orgEntity.Position, error) {
+	return uc.positionRepo.FindByID(ctx, companyID, id)
+}
+
+func (uc *UseCase) CreatePosition(ctx context.Context, companyID int64, req CreatePositionRequest) (*orgEntity.Position, error) {
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	if existing, _ := uc.positionRepo.FindByCode(ctx, companyID, code); existing != nil {
+		return nil, errors.New("position code already exists")
+	}
+
+	departmentID, err := uc.checkDepartment(ctx, companyID, req.DepartmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	p := &orgEntity.Position{
+		ID:           id,
+		CompanyID:    companyID,
+		DepartmentID: departmentID,
+		Title:        strings.TrimSpace(req.Title),
+		Code:         code,
+		Level:        req.Level,
+		Description:  req.Description,
+		IsActive:     true,
+	}
+	if err := uc.positionRepo.Create(ctx, p); err != nil {
+		return nil, fmt.Errorf("failed to create position: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "positions", p.ID, audit.ActionCreate, nil, p)
+	return p, nil
+}
+
+// UpdatePosition changes a position. It cannot be deactivated while
+// employees who are not terminated currently hold it.
+func (uc *UseCase) UpdatePosition(ctx context.Context, companyID, id int64, req UpdatePositionRequest) (*orgEntity.Position, error) {
+	p, err := uc.positionRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *p
+
+	if req.DepartmentID != nil {
+		if p.DepartmentID, err = uc.checkDepartment(ctx, companyID, *req.DepartmentID); err != nil {
+			return nil, err
+		}
+	}
+	if req.Title != nil {
+		p.Title = strings.TrimSpace(*req.Title)
+	}
+	if req.Level != nil {
+		p.Level = *req.Level
+	}
+	if req.Description != nil {
+		p.Description = req.Description
+	}
+	if req.IsActive != nil {
+		if p.IsActive && !*req.IsActive {
+			count, err := uc.employeePositionRepo.CountActiveByPosition(ctx, p.ID)
+			if err != nil {
+				return nil, err
+			}
+			if count > 0 {
+				return nil, errors.New("position has active employees")
+			}
+		}
+		p.IsActive = *req.IsActive
+	}
+
+	if err := uc.positionRepo.Update(ctx, p); err != nil {
+		return nil, fmt.Errorf("failed to update position: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "positions", p.ID, audit.ActionUpdate, before, p)
+	return p, nil
+}
+
+// DeletePosition removes a position no employee who is not terminated
+// currently holds.
+func (uc *UseCase) DeletePosition(ctx context.Context, companyID, id int64) error {
+	p, err := uc.positionRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return err
+	}
+
+	count, err := uc.employeePositionRepo.CountActiveByPosition(ctx, p.ID)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.New("position has active employees")
+	}
+
+	if err := uc.positionRepo.Delete(ctx, p); err != nil {
+		return fmt.Errorf("failed to delete position: %w", err)
+	}
+
+	audit.RecordHR(ctx, uc.auditor, companyID, "positions", p.ID, audit.ActionDelete, p, nil)
+	return nil
+}
+
+// ─── Tree ───────────────────────────────────────────────────────
+
+// Tree returns the company's divisions, departments and positions nested in
+// one structure. Unless includeInactive is set, inactive entries are left
+// out together with everything below them.
+func (uc *UseCase) Tree(ctx context.Context, companyID int64, includeInactive bool) (*Tree, error) {
+	activeOnly := !includeInactive
+
+	divisions, err := uc.divisionRepo.ListByCompany(ctx, companyID, activeOnly)
+	if err != nil {
+		return nil, err
+	}
+	departments, err := uc.departmentRepo.ListByCompany(ctx, companyID, 0, activeOnly)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := uc.positionRepo.ListByCompany(ctx, companyID, 0, activeOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	positionsByDepartment := make(map[int64][]orgEntity.Position)
+	for _, p := range positions {
+		positionsByDepartment[p.DepartmentID] = append(positionsByDepartment[p.DepartmentID], p)
+	}
+
+	divisionIndex := make(map[int64]int, len(divisions))
+	tree := &Tree{
+		Divisions:   make([]DivisionNode, 0, len(divisions)),
+		Departments: []DepartmentNode{},
+	}
+	for i := range divisions {
+		divisionIndex[divisions[i].ID] = i
+		tree.Divisions = append(tree.Divisions, DivisionNode{
+			Division:    &divisions[i],
+			Departments: []DepartmentNode{},
+		})
+	}
+
+	for i := range departments {
+		d := &departments[i]
+		node := DepartmentNode{Department: d, Positions: positionsByDepartment[d.ID]}
+		if node.Positions == nil {
+			node.Positions = []orgEntity.Position{}
+		}
+
+		if d.DivisionID == nil {
+			tree.Departments = append(tree.Departments, node)
+			continue
+		}
+		// Departments of a division that was left out are left out too.
+		if idx, ok := divisionIndex[*d.DivisionID]; ok {
+			tree.Divisions[idx].Departments = append(tree.Divisions[idx].Departments, node)
+		}
+	}
+
+	return tree, nil
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+// checkHead resolves a head employee ID from a request. Nil and empty IDs
+// mean no head.
+func (uc *UseCase) checkHead(ctx context.Context, companyID int64, raw *string) (*int64, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+	id, err := strconv.ParseInt(*raw, 10, 64)
+	if err != nil {
+		return nil, errors.New("employee not found")
+	}
+
+	e, err := uc.employeeRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if e.CompanyID != companyID {
+		return nil, errors.New("employee not found")
+	}
+	if e.EmploymentStatus == employeeEntity.EmploymentStatusTerminated {
+		return nil, errors.New("employee not active")
+	}
+	return &e.ID, nil
+}
+
+// checkDivision resolves an active division of the company from a request.
+// Nil and empty IDs mean no division.
+func (uc *UseCase) checkDivision(ctx context.Context, companyID int64, raw *string) (*int64, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+	id, err := strconv.ParseInt(*raw, 10, 64)
+	if err != nil {
+		return nil, errors.New("division not found")
+	}
+
+	d, err := uc.divisionRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !d.IsActive {
+		return nil, errors.New("division not available")
+	}
+	return &d.ID, nil
+}
+
+// checkDepartment resolves an active department of the company.
+func (uc *UseCase) checkDepartment(ctx context.Context, companyID int64, raw string) (int64, error) {
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, errors.New("department not found")
+	}
+
+	d, err := uc.departmentRepo.FindByID(ctx, companyID, id)
+	if err != nil {
+		return 0, err
+	}
+	if !d.IsActive {
+		return 0, errors.New("department not available")
+	}
+	return d.ID, nil
+}
@@ -0,0 +1,296 @@
+package orgchart
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	orgEntity "github.com/haily-id/engine/internal/domain/entity/organization"
+	"github.com/haily-id/engine/internal/domain/repository"
+)
+
+const (
+	FormatJSON    = "json"
+	FormatDOT     = "dot"
+	FormatMermaid = "mermaid"
+
+	HeadOfDivision   = "DIVISION"
+	HeadOfDepartment = "DEPARTMENT"
+)
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+// ChartRequest narrows the chart to one subtree: everyone reporting to an
+// employee, or the members of a division or department. At most one
+// filter applies, in that order.
+type ChartRequest struct {
+	EmployeeID   string `query:"employee_id"   validate:"omitempty,numeric"`
+	DivisionID   string `query:"division_id"   validate:"omitempty,numeric"`
+	DepartmentID string `query:"department_id" validate:"omitempty,numeric"`
+	Format       string `query:"format"        validate:"omitempty,oneof=json dot mermaid"`
+}
+
+// ─── Results ────────────────────────────────────────────────────
+
+// Node is one employee in the chart. Position, Department and Division are
+// nil when unknown. HeadOf is set for division and department heads.
+type Node struct {
+	Employee   *employeeEntity.Employee
+	Position   *orgEntity.Position
+	Department *orgEntity.Department
+	Division   *orgEntity.Division
+	HeadOf     string
+	Reports    []*Node
+
+	managerID int64
+}
+
+// Chart is a reporting forest; employees nobody manages are roots.
+type Chart struct {
+	Roots []*Node
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	divisionRepo         repository.DivisionRepository
+	departmentRepo       repository.DepartmentRepository
+	positionRepo         repository.PositionRepository
+	employeeRepo         repository.EmployeeRepository
+	employeePositionRepo repository.EmployeePositionRepository
+}
+
+func NewUseCase(
+	divisionRepo repository.DivisionRepository,
+	departmentRepo repository.DepartmentRepository,
+	positionRepo repository.PositionRepository,
+	employeeRepo repository.EmployeeRepository,
+	employeePositionRepo repository.EmployeePositionRepository,
+) *UseCase {
+	return &UseCase{
+		divisionRepo:         divisionRepo,
+		departmentRepo:       departmentRepo,
+		positionRepo:         positionRepo,
+		employeeRepo:         employeeRepo,
+		employeePositionRepo: employeePositionRepo,
+	}
+}
+
+// Build derives the reporting hierarchy of the company's employees who are
+// not terminated:
+//
+//   - division heads report to nobody;
+//   - department heads report to the head of their division;
+//   - everyone else reports to the head of the department of their primary
+//     position (or, without one, of their own department), falling back to
+//     the division head.
+//
+// Only active divisions, departments and positions are considered.
+func (uc *UseCase) Build(ctx context.Context, companyID int64, req ChartRequest) (*Chart, error) {
+	employees, err := uc.employeeRepo.ListActiveByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	assignments, err := uc.employeePositionRepo.ListCurrentPrimaryByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	divisionList, err := uc.divisionRepo.ListByCompany(ctx, companyID, true)
+	if err != nil {
+		return nil, err
+	}
+	departmentList, err := uc.departmentRepo.ListByCompany(ctx, companyID, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	positionList, err := uc.positionRepo.ListByCompany(ctx, companyID, 0, true)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[int64]*Node, len(employees))
+	for i := range employees {
+		nodes[employees[i].ID] = &Node{Employee: &employees[i]}
+	}
+
+	divisions := make(map[int64]*orgEntity.Division, len(divisionList))
+	divisionHeads := make(map[int64]*orgEntity.Division)
+	for i := range divisionList {
+		d := &divisionList[i]
+		divisions[d.ID] = d
+		if head := headID(d.HeadEmployeeID, nodes); head != 0 && divisionHeads[head] == nil {
+			divisionHeads[head] = d
+		}
+	}
+
+	departments := make(map[int64]*orgEntity.Department, len(departmentList))
+	departmentHeads := make(map[int64]*orgEntity.Department)
+	for i := range departmentList {
+		d := &departmentList[i]
+		departments[d.ID] = d
+		if head := headID(d.HeadEmployeeID, nodes); head != 0 && departmentHeads[head] == nil {
+			departmentHeads[head] = d
+		}
+	}
+
+	positions := make(map[int64]*orgEntity.Position, len(positionList))
+	for i := range positionList {
+		positions[positionList[i].ID] = &positionList[i]
+	}
+
+	// Later assignments win, so each employee keeps the most recent one.
+	for _, a := range assignments {
+		if n, ok := nodes[a.EmployeeID]; ok {
+			n.Position = positions[a.PositionID]
+		}
+	}
+
+	for _, n := range nodes {
+		e := n.Employee
+		switch {
+		case n.Position != nil:
+			n.Department = departments[n.Position.DepartmentID]
+		case e.DepartmentID != nil:
+			n.Department = departments[*e.DepartmentID]
+		}
+		if d := departmentHeads[e.ID]; d != nil {
+			n.Department = d
+			n.HeadOf = HeadOfDepartment
+		}
+
+		if n.Department != nil && n.Department.DivisionID != nil {
+			n.Division = divisions[*n.Department.DivisionID]
+		} else if n.Department == nil && e.DivisionID != nil {
+			n.Division = divisions[*e.DivisionID]
+		}
+		if d := divisionHeads[e.ID]; d != nil {
+			n.Division = d
+			n.HeadOf = HeadOfDivision
+		}
+	}
+
+	for _, n := range nodes {
+		n.managerID = manager(n, nodes)
+	}
+
+	members, err := filter(req, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	chart := &Chart{}
+	for _, n := range members {
+		if parent, ok := members[n.managerID]; ok && n.managerID != 0 {
+			parent.Reports = append(parent.Reports, n)
+		} else {
+			chart.Roots = append(chart.Roots, n)
+		}
+	}
+	if req.EmployeeID != "" {
+		id, _ := strconv.ParseInt(req.EmployeeID, 10, 64)
+		chart.Roots = []*Node{members[id]}
+	}
+
+	sortNodes(chart.Roots)
+	return chart, nil
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+// headID returns the head employee's ID when they are in the chart.
+func headID(id *int64, nodes map[int64]*Node) int64 {
+	if id == nil {
+		return 0
+	}
+	if _, ok := nodes[*id]; !ok {
+		return 0
+	}
+	return *id
+}
+
+func manager(n *Node, nodes map[int64]*Node) int64 {
+	if n.HeadOf == HeadOfDivision {
+		return 0
+	}
+
+	candidates := make([]*int64, 0, 2)
+	if n.HeadOf != HeadOfDepartment && n.Department != nil {
+		candidates = append(candidates, n.Department.HeadEmployeeID)
+	}
+	if n.Division != nil {
+		candidates = append(candidates, n.Division.HeadEmployeeID)
+	}
+
+	for _, c := range candidates {
+		if id := headID(c, nodes); id != 0 && id != n.Employee.ID {
+			return id
+		}
+	}
+	return 0
+}
+
+// filter returns the employees in the requested subtree, all of them when
+// no filter is set.
+func filter(req ChartRequest, nodes map[int64]*Node) (map[int64]*Node, error) {
+	switch {
+	case req.EmployeeID != "":
+		id, err := strconv.ParseInt(req.EmployeeID, 10, 64)
+		if err != nil {
+			return nil, errors.New("employee not found")
+		}
+		if _, ok := nodes[id]; !ok {
+			return nil, errors.New("employee not found")
+		}
+
+		children := make(map[int64][]int64)
+		for _, n := range nodes {
+			children[n.managerID] = append(children[n.managerID], n.Employee.ID)
+		}
+		members := make(map[int64]*Node)
+		queue := []int64{id}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			if _, seen := members[current]; seen {
+				continue
+			}
+			members[current] = nodes[current]
+			queue = append(queue, children[current]...)
+		}
+		return members, nil
+
+	case req.DivisionID != "":
+		id, _ := strconv.ParseInt(req.DivisionID, 10, 64)
+		return keep(nodes, func(n *Node) bool { return n.Division != nil && n.Division.ID == id }), nil
+
+	case req.DepartmentID != "":
+		id, _ := strconv.ParseInt(req.DepartmentID, 10, 64)
+		return keep(nodes, func(n *Node) bool { return n.Department != nil && n.Department.ID == id }), nil
+	}
+	return nodes, nil
+}
+
+func keep(nodes map[int64]*Node, fn func(*Node) bool) map[int64]*Node {
+	members := make(map[int64]*Node)
+	for id, n := range nodes {
+		if fn(n) {
+			members[id] = n
+		}
+	}
+	return members
+}
+
+// sortNodes orders every level by name so output is stable.
+func sortNodes(nodes []*Node) {
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Employee.Name != nodes[j].Employee.Name {
+			return nodes[i].Employee.Name < nodes[j].Employee.Name
+		}
+		return nodes[i].Employee.ID < nodes[j].Employee.ID
+	})
+	for _, n := range nodes {
+		sortNodes(n.Reports)
+	}
+}
@@ -0,0 +1,93 @@
+package orgchart
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteDOT writes the chart as a Graphviz digraph.
+func WriteDOT(w io.Writer, c *Chart) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph OrgChart {")
+	fmt.Fprintln(bw, "    rankdir=TB;")
+	fmt.Fprintln(bw, `    node [shape=box, style="rounded,filled", fillcolor="#ffffff", fontname="Helvetica"];`)
+
+	walk(c.Roots, func(n *Node) {
+		fmt.Fprintf(bw, "    %s [label=\"%s\"];\n", nodeID(n), dotEscape(label(n)))
+	})
+	walk(c.Roots, func(n *Node) {
+		for _, r := range n.Reports {
+			fmt.Fprintf(bw, "    %s -> %s;\n", nodeID(n), nodeID(r))
+		}
+	})
+
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// WriteMermaid writes the chart as a Mermaid flowchart.
+func WriteMermaid(w io.Writer, c *Chart) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "flowchart TD")
+
+	walk(c.Roots, func(n *Node) {
+		fmt.Fprintf(bw, "    %s[\"%s\"]\n", nodeID(n), mermaidEscape(label(n)))
+	})
+	walk(c.Roots, func(n *Node) {
+		for _, r := range n.Reports {
+			fmt.Fprintf(bw, "    %s --> %s\n", nodeID(n), nodeID(r))
+		}
+	})
+
+	return bw.Flush()
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func walk(nodes []*Node, fn func(*Node)) {
+	for _, n := range nodes {
+		fn(n)
+		walk(n.Reports, fn)
+	}
+}
+
+func nodeID(n *Node) string {
+	return fmt.Sprintf("e%d", n.Employee.ID)
+}
+
+// label is the employee's name over their title: the position title, or
+// the unit they head, or their department.
+func label(n *Node) []string {
+	lines := []string{n.Employee.Name}
+	switch {
+	case n.Position != nil:
+		lines = append(lines, n.Position.Title)
+	case n.HeadOf == HeadOfDivision:
+		lines = append(lines, "Head of "+n.Division.Name)
+	case n.HeadOf == HeadOfDepartment:
+		lines = append(lines, "Head of "+n.Department.Name)
+	case n.Department != nil:
+		lines = append(lines, n.Department.Name)
+	}
+	return lines
+}
+
+func dotEscape(lines []string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", " ")
+	escaped := make([]string, len(lines))
+	for i, l := range lines {
+		escaped[i] = r.Replace(l)
+	}
+	return strings.Join(escaped, `\n`)
+}
+
+func mermaidEscape(lines []string) string {
+	r := strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;", "\n", " ")
+	escaped := make([]string, len(lines))
+	for i, l := range lines {
+		escaped[i] = r.Replace(l)
+	}
+	return strings.Join(escaped, "<br/>")
+}
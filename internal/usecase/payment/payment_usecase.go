@@ -0,0 +1,348 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	billingEntity "github.com/haily-id/engine/internal/domain/entity/billing"
+	subscriptionEntity "github.com/haily-id/engine/internal/domain/entity/subscription"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	"github.com/haily-id/engine/internal/pkg/payment"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+)
+
+// vaLifetime is how long a virtual account stays open for an invoice that
+// is already overdue.
+const vaLifetime = 24 * time.Hour
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+type PayRequest struct {
+	Method    string `json:"method"     validate:"required,oneof=VA CREDIT_CARD"`
+	BankCode  string `json:"bank_code"  validate:"required_if=Method VA,max=10"`
+	CardToken string `json:"card_token" validate:"required_if=Method CREDIT_CARD,max=255"`
+}
+
+// ─── Results ────────────────────────────────────────────────────
+
+// PayResult tells the client how to complete a payment. For virtual
+// accounts the invoice is settled later by webhook; card charges settle
+// immediately when the provider approves them.
+type PayResult struct {
+	Invoice        *billingEntity.Invoice
+	VirtualAccount *payment.VirtualAccount
+	ChargeStatus   string
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	invoiceRepo repository.InvoiceRepository
+	eventRepo   repository.PaymentEventRepository
+	subRepo     repository.CompanySubscriptionRepository
+	companyRepo repository.CompanyRepository
+	transactor  repository.Transactor
+	provider    payment.Provider
+}
+
+func NewUseCase(
+	invoiceRepo repository.InvoiceRepository,
+	eventRepo repository.PaymentEventRepository,
+	subRepo repository.CompanySubscriptionRepository,
+	companyRepo repository.CompanyRepository,
+	transactor repository.Transactor,
+	provider payment.Provider,
+) *UseCase {
+	return &UseCase{
+		invoiceRepo: invoiceRepo,
+		eventRepo:   eventRepo,
+		subRepo:     subRepo,
+		companyRepo: companyRepo,
+		transactor:  transactor,
+		provider:    provider,
+	}
+}
+
+// Pay starts paying an invoice through the provider, either by opening a
+// virtual account or by charging a tokenised card. The invoice stays
+// locked while the provider is called, so a concurrent payment of it waits
+// and then sees the outcome instead of charging again.
+func (uc *UseCase) Pay(ctx context.Context, companyID, invoiceID int64, req PayRequest) (*PayResult, error) {
+	var result *PayResult
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		inv, err := uc.invoiceRepo.LockByID(ctx, invoiceID)
+		if err != nil || inv.CompanyID != companyID {
+			return errors.New("invoice not found")
+		}
+		if !inv.IsPayable() {
+			return errors.New("invoice is not payable")
+		}
+		if inv.PaymentMethod != nil && *inv.PaymentMethod == billingEntity.PaymentMethodCreditCard && inv.PaymentReference != nil {
+			return errors.New("payment in progress")
+		}
+
+		switch req.Method {
+		case billingEntity.PaymentMethodVA:
+			result, err = uc.openVirtualAccount(ctx, inv, req)
+		case billingEntity.PaymentMethodCreditCard:
+			result, err = uc.chargeCard(ctx, inv, req)
+		default:
+			err = errors.New("payment method not supported")
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// HandleWebhook verifies and applies a provider webhook. Events already
+// seen are acknowledged without doing anything, so provider retries are
+// safe. Events that cannot settle an invoice are recorded with the reason
+// and acknowledged too; only signature and parse failures are rejected.
+func (uc *UseCase) HandleWebhook(ctx context.Context, provider string, body []byte, header http.Header) error {
+	if provider != uc.provider.Name() {
+		return errors.New("unknown payment provider")
+	}
+	if err := uc.provider.VerifyWebhook(body, header); err != nil {
+		return errors.New("invalid signature")
+	}
+
+	event, err := uc.provider.ParseEvent(body)
+	if err != nil {
+		return errors.New("invalid payment event")
+	}
+
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		record := &billingEntity.PaymentEvent{
+			Provider:    provider,
+			EventID:     event.ID,
+			Type:        event.Type,
+			Amount:      event.Amount,
+			Payload:     string(body),
+			Result:      billingEntity.EventResultRecorded,
+			ProcessedAt: now,
+		}
+
+		var inv *billingEntity.Invoice
+		if invoiceID, err := strconv.ParseInt(event.Reference, 10, 64); err == nil {
+			inv, _ = uc.invoiceRepo.LockByID(ctx, invoiceID)
+		}
+
+		settle, release := false, false
+		switch {
+		case inv == nil:
+			record.Result = billingEntity.EventResultUnknownInvoice
+		case event.Type != payment.EventPaymentSucceeded:
+			record.InvoiceID = &inv.ID
+			// A pending card charge that failed or expired no longer
+			// blocks paying the invoice again.
+			release = inv.IsPayable() && inv.PaymentReference != nil && *inv.PaymentReference == event.ProviderReference
+		case inv.Status == billingEntity.InvoiceStatusPaid:
+			record.InvoiceID = &inv.ID
+			record.Result = billingEntity.EventResultAlreadyPaid
+		case !inv.IsPayable():
+			record.InvoiceID = &inv.ID
+			record.Result = billingEntity.EventResultNotPayable
+		case event.Amount != inv.TotalAmount:
+			record.InvoiceID = &inv.ID
+			record.Result = billingEntity.EventResultAmountMismatch
+		default:
+			record.InvoiceID = &inv.ID
+			record.Result = billingEntity.EventResultSettled
+			settle = true
+		}
+
+		id, err := snowflake.Generate()
+		if err != nil {
+			return fmt.Errorf("failed to generate ID: %w", err)
+		}
+		record.ID = id
+
+		created, err := uc.eventRepo.CreateIfAbsent(ctx, record)
+		if err != nil {
+			return fmt.Errorf("failed to record payment event: %w", err)
+		}
+		if !created {
+			logger.Infof("Ignoring duplicate %s payment event %s", provider, event.ID)
+			return nil
+		}
+
+		if record.Result != billingEntity.EventResultSettled && record.Result != billingEntity.EventResultRecorded {
+			logger.Errorf("Payment event %s not applied: %s", event.ID, record.Result)
+		}
+		if release {
+			return uc.setPayment(ctx, inv, nil, nil)
+		}
+		if !settle {
+			return nil
+		}
+
+		method := event.Method
+		if method == "" && inv.PaymentMethod != nil {
+			method = *inv.PaymentMethod
+		}
+		return uc.settle(ctx, inv, method, event.ProviderReference, now)
+	})
+}
+
+// SimulatePayment pays an invoice in full through the provider's simulator
+// and the regular webhook path. Only providers implementing
+// payment.Simulator, such as the fake one, support it.
+func (uc *UseCase) SimulatePayment(ctx context.Context, companyID, invoiceID int64) (*billingEntity.Invoice, error) {
+	sim, ok := uc.provider.(payment.Simulator)
+	if !ok {
+		return nil, errors.New("payment simulation not supported")
+	}
+
+	inv, err := uc.findPayable(ctx, companyID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	method := billingEntity.PaymentMethodVA
+	if inv.PaymentMethod != nil {
+		method = *inv.PaymentMethod
+	}
+
+	body, header, err := sim.SimulatePayment(strconv.FormatInt(inv.ID, 10), inv.TotalAmount, method)
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate payment: %w", err)
+	}
+	if err := uc.HandleWebhook(ctx, uc.provider.Name(), body, header); err != nil {
+		return nil, err
+	}
+
+	return uc.invoiceRepo.FindByID(ctx, inv.ID)
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func (uc *UseCase) findPayable(ctx context.Context, companyID, invoiceID int64) (*billingEntity.Invoice, error) {
+	inv, err := uc.invoiceRepo.FindByID(ctx, invoiceID)
+	if err != nil || inv.CompanyID != companyID {
+		return nil, errors.New("invoice not found")
+	}
+	if !inv.IsPayable() {
+		return nil, errors.New("invoice is not payable")
+	}
+	return inv, nil
+}
+
+// openVirtualAccount opens a virtual account for the locked invoice inv.
+func (uc *UseCase) openVirtualAccount(ctx context.Context, inv *billingEntity.Invoice, req PayRequest) (*PayResult, error) {
+	c, err := uc.companyRepo.FindByID(ctx, inv.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	expires := time.Now().Add(vaLifetime)
+	if inv.DueAt != nil && inv.DueAt.After(expires) {
+		expires = *inv.DueAt
+	}
+
+	va, err := uc.provider.CreateVirtualAccount(ctx, payment.VirtualAccountRequest{
+		Reference: strconv.FormatInt(inv.ID, 10),
+		BankCode:  req.BankCode,
+		Name:      c.LegalName,
+		Amount:    inv.TotalAmount,
+		ExpiresAt: expires,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create virtual account: %w", err)
+	}
+
+	method := billingEntity.PaymentMethodVA
+	if err := uc.setPayment(ctx, inv, &method, &va.Number); err != nil {
+		return nil, err
+	}
+	return &PayResult{Invoice: inv, VirtualAccount: va, ChargeStatus: payment.ChargePending}, nil
+}
+
+// chargeCard charges a card for the locked invoice inv. An approved charge
+// settles the invoice; a pending one is recorded on it and blocks further
+// payments until its webhook arrives.
+func (uc *UseCase) chargeCard(ctx context.Context, inv *billingEntity.Invoice, req PayRequest) (*PayResult, error) {
+	charge, err := uc.provider.ChargeCard(ctx, payment.CardChargeRequest{
+		Reference: strconv.FormatInt(inv.ID, 10),
+		Token:     req.CardToken,
+		Amount:    inv.TotalAmount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to charge card: %w", err)
+	}
+
+	switch charge.Status {
+	case payment.ChargeFailed:
+		logger.Infof("Card charge for invoice %d failed: %s", inv.ID, charge.FailureReason)
+		return nil, errors.New("payment failed")
+	case payment.ChargeSucceeded:
+		if err := uc.settle(ctx, inv, billingEntity.PaymentMethodCreditCard, charge.ProviderReference, time.Now()); err != nil {
+			return nil, err
+		}
+	default:
+		method := billingEntity.PaymentMethodCreditCard
+		if err := uc.setPayment(ctx, inv, &method, &charge.ProviderReference); err != nil {
+			return nil, err
+		}
+	}
+	return &PayResult{Invoice: inv, ChargeStatus: charge.Status}, nil
+}
+
+// setPayment records how inv is being paid, writing only those columns.
+func (uc *UseCase) setPayment(ctx context.Context, inv *billingEntity.Invoice, method, reference *string) error {
+	if err := uc.invoiceRepo.UpdatePayment(ctx, inv.ID, method, reference); err != nil {
+		return fmt.Errorf("failed to update invoice: %w", err)
+	}
+	inv.PaymentMethod = method
+	inv.PaymentReference = reference
+	return nil
+}
+
+// settle marks inv paid and brings a past-due subscription back to active
+// once none of its invoices are overdue. It runs inside a transaction with
+// inv locked.
+func (uc *UseCase) settle(ctx context.Context, inv *billingEntity.Invoice, method, reference string, now time.Time) error {
+	inv.Status = billingEntity.InvoiceStatusPaid
+	inv.PaidAt = &now
+	if method != "" {
+		inv.PaymentMethod = &method
+	}
+	if reference != "" {
+		inv.PaymentReference = &reference
+	}
+	if err := uc.invoiceRepo.Update(ctx, inv); err != nil {
+		return fmt.Errorf("failed to update invoice: %w", err)
+	}
+
+	sub, err := uc.subRepo.FindByID(ctx, inv.SubscriptionID)
+	if err != nil {
+		return err
+	}
+	if sub.Status != subscriptionEntity.StatusPastDue {
+		return nil
+	}
+
+	overdue, err := uc.invoiceRepo.ListByCompany(ctx, inv.CompanyID, billingEntity.InvoiceStatusOverdue)
+	if err != nil {
+		return err
+	}
+	for _, o := range overdue {
+		if o.SubscriptionID == sub.ID && o.ID != inv.ID {
+			return nil
+		}
+	}
+
+	sub.Status = subscriptionEntity.StatusActive
+	if err := uc.subRepo.Update(ctx, sub); err != nil {
+		return fmt.Errorf("failed to update subscription: %w", err)
+	}
+	return nil
+}
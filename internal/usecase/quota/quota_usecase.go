@@ -0,0 +1,163 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	subscriptionEntity "github.com/haily-id/engine/internal/domain/entity/subscription"
+	"github.com/haily-id/engine/internal/domain/repository"
+)
+
+// ─── Results ────────────────────────────────────────────────────
+
+// Usage is a company's consumption against its plan. A limit of 0 means
+// unlimited.
+type Usage struct {
+	Plan            *subscriptionEntity.Plan
+	Users           int64
+	MaxUsers        int64
+	Employees       int64
+	MaxEmployees    int64
+	StorageBytes    int64
+	MaxStorageBytes int64
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	companyRepo  repository.CompanyRepository
+	memberRepo   repository.UserCompanyRepository
+	employeeRepo repository.EmployeeRepository
+	usageRepo    repository.CompanyUsageRepository
+	subRepo      repository.CompanySubscriptionRepository
+	planRepo     repository.SubscriptionPlanRepository
+}
+
+func NewUseCase(
+	companyRepo repository.CompanyRepository,
+	memberRepo repository.UserCompanyRepository,
+	employeeRepo repository.EmployeeRepository,
+	usageRepo repository.CompanyUsageRepository,
+	subRepo repository.CompanySubscriptionRepository,
+	planRepo repository.SubscriptionPlanRepository,
+) *UseCase {
+	return &UseCase{
+		companyRepo:  companyRepo,
+		memberRepo:   memberRepo,
+		employeeRepo: employeeRepo,
+		usageRepo:    usageRepo,
+		subRepo:      subRepo,
+		planRepo:     planRepo,
+	}
+}
+
+// ReserveUserSeat fails with "user limit reached" when the company already
+// has as many active members as its plan allows. It must run inside the
+// transaction that creates the membership: the company row stays locked
+// until that transaction ends, so concurrent joins cannot both pass.
+func (uc *UseCase) ReserveUserSeat(ctx context.Context, companyID int64) error {
+	plan, err := uc.lock(ctx, companyID)
+	if err != nil {
+		return err
+	}
+	if plan.MaxUsers == 0 {
+		return nil
+	}
+
+	n, err := uc.memberRepo.CountActiveByCompany(ctx, companyID)
+	if err != nil {
+		return fmt.Errorf("failed to count members: %w", err)
+	}
+	if n >= int64(plan.MaxUsers) {
+		return errors.New("user limit reached")
+	}
+	return nil
+}
+
+// ReserveEmployee fails with "employee limit reached" when the company
+// already has as many non-terminated employees as its plan allows. Like
+// ReserveUserSeat it must run inside the transaction that creates the
+// employee.
+func (uc *UseCase) ReserveEmployee(ctx context.Context, companyID int64) error {
+	plan, err := uc.lock(ctx, companyID)
+	if err != nil {
+		return err
+	}
+	if plan.MaxEmployees == 0 {
+		return nil
+	}
+
+	n, err := uc.employeeRepo.CountActiveByCompany(ctx, companyID)
+	if err != nil {
+		return fmt.Errorf("failed to count employees: %w", err)
+	}
+	if n >= int64(plan.MaxEmployees) {
+		return errors.New("employee limit reached")
+	}
+	return nil
+}
+
+// ReserveStorage adds size bytes to the company's stored total, failing with
+// "storage quota exceeded" when that would pass the plan limit. Callers
+// release the bytes again if the upload itself fails or the file is deleted.
+func (uc *UseCase) ReserveStorage(ctx context.Context, companyID, size int64) error {
+	plan, err := uc.currentPlan(ctx, companyID)
+	if err != nil {
+		return err
+	}
+	return uc.usageRepo.AddStorage(ctx, companyID, size, int64(plan.MaxStorageGB)*subscriptionEntity.BytesPerGB)
+}
+
+// ReleaseStorage gives size bytes back to the company's quota.
+func (uc *UseCase) ReleaseStorage(ctx context.Context, companyID, size int64) error {
+	return uc.usageRepo.AddStorage(ctx, companyID, -size, 0)
+}
+
+// Usage reports current counts against the plan limits for the billing page.
+func (uc *UseCase) Usage(ctx context.Context, companyID int64) (*Usage, error) {
+	plan, err := uc.currentPlan(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := uc.memberRepo.CountActiveByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count members: %w", err)
+	}
+	employees, err := uc.employeeRepo.CountActiveByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count employees: %w", err)
+	}
+	usage, err := uc.usageRepo.FindByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load usage: %w", err)
+	}
+
+	return &Usage{
+		Plan:            plan,
+		Users:           users,
+		MaxUsers:        int64(plan.MaxUsers),
+		Employees:       employees,
+		MaxEmployees:    int64(plan.MaxEmployees),
+		StorageBytes:    usage.StorageBytes,
+		MaxStorageBytes: int64(plan.MaxStorageGB) * subscriptionEntity.BytesPerGB,
+	}, nil
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func (uc *UseCase) lock(ctx context.Context, companyID int64) (*subscriptionEntity.Plan, error) {
+	if _, err := uc.companyRepo.LockByID(ctx, companyID); err != nil {
+		return nil, err
+	}
+	return uc.currentPlan(ctx, companyID)
+}
+
+func (uc *UseCase) currentPlan(ctx context.Context, companyID int64) (*subscriptionEntity.Plan, error) {
+	sub, err := uc.subRepo.FindCurrentByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.planRepo.FindByID(ctx, sub.PlanID)
+}
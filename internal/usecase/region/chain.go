@@ -0,0 +1,43 @@
+package region
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haily-id/engine/internal/domain/repository"
+)
+
+// CheckChain verifies that each region of an address belongs to the one
+// above it and returns the village's postal code, if known. Missing
+// regions return their repository's not found error, a broken chain
+// "region mismatch".
+func CheckChain(ctx context.Context, regionRepo repository.RegionRepository, provinceID, cityID, districtID int, villageID *int) (*string, error) {
+	if _, err := regionRepo.FindProvinceByID(ctx, provinceID); err != nil {
+		return nil, err
+	}
+	city, err := regionRepo.FindCityByID(ctx, cityID)
+	if err != nil {
+		return nil, err
+	}
+	if city.ProvinceID != provinceID {
+		return nil, errors.New("region mismatch")
+	}
+	district, err := regionRepo.FindDistrictByID(ctx, districtID)
+	if err != nil {
+		return nil, err
+	}
+	if district.CityID != cityID {
+		return nil, errors.New("region mismatch")
+	}
+	if villageID == nil {
+		return nil, nil
+	}
+	village, err := regionRepo.FindVillageByID(ctx, *villageID)
+	if err != nil {
+		return nil, err
+	}
+	if village.DistrictID != districtID {
+		return nil, errors.New("region mismatch")
+	}
+	return village.PosCode, nil
+}
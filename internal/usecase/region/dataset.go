@@ -0,0 +1,288 @@
+package region
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	regionEntity "github.com/haily-id/engine/internal/domain/entity/region"
+)
+
+// Record is one row of the Kemendagri dataset. Code may be dotted or not;
+// LoadDataset normalizes it to a full_code.
+type Record struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	Type       string `json:"type,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+}
+
+type Dataset struct {
+	Provinces []Record
+	Cities    []Record
+	Districts []Record
+	Villages  []Record
+}
+
+// Files of the single-export form of the dataset in LoadDataset's dir.
+const (
+	combinedFile = "kemendagri.csv"
+	postalFile   = "postal_codes.csv"
+)
+
+// LoadDataset reads provinces, cities, districts and villages from dir.
+//
+// When dir holds kemendagri.csv, the whole dataset is read from it (see
+// loadCombined), with village postal codes from postal_codes.csv when
+// present. Otherwise each level is read from <level>.csv, or <level>.json
+// when there is no CSV: CSV files have a header row naming the columns
+// code, name and, where they apply, type (cities) and postal_code
+// (villages); JSON files hold an array of objects with the same keys.
+func LoadDataset(dir string) (*Dataset, error) {
+	f, err := os.Open(filepath.Join(dir, combinedFile))
+	if err == nil {
+		defer f.Close()
+		return loadCombined(f, filepath.Join(dir, postalFile))
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	ds := &Dataset{}
+	for _, level := range ds.levels() {
+		records, err := loadLevel(dir, level.name)
+		if err != nil {
+			return nil, err
+		}
+		*level.dest = records
+	}
+	if err := ds.normalize(); err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+// loadCombined reads the dataset from a single Kemendagri export that
+// lists every level as code,name rows, the form the full dataset is
+// published in. The level of a row follows from the length of its code;
+// a header row is optional. City names carry their type as a prefix
+// ("KAB. ", "KOTA ", with "ADM. " for Jakarta), which is split off.
+//
+// The export has no postal codes; they are read as code,postal_code rows
+// from postalPath when that file exists.
+func loadCombined(r io.Reader, postalPath string) (*Dataset, error) {
+	rows, err := readPairs(r, combinedFile)
+	if err != nil {
+		return nil, err
+	}
+	postalCodes := make(map[string]string)
+	pf, err := os.Open(postalPath)
+	switch {
+	case err == nil:
+		defer pf.Close()
+		pairs, err := readPairs(pf, postalFile)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range pairs {
+			postalCodes[regionEntity.NormalizeCode(p[0])] = strings.TrimSpace(p[1])
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, err
+	}
+
+	ds := &Dataset{}
+	for _, row := range rows {
+		code := regionEntity.NormalizeCode(row[0])
+		rec := Record{Code: code, Name: strings.TrimSpace(row[1])}
+		switch len(strings.ReplaceAll(code, ".", "")) {
+		case 2:
+			ds.Provinces = append(ds.Provinces, rec)
+		case 4:
+			rec.Type, rec.Name = cityType(rec.Name)
+			ds.Cities = append(ds.Cities, rec)
+		case 6:
+			ds.Districts = append(ds.Districts, rec)
+		case 10:
+			rec.PostalCode = postalCodes[code]
+			ds.Villages = append(ds.Villages, rec)
+		default:
+			return nil, fmt.Errorf("%s: invalid code %q", combinedFile, row[0])
+		}
+	}
+	if err := ds.normalize(); err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+type datasetLevel struct {
+	name   string
+	digits int
+	dest   *[]Record
+}
+
+func (ds *Dataset) levels() []datasetLevel {
+	return []datasetLevel{
+		{"provinces", 2, &ds.Provinces},
+		{"cities", 4, &ds.Cities},
+		{"districts", 6, &ds.Districts},
+		{"villages", 10, &ds.Villages},
+	}
+}
+
+// normalize trims the records, turns their codes into full_codes and
+// checks them.
+func (ds *Dataset) normalize() error {
+	for _, level := range ds.levels() {
+		records := *level.dest
+		for i := range records {
+			r := &records[i]
+			r.Name = strings.TrimSpace(r.Name)
+			r.Type = strings.TrimSpace(r.Type)
+			r.PostalCode = strings.TrimSpace(r.PostalCode)
+
+			code := regionEntity.NormalizeCode(r.Code)
+			if len(strings.ReplaceAll(code, ".", "")) != level.digits {
+				return fmt.Errorf("%s: invalid code %q", level.name, r.Code)
+			}
+			r.Code = code
+			if r.Name == "" {
+				return fmt.Errorf("%s: %s has no name", level.name, code)
+			}
+			if level.name == "cities" && r.Type != regionEntity.CityTypeKota && r.Type != regionEntity.CityTypeKabupaten {
+				return fmt.Errorf("cities: %s has invalid type %q", code, r.Type)
+			}
+			if r.PostalCode != "" && len(r.PostalCode) != 5 {
+				return fmt.Errorf("%s: %s has invalid postal code %q", level.name, code, r.PostalCode)
+			}
+		}
+	}
+	return nil
+}
+
+// cityType splits the type prefix off a city name of the combined export.
+func cityType(name string) (string, string) {
+	upper := strings.ToUpper(name)
+	for _, p := range []struct{ prefix, cityType string }{
+		{"KABUPATEN ", regionEntity.CityTypeKabupaten},
+		{"KAB. ", regionEntity.CityTypeKabupaten},
+		{"KOTA ", regionEntity.CityTypeKota},
+	} {
+		if strings.HasPrefix(upper, p.prefix) {
+			rest := strings.TrimSpace(name[len(p.prefix):])
+			if strings.HasPrefix(strings.ToUpper(rest), "ADM. ") {
+				rest = strings.TrimSpace(rest[len("ADM. "):])
+			}
+			return p.cityType, rest
+		}
+	}
+	return "", name
+}
+
+func loadLevel(dir, name string) ([]Record, error) {
+	f, err := os.Open(filepath.Join(dir, name+".csv"))
+	if err == nil {
+		defer f.Close()
+		return readCSV(f, name)
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	f, err = os.Open(filepath.Join(dir, name+".json"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%s: neither %s.csv nor %s.json found in %s", name, name, name, dir)
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []Record
+	if err := json.NewDecoder(f).Decode(&records); err != nil {
+		return nil, fmt.Errorf("%s.json: %w", name, err)
+	}
+	return records, nil
+}
+
+func readCSV(r io.Reader, name string) ([]Record, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%s.csv: %w", name, err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	if _, ok := columns["code"]; !ok {
+		return nil, fmt.Errorf("%s.csv: missing code column", name)
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, fmt.Errorf("%s.csv: missing name column", name)
+	}
+
+	field := func(row []string, column string) string {
+		if i, ok := columns[column]; ok && i < len(row) {
+			return row[i]
+		}
+		return ""
+	}
+
+	var records []Record
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s.csv: %w", name, err)
+		}
+		records = append(records, Record{
+			Code:       field(row, "code"),
+			Name:       field(row, "name"),
+			Type:       field(row, "type"),
+			PostalCode: field(row, "postal_code"),
+		})
+	}
+	return records, nil
+}
+
+// readPairs reads the first two columns of CSV rows, skipping a header
+// row whose first cell is not a region code.
+func readPairs(r io.Reader, name string) ([][2]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var pairs [][2]string
+	for first := true; ; first = false {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if len(row) < 2 {
+			line, _ := reader.FieldPos(0)
+			return nil, fmt.Errorf("%s: line %d has fewer than two columns", name, line)
+		}
+		if first {
+			row[0] = strings.TrimPrefix(row[0], "\ufeff")
+			if regionEntity.NormalizeCode(row[0]) == "" {
+				continue
+			}
+		}
+		pairs = append(pairs, [2]string{row[0], row[1]})
+	}
+	return pairs, nil
+}
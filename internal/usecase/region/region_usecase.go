@@ -0,0 +1,258 @@
+package region
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	regionEntity "github.com/haily-id/engine/internal/domain/entity/region"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	redisCache "github.com/haily-id/engine/internal/repository/redis"
+)
+
+const (
+	// Reference data only changes on import, which clears the cache.
+	cacheTTL = 24 * time.Hour
+
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+type SearchVillagesRequest struct {
+	Query      string `query:"q" validate:"omitempty,min=3,max=100"`
+	PostalCode string `query:"postal_code" validate:"omitempty,numeric,len=5"`
+	Limit      int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// ─── Results ────────────────────────────────────────────────────
+
+type ImportResult struct {
+	Provinces int
+	Cities    int
+	Districts int
+	Villages  int
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	regionRepo repository.RegionRepository
+	transactor repository.Transactor
+	cache      repository.Cache
+}
+
+func NewUseCase(
+	regionRepo repository.RegionRepository,
+	transactor repository.Transactor,
+	cache repository.Cache,
+) *UseCase {
+	return &UseCase{
+		regionRepo: regionRepo,
+		transactor: transactor,
+		cache:      cache,
+	}
+}
+
+func (uc *UseCase) ListProvinces(ctx context.Context) ([]regionEntity.Province, error) {
+	return cached(ctx, uc.cache, redisCache.RegionKey("provinces"), func() ([]regionEntity.Province, error) {
+		return uc.regionRepo.ListProvinces(ctx)
+	})
+}
+
+func (uc *UseCase) ListCities(ctx context.Context, provinceID int) ([]regionEntity.City, error) {
+	return cached(ctx, uc.cache, redisCache.RegionKey("province", provinceID, "cities"), func() ([]regionEntity.City, error) {
+		if _, err := uc.regionRepo.FindProvinceByID(ctx, provinceID); err != nil {
+			return nil, err
+		}
+		return uc.regionRepo.ListCitiesByProvince(ctx, provinceID)
+	})
+}
+
+func (uc *UseCase) ListDistricts(ctx context.Context, cityID int) ([]regionEntity.District, error) {
+	return cached(ctx, uc.cache, redisCache.RegionKey("city", cityID, "districts"), func() ([]regionEntity.District, error) {
+		if _, err := uc.regionRepo.FindCityByID(ctx, cityID); err != nil {
+			return nil, err
+		}
+		return uc.regionRepo.ListDistrictsByCity(ctx, cityID)
+	})
+}
+
+func (uc *UseCase) ListVillages(ctx context.Context, districtID int) ([]regionEntity.Village, error) {
+	return cached(ctx, uc.cache, redisCache.RegionKey("district", districtID, "villages"), func() ([]regionEntity.Village, error) {
+		if _, err := uc.regionRepo.FindDistrictByID(ctx, districtID); err != nil {
+			return nil, err
+		}
+		return uc.regionRepo.ListVillagesByDistrict(ctx, districtID)
+	})
+}
+
+// SearchVillages finds villages by name, postal code, or both. Each result
+// carries its district, city and province.
+func (uc *UseCase) SearchVillages(ctx context.Context, req SearchVillagesRequest) ([]regionEntity.Village, error) {
+	query := strings.TrimSpace(req.Query)
+	if query == "" && req.PostalCode == "" {
+		return nil, errors.New("search query required")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	key := redisCache.RegionKey("villages", "search", strings.ToLower(query), req.PostalCode, limit)
+	return cached(ctx, uc.cache, key, func() ([]regionEntity.Village, error) {
+		return uc.regionRepo.SearchVillages(ctx, query, req.PostalCode, limit)
+	})
+}
+
+// Hierarchy resolves a village code, dotted or not, to the village with its
+// district, city and province.
+func (uc *UseCase) Hierarchy(ctx context.Context, code string) (*regionEntity.Village, error) {
+	fullCode := regionEntity.NormalizeCode(code)
+	if strings.Count(fullCode, ".") != 3 {
+		return nil, errors.New("invalid region code")
+	}
+
+	return cached(ctx, uc.cache, redisCache.RegionKey("village", fullCode), func() (*regionEntity.Village, error) {
+		return uc.regionRepo.FindVillageByFullCode(ctx, fullCode)
+	})
+}
+
+// Import upserts the dataset level by level in one transaction, matching
+// existing rows by full_code, and clears the lookup cache.
+func (uc *UseCase) Import(ctx context.Context, ds *Dataset) (*ImportResult, error) {
+	result := &ImportResult{}
+
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		provinces := make([]regionEntity.Province, 0, len(ds.Provinces))
+		for _, r := range ds.Provinces {
+			provinces = append(provinces, regionEntity.Province{Code: r.Code, Name: r.Name})
+		}
+		if err := uc.regionRepo.UpsertProvinces(ctx, provinces); err != nil {
+			return err
+		}
+		result.Provinces = len(provinces)
+
+		provinceIDs, err := uc.regionRepo.ProvinceIDs(ctx)
+		if err != nil {
+			return err
+		}
+		cities := make([]regionEntity.City, 0, len(ds.Cities))
+		for _, r := range ds.Cities {
+			parentID, err := parent(provinceIDs, r)
+			if err != nil {
+				return err
+			}
+			cities = append(cities, regionEntity.City{
+				Type:       r.Type,
+				Name:       r.Name,
+				Code:       regionEntity.LocalCode(r.Code),
+				FullCode:   r.Code,
+				ProvinceID: parentID,
+			})
+		}
+		if err := uc.regionRepo.UpsertCities(ctx, cities); err != nil {
+			return err
+		}
+		result.Cities = len(cities)
+
+		cityIDs, err := uc.regionRepo.CityIDs(ctx)
+		if err != nil {
+			return err
+		}
+		districts := make([]regionEntity.District, 0, len(ds.Districts))
+		for _, r := range ds.Districts {
+			parentID, err := parent(cityIDs, r)
+			if err != nil {
+				return err
+			}
+			districts = append(districts, regionEntity.District{
+				Name:     r.Name,
+				Code:     regionEntity.LocalCode(r.Code),
+				FullCode: r.Code,
+				CityID:   parentID,
+			})
+		}
+		if err := uc.regionRepo.UpsertDistricts(ctx, districts); err != nil {
+			return err
+		}
+		result.Districts = len(districts)
+
+		districtIDs, err := uc.regionRepo.DistrictIDs(ctx)
+		if err != nil {
+			return err
+		}
+		villages := make([]regionEntity.Village, 0, len(ds.Villages))
+		for _, r := range ds.Villages {
+			parentID, err := parent(districtIDs, r)
+			if err != nil {
+				return err
+			}
+			v := regionEntity.Village{
+				Name:       r.Name,
+				Code:       regionEntity.LocalCode(r.Code),
+				FullCode:   r.Code,
+				DistrictID: parentID,
+			}
+			if r.PostalCode != "" {
+				posCode := r.PostalCode
+				v.PosCode = &posCode
+			}
+			villages = append(villages, v)
+		}
+		if err := uc.regionRepo.UpsertVillages(ctx, villages); err != nil {
+			return err
+		}
+		result.Villages = len(villages)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.Invalidate(ctx); err != nil {
+		logger.Errorf("Failed to clear region cache: %v", err)
+	}
+	return result, nil
+}
+
+// Invalidate drops every cached region lookup.
+func (uc *UseCase) Invalidate(ctx context.Context) error {
+	return uc.cache.DeletePattern(ctx, redisCache.RegionPattern())
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func parent(ids map[string]int, r Record) (int, error) {
+	id, ok := ids[regionEntity.ParentCode(r.Code)]
+	if !ok {
+		return 0, fmt.Errorf("region %s: parent %s not found", r.Code, regionEntity.ParentCode(r.Code))
+	}
+	return id, nil
+}
+
+// cached returns the value stored under key, or loads and stores it. Cache
+// failures only cost a database round trip.
+func cached[T any](ctx context.Context, cache repository.Cache, key string, load func() (T, error)) (T, error) {
+	var v T
+	if err := cache.Get(ctx, key, &v); err == nil {
+		return v, nil
+	}
+
+	v, err := load()
+	if err != nil {
+		return v, err
+	}
+	if err := cache.Set(ctx, key, v, cacheTTL); err != nil {
+		logger.Errorf("Failed to cache %s: %v", key, err)
+	}
+	return v, nil
+}
@@ -0,0 +1,106 @@
+package setting
+
+import (
+	attendanceEntity "github.com/haily-id/engine/internal/domain/entity/attendance"
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+	leaveEntity "github.com/haily-id/engine/internal/domain/entity/leave"
+	subscriptionEntity "github.com/haily-id/engine/internal/domain/entity/subscription"
+)
+
+const (
+	KeyGeneralDateFormat = "general.date_format"
+	KeyGeneralWeekStart  = "general.week_start"
+
+	KeyHREmployeeNumberPattern = employeeEntity.NumberPatternSetting
+	KeyHRDocumentExpiryDays    = employeeEntity.DocumentExpirySetting
+	KeyHRLeaveMaxDays          = leaveEntity.MaxDaysSetting
+	KeyHRProbationMonths       = "hr.probation_months"
+	KeyHRAttendanceRadius      = attendanceEntity.RadiusSetting
+	KeyPayrollCutOffDate       = "payroll.cut_off_date"
+	KeyPayrollProrateJoiner    = "payroll.prorate_new_joiners"
+)
+
+// GeneralDefinitions are the settings every company has regardless of
+// modules.
+func GeneralDefinitions() []Definition {
+	return []Definition{
+		{
+			Key:         KeyGeneralDateFormat,
+			Module:      companyEntity.SettingModuleGeneral,
+			Type:        companyEntity.SettingTypeString,
+			Default:     "DD/MM/YYYY",
+			Description: "Date format used in documents and exports",
+			Validate:    OneOf("DD/MM/YYYY", "YYYY-MM-DD", "MM/DD/YYYY"),
+		},
+		{
+			Key:         KeyGeneralWeekStart,
+			Module:      companyEntity.SettingModuleGeneral,
+			Type:        companyEntity.SettingTypeString,
+			Default:     "MONDAY",
+			Description: "First day of the week in calendars",
+			Validate:    OneOf("MONDAY", "SUNDAY"),
+		},
+	}
+}
+
+// HRDefinitions are the settings of the HR module, payroll included.
+func HRDefinitions() []Definition {
+	return []Definition{
+		{
+			Key:         KeyHREmployeeNumberPattern,
+			Module:      subscriptionEntity.ModuleHR,
+			Type:        companyEntity.SettingTypeString,
+			Default:     employeeEntity.DefaultNumberPattern,
+			Description: "Pattern for generated employee numbers, e.g. EMP/{YYYY}/{SEQ:4}",
+			Validate:    NumberPattern(40),
+		},
+		{
+			Key:         KeyHRDocumentExpiryDays,
+			Module:      subscriptionEntity.ModuleHR,
+			Type:        companyEntity.SettingTypeInteger,
+			Default:     "30",
+			Description: "Days before a document's valid until date to remind the employee and HR; 0 turns reminders off",
+			Validate:    IntRange(0, 365),
+		},
+		{
+			Key:         KeyHRLeaveMaxDays,
+			Module:      subscriptionEntity.ModuleHR,
+			Type:        companyEntity.SettingTypeInteger,
+			Default:     "12",
+			Description: "Annual leave days per employee per year, for leave types without their own",
+			Validate:    IntRange(0, 365),
+		},
+		{
+			Key:         KeyHRAttendanceRadius,
+			Module:      subscriptionEntity.ModuleHR,
+			Type:        companyEntity.SettingTypeInteger,
+			Default:     "100",
+			Description: "Distance in meters from a work location within which employees can clock in and out",
+			Validate:    IntRange(10, 10000),
+		},
+		{
+			Key:         KeyHRProbationMonths,
+			Module:      subscriptionEntity.ModuleHR,
+			Type:        companyEntity.SettingTypeInteger,
+			Default:     "3",
+			Description: "Probation period for permanent employees, in months",
+			Validate:    IntRange(0, 3),
+		},
+		{
+			Key:         KeyPayrollCutOffDate,
+			Module:      subscriptionEntity.ModuleHR,
+			Type:        companyEntity.SettingTypeInteger,
+			Default:     "25",
+			Description: "Day of the month attendance is closed for payroll",
+			Validate:    IntRange(1, 31),
+		},
+		{
+			Key:         KeyPayrollProrateJoiner,
+			Module:      subscriptionEntity.ModuleHR,
+			Type:        companyEntity.SettingTypeBoolean,
+			Default:     "true",
+			Description: "Prorate the first salary of employees joining mid-period",
+		},
+	}
+}
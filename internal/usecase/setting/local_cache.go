@@ -0,0 +1,50 @@
+package setting
+
+import (
+	"sync"
+	"time"
+)
+
+// localCache keeps each company's stored values in process memory for a
+// short time so hot paths do not hit Redis on every read. Writes through
+// this process invalidate it immediately; other processes see them once
+// their entry expires.
+type localCache struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	entries map[int64]localEntry
+}
+
+type localEntry struct {
+	values    map[string]string
+	expiresAt time.Time
+}
+
+func newLocalCache(ttl time.Duration) *localCache {
+	return &localCache{ttl: ttl, entries: make(map[int64]localEntry)}
+}
+
+func (c *localCache) get(companyID int64) (map[string]string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	e, ok := c.entries[companyID]
+	if !ok || time.Now().After(e.expiresAt) {
+		return nil, false
+	}
+	return e.values, true
+}
+
+func (c *localCache) set(companyID int64, values map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[companyID] = localEntry{values: values, expiresAt: time.Now().Add(c.ttl)}
+}
+
+func (c *localCache) delete(companyID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, companyID)
+}
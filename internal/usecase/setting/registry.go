@@ -0,0 +1,189 @@
+package setting
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	employeeEntity "github.com/haily-id/engine/internal/domain/entity/employee"
+)
+
+// Definition describes a setting key. Default is given in canonical text
+// form (see companyEntity.Setting). Validate receives the coerced value:
+// int64, bool, string, or json.RawMessage for JSON settings.
+type Definition struct {
+	Key         string
+	Module      string
+	Type        string
+	Default     string
+	Description string
+	Validate    func(value interface{}) error
+}
+
+// Registry holds the keys every module registers at startup. It is not safe
+// for concurrent registration; register everything before serving.
+type Registry struct {
+	defs map[string]Definition
+}
+
+func NewRegistry() *Registry {
+	return &Registry{defs: make(map[string]Definition)}
+}
+
+// MustRegister adds definitions and panics on duplicate keys or defaults
+// that do not satisfy their own type and validation.
+func (r *Registry) MustRegister(defs ...Definition) {
+	for _, d := range defs {
+		if _, ok := r.defs[d.Key]; ok {
+			panic(fmt.Sprintf("setting %s registered twice", d.Key))
+		}
+		if d.Module == "" {
+			d.Module = companyEntity.SettingModuleGeneral
+		}
+		if _, err := d.parse(d.Default); err != nil {
+			panic(fmt.Sprintf("setting %s: invalid default: %v", d.Key, err))
+		}
+		r.defs[d.Key] = d
+	}
+}
+
+func (r *Registry) Lookup(key string) (Definition, bool) {
+	d, ok := r.defs[key]
+	return d, ok
+}
+
+// List returns the definitions of module, or all of them when module is
+// empty, sorted by key.
+func (r *Registry) List(module string) []Definition {
+	defs := make([]Definition, 0, len(r.defs))
+	for _, d := range r.defs {
+		if module == "" || d.Module == module {
+			defs = append(defs, d)
+		}
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Key < defs[j].Key })
+	return defs
+}
+
+// Coerce converts a JSON value sent by a client to the canonical text form,
+// accepting numbers and booleans as JSON strings too, then validates it.
+func (d Definition) Coerce(raw json.RawMessage) (string, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return "", errors.New("invalid setting value")
+	}
+
+	var text string
+	switch d.Type {
+	case companyEntity.SettingTypeString:
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return "", errors.New("invalid setting value")
+		}
+	case companyEntity.SettingTypeInteger, companyEntity.SettingTypeBoolean:
+		if err := json.Unmarshal(raw, &text); err != nil {
+			text = string(raw)
+		}
+		text = strings.TrimSpace(text)
+	case companyEntity.SettingTypeJSON:
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, raw); err != nil {
+			return "", errors.New("invalid setting value")
+		}
+		text = buf.String()
+	}
+
+	v, err := d.parse(text)
+	if err != nil {
+		return "", err
+	}
+	switch v := v.(type) {
+	case int64:
+		text = strconv.FormatInt(v, 10)
+	case bool:
+		text = strconv.FormatBool(v)
+	}
+	return text, nil
+}
+
+// parse converts canonical text to the typed value and validates it.
+func (d Definition) parse(text string) (interface{}, error) {
+	var v interface{}
+	switch d.Type {
+	case companyEntity.SettingTypeString:
+		v = text
+	case companyEntity.SettingTypeInteger:
+		n, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid setting value")
+		}
+		v = n
+	case companyEntity.SettingTypeBoolean:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return nil, errors.New("invalid setting value")
+		}
+		v = b
+	case companyEntity.SettingTypeJSON:
+		if !json.Valid([]byte(text)) {
+			return nil, errors.New("invalid setting value")
+		}
+		v = json.RawMessage(text)
+	default:
+		return nil, fmt.Errorf("unknown setting type %q", d.Type)
+	}
+
+	if d.Validate != nil {
+		if err := d.Validate(v); err != nil {
+			return nil, fmt.Errorf("invalid setting value: %w", err)
+		}
+	}
+	return v, nil
+}
+
+// ─── Validators ─────────────────────────────────────────────────
+
+func IntRange(min, max int64) func(interface{}) error {
+	return func(v interface{}) error {
+		n := v.(int64)
+		if n < min || n > max {
+			return fmt.Errorf("must be between %d and %d", min, max)
+		}
+		return nil
+	}
+}
+
+func OneOf(values ...string) func(interface{}) error {
+	return func(v interface{}) error {
+		s := v.(string)
+		for _, allowed := range values {
+			if s == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("must be one of %s", strings.Join(values, ", "))
+	}
+}
+
+func MaxLength(n int) func(interface{}) error {
+	return func(v interface{}) error {
+		if len(v.(string)) > n {
+			return fmt.Errorf("must be at most %d characters", n)
+		}
+		return nil
+	}
+}
+
+// NumberPattern accepts employee number patterns of at most n characters.
+func NumberPattern(n int) func(interface{}) error {
+	return func(v interface{}) error {
+		if err := MaxLength(n)(v); err != nil {
+			return err
+		}
+		return employeeEntity.ValidateNumberPattern(v.(string))
+	}
+}
@@ -0,0 +1,276 @@
+package setting
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	companyEntity "github.com/haily-id/engine/internal/domain/entity/company"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/audit"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+	redisCache "github.com/haily-id/engine/internal/repository/redis"
+)
+
+const (
+	redisTTL = time.Hour
+	localTTL = 30 * time.Second
+)
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+type SetValueRequest struct {
+	Value json.RawMessage `json:"value" validate:"required"`
+}
+
+// ─── Results ────────────────────────────────────────────────────
+
+type Value struct {
+	Definition Definition
+	Value      string
+	IsDefault  bool
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+type UseCase struct {
+	settingRepo repository.CompanySettingRepository
+	registry    *Registry
+	cache       repository.Cache
+	local       *localCache
+	auditor     audit.Recorder
+}
+
+func NewUseCase(
+	settingRepo repository.CompanySettingRepository,
+	registry *Registry,
+	cache repository.Cache,
+	auditor audit.Recorder,
+) *UseCase {
+	return &UseCase{
+		settingRepo: settingRepo,
+		registry:    registry,
+		cache:       cache,
+		local:       newLocalCache(localTTL),
+		auditor:     auditor,
+	}
+}
+
+// List returns the effective value of every key of module (all modules when
+// empty) for the company.
+func (uc *UseCase) List(ctx context.Context, companyID int64, module string) ([]Value, error) {
+	stored, err := uc.stored(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	defs := uc.registry.List(module)
+	values := make([]Value, 0, len(defs))
+	for _, d := range defs {
+		values = append(values, effective(d, stored))
+	}
+	return values, nil
+}
+
+func (uc *UseCase) Get(ctx context.Context, companyID int64, key string) (*Value, error) {
+	d, ok := uc.registry.Lookup(key)
+	if !ok {
+		return nil, errors.New("setting not found")
+	}
+
+	stored, err := uc.stored(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	v := effective(d, stored)
+	return &v, nil
+}
+
+// Set coerces and validates value against the key's definition and stores
+// it for the company.
+func (uc *UseCase) Set(ctx context.Context, companyID int64, key string, req SetValueRequest) (*Value, error) {
+	d, ok := uc.registry.Lookup(key)
+	if !ok {
+		return nil, errors.New("setting not found")
+	}
+
+	text, err := d.Coerce(req.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := uc.stored(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	previous := effective(d, stored)
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	if err := uc.settingRepo.Upsert(ctx, &companyEntity.Setting{
+		ID:        id,
+		CompanyID: companyID,
+		Key:       d.Key,
+		Value:     text,
+		ValueType: d.Type,
+		Module:    d.Module,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to save setting: %w", err)
+	}
+	uc.invalidate(ctx, companyID)
+	uc.record(ctx, companyID, audit.ActionUpdate, map[string]string{d.Key: previous.Value}, map[string]string{d.Key: text})
+
+	return &Value{Definition: d, Value: text}, nil
+}
+
+// Reset removes the company's value so the default applies again.
+func (uc *UseCase) Reset(ctx context.Context, companyID int64, key string) (*Value, error) {
+	d, ok := uc.registry.Lookup(key)
+	if !ok {
+		return nil, errors.New("setting not found")
+	}
+
+	stored, err := uc.stored(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	previous := effective(d, stored)
+
+	if err := uc.settingRepo.Delete(ctx, companyID, key); err != nil {
+		return nil, fmt.Errorf("failed to reset setting: %w", err)
+	}
+	uc.invalidate(ctx, companyID)
+	if !previous.IsDefault {
+		uc.record(ctx, companyID, audit.ActionDelete, map[string]string{d.Key: previous.Value}, nil)
+	}
+
+	return &Value{Definition: d, Value: d.Default, IsDefault: true}, nil
+}
+
+// ─── Typed API ──────────────────────────────────────────────────
+
+// Int returns an INTEGER setting for the company.
+func (uc *UseCase) Int(ctx context.Context, companyID int64, key string) (int64, error) {
+	v, err := uc.typed(ctx, companyID, key, companyEntity.SettingTypeInteger)
+	if err != nil {
+		return 0, err
+	}
+	return v.(int64), nil
+}
+
+// Bool returns a BOOLEAN setting for the company.
+func (uc *UseCase) Bool(ctx context.Context, companyID int64, key string) (bool, error) {
+	v, err := uc.typed(ctx, companyID, key, companyEntity.SettingTypeBoolean)
+	if err != nil {
+		return false, err
+	}
+	return v.(bool), nil
+}
+
+// String returns a STRING setting for the company.
+func (uc *UseCase) String(ctx context.Context, companyID int64, key string) (string, error) {
+	v, err := uc.typed(ctx, companyID, key, companyEntity.SettingTypeString)
+	if err != nil {
+		return "", err
+	}
+	return v.(string), nil
+}
+
+// JSON decodes a JSON setting for the company into dest.
+func (uc *UseCase) JSON(ctx context.Context, companyID int64, key string, dest interface{}) error {
+	v, err := uc.typed(ctx, companyID, key, companyEntity.SettingTypeJSON)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(v.(json.RawMessage), dest)
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+// record logs a settings change. Values are keyed by setting key so the
+// entry stays readable without a settings row ID.
+func (uc *UseCase) record(ctx context.Context, companyID int64, action string, oldValues, newValues map[string]string) {
+	uc.auditor.Record(ctx, audit.Entry{
+		CompanyID: companyID,
+		Module:    audit.ModuleGeneral,
+		Entity:    "company_settings",
+		Action:    action,
+		Old:       oldValues,
+		New:       newValues,
+	})
+}
+
+func (uc *UseCase) typed(ctx context.Context, companyID int64, key, typ string) (interface{}, error) {
+	d, ok := uc.registry.Lookup(key)
+	if !ok {
+		return nil, fmt.Errorf("setting %s is not registered", key)
+	}
+	if d.Type != typ {
+		return nil, fmt.Errorf("setting %s is %s, not %s", key, d.Type, typ)
+	}
+
+	stored, err := uc.stored(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := d.parse(effective(d, stored).Value)
+	if err != nil {
+		// A stored value that no longer passes validation, e.g. after a
+		// range was narrowed, falls back to the default.
+		logger.Errorf("Setting %s of company %d is invalid, using default: %v", key, companyID, err)
+		return d.parse(d.Default)
+	}
+	return v, nil
+}
+
+// stored returns the company's stored values by key, from the in-process
+// cache, then Redis, then the database.
+func (uc *UseCase) stored(ctx context.Context, companyID int64) (map[string]string, error) {
+	if values, ok := uc.local.get(companyID); ok {
+		return values, nil
+	}
+
+	key := redisCache.SettingKey(companyID)
+	var values map[string]string
+	if err := uc.cache.Get(ctx, key, &values); err == nil {
+		uc.local.set(companyID, values)
+		return values, nil
+	}
+
+	settings, err := uc.settingRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	values = make(map[string]string, len(settings))
+	for _, s := range settings {
+		values[s.Key] = s.Value
+	}
+
+	if err := uc.cache.Set(ctx, key, values, redisTTL); err != nil {
+		logger.Errorf("Failed to cache settings for company %d: %v", companyID, err)
+	}
+	uc.local.set(companyID, values)
+	return values, nil
+}
+
+func (uc *UseCase) invalidate(ctx context.Context, companyID int64) {
+	uc.local.delete(companyID)
+	if err := uc.cache.Delete(ctx, redisCache.SettingKey(companyID)); err != nil {
+		logger.Errorf("Failed to clear settings cache for company %d: %v", companyID, err)
+	}
+}
+
+func effective(d Definition, stored map[string]string) Value {
+	if v, ok := stored[d.Key]; ok {
+		return Value{Definition: d, Value: v}
+	}
+	return Value{Definition: d, Value: d.Default, IsDefault: true}
+}
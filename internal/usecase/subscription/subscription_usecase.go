@@ -0,0 +1,743 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	billingEntity "github.com/haily-id/engine/internal/domain/entity/billing"
+	subscriptionEntity "github.com/haily-id/engine/internal/domain/entity/subscription"
+	"github.com/haily-id/engine/internal/domain/repository"
+	"github.com/haily-id/engine/internal/pkg/asynq/tasks"
+	"github.com/haily-id/engine/internal/pkg/i18n"
+	"github.com/haily-id/engine/internal/pkg/logger"
+	"github.com/haily-id/engine/internal/pkg/notify"
+	"github.com/haily-id/engine/internal/pkg/snowflake"
+	"github.com/hibiken/asynq"
+)
+
+// ─── Request DTOs ───────────────────────────────────────────────
+
+type CreatePlanRequest struct {
+	Name         string   `json:"name"           validate:"required,min=2,max=100"`
+	Code         string   `json:"code"           validate:"required,min=2,max=50,alphanum"`
+	Currency     string   `json:"currency"       validate:"omitempty,len=3"`
+	PriceMonthly int64    `json:"price_monthly"  validate:"gte=0"`
+	PriceYearly  int64    `json:"price_yearly"   validate:"gte=0"`
+	MaxUsers     int      `json:"max_users"      validate:"gte=0"`
+	MaxEmployees int      `json:"max_employees"  validate:"gte=0"`
+	MaxStorageGB int      `json:"max_storage_gb" validate:"gte=0"`
+	IsPublic     *bool    `json:"is_public"`
+	ModuleIDs    []string `json:"module_ids"     validate:"dive,numeric"`
+}
+
+type UpdatePlanRequest struct {
+	Name         *string `json:"name"           validate:"omitempty,min=2,max=100"`
+	PriceMonthly *int64  `json:"price_monthly"  validate:"omitempty,gte=0"`
+	PriceYearly  *int64  `json:"price_yearly"   validate:"omitempty,gte=0"`
+	MaxUsers     *int    `json:"max_users"      validate:"omitempty,gte=0"`
+	MaxEmployees *int    `json:"max_employees"  validate:"omitempty,gte=0"`
+	MaxStorageGB *int    `json:"max_storage_gb" validate:"omitempty,gte=0"`
+	IsPublic     *bool   `json:"is_public"`
+	IsActive     *bool   `json:"is_active"`
+}
+
+type SetPlanModulesRequest struct {
+	ModuleIDs []string `json:"module_ids" validate:"dive,numeric"`
+}
+
+type ChangePlanRequest struct {
+	PlanID       string `json:"plan_id"       validate:"required,numeric"`
+	BillingCycle string `json:"billing_cycle" validate:"required,oneof=MONTHLY YEARLY"`
+}
+
+// ─── Results ────────────────────────────────────────────────────
+
+type PlanDetail struct {
+	Plan    *subscriptionEntity.Plan
+	Modules []subscriptionEntity.Module
+}
+
+type SubscriptionDetail struct {
+	Subscription *subscriptionEntity.CompanySubscription
+	Plan         *subscriptionEntity.Plan
+	PendingPlan  *subscriptionEntity.Plan
+}
+
+type ChangeResult struct {
+	SubscriptionDetail
+	Change *subscriptionEntity.SubscriptionChange
+}
+
+// ─── Use Case ───────────────────────────────────────────────────
+
+// Entitlements is notified whenever a change here may alter which modules a
+// company can use.
+type Entitlements interface {
+	Invalidate(ctx context.Context, companyID int64)
+	InvalidateAll(ctx context.Context)
+}
+
+// Invoicer bills renewals and immediate plan changes. Its Invoice methods run
+// inside the transaction that updates the subscription.
+type Invoicer interface {
+	InvoicePeriod(ctx context.Context, sub *subscriptionEntity.CompanySubscription, plan *subscriptionEntity.Plan) (*billingEntity.Invoice, error)
+	InvoiceChange(ctx context.Context, sub *subscriptionEntity.CompanySubscription, change *subscriptionEntity.SubscriptionChange, plan *subscriptionEntity.Plan) (*billingEntity.Invoice, error)
+	QueueRender(ctx context.Context, inv *billingEntity.Invoice)
+}
+
+type UseCase struct {
+	planRepo    repository.SubscriptionPlanRepository
+	moduleRepo  repository.ModuleRepository
+	subRepo     repository.CompanySubscriptionRepository
+	changeRepo  repository.SubscriptionChangeRepository
+	companyRepo repository.CompanyRepository
+	userRepo    repository.UserRepository
+	transactor  repository.Transactor
+	asynqClient interface {
+		Enqueue(task *asynq.Task, opts ...asynq.Option) error
+	}
+	entitlements  Entitlements
+	invoices      Invoicer
+	notifier      notify.Notifier
+	trialDays     int
+	trialPlanCode string
+}
+
+type Config struct {
+	TrialDays     int
+	TrialPlanCode string
+}
+
+func NewUseCase(
+	planRepo repository.SubscriptionPlanRepository,
+	moduleRepo repository.ModuleRepository,
+	subRepo repository.CompanySubscriptionRepository,
+	changeRepo repository.SubscriptionChangeRepository,
+	companyRepo repository.CompanyRepository,
+	userRepo repository.UserRepository,
+	transactor repository.Transactor,
+	asynqClient interface {
+		Enqueue(task *asynq.Task, opts ...asynq.Option) error
+	},
+	entitlements Entitlements,
+	invoices Invoicer,
+	notifier notify.Notifier,
+	cfg Config,
+) *UseCase {
+	return &UseCase{
+		planRepo:      planRepo,
+		moduleRepo:    moduleRepo,
+		subRepo:       subRepo,
+		changeRepo:    changeRepo,
+		companyRepo:   companyRepo,
+		userRepo:      userRepo,
+		transactor:    transactor,
+		asynqClient:   asynqClient,
+		entitlements:  entitlements,
+		invoices:      invoices,
+		notifier:      notifier,
+		trialDays:     cfg.TrialDays,
+		trialPlanCode: cfg.TrialPlanCode,
+	}
+}
+
+// ─── Plans ──────────────────────────────────────────────────────
+
+func (uc *UseCase) ListPlans(ctx context.Context, publicOnly bool) ([]PlanDetail, error) {
+	plans, err := uc.planRepo.List(ctx, publicOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]PlanDetail, 0, len(plans))
+	for i := range plans {
+		modules, err := uc.moduleRepo.ListByPlanID(ctx, plans[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		details = append(details, PlanDetail{Plan: &plans[i], Modules: modules})
+	}
+	return details, nil
+}
+
+func (uc *UseCase) GetPlan(ctx context.Context, id int64) (*PlanDetail, error) {
+	p, err := uc.planRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	modules, err := uc.moduleRepo.ListByPlanID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &PlanDetail{Plan: p, Modules: modules}, nil
+}
+
+func (uc *UseCase) CreatePlan(ctx context.Context, req CreatePlanRequest) (*PlanDetail, error) {
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	if existing, _ := uc.planRepo.FindByCode(ctx, code); existing != nil {
+		return nil, errors.New("plan code already exists")
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	currency := strings.ToUpper(req.Currency)
+	if currency == "" {
+		currency = "IDR"
+	}
+
+	p := &subscriptionEntity.Plan{
+		ID:           id,
+		Name:         req.Name,
+		Code:         code,
+		Currency:     currency,
+		PriceMonthly: req.PriceMonthly,
+		PriceYearly:  req.PriceYearly,
+		MaxUsers:     req.MaxUsers,
+		MaxEmployees: req.MaxEmployees,
+		MaxStorageGB: req.MaxStorageGB,
+		IsPublic:     req.IsPublic == nil || *req.IsPublic,
+		IsActive:     true,
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.planRepo.Create(ctx, p); err != nil {
+			return fmt.Errorf("failed to create plan: %w", err)
+		}
+		return uc.replacePlanModules(ctx, p.ID, req.ModuleIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.GetPlan(ctx, p.ID)
+}
+
+func (uc *UseCase) UpdatePlan(ctx context.Context, id int64, req UpdatePlanRequest) (*PlanDetail, error) {
+	p, err := uc.planRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		p.Name = *req.Name
+	}
+	if req.PriceMonthly != nil {
+		p.PriceMonthly = *req.PriceMonthly
+	}
+	if req.PriceYearly != nil {
+		p.PriceYearly = *req.PriceYearly
+	}
+	if req.MaxUsers != nil {
+		p.MaxUsers = *req.MaxUsers
+	}
+	if req.MaxEmployees != nil {
+		p.MaxEmployees = *req.MaxEmployees
+	}
+	if req.MaxStorageGB != nil {
+		p.MaxStorageGB = *req.MaxStorageGB
+	}
+	if req.IsPublic != nil {
+		p.IsPublic = *req.IsPublic
+	}
+	if req.IsActive != nil {
+		p.IsActive = *req.IsActive
+	}
+
+	if err := uc.planRepo.Update(ctx, p); err != nil {
+		return nil, fmt.Errorf("failed to update plan: %w", err)
+	}
+	uc.entitlements.InvalidateAll(ctx)
+
+	return uc.GetPlan(ctx, p.ID)
+}
+
+func (uc *UseCase) SetPlanModules(ctx context.Context, id int64, req SetPlanModulesRequest) (*PlanDetail, error) {
+	if _, err := uc.planRepo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return uc.replacePlanModules(ctx, id, req.ModuleIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.entitlements.InvalidateAll(ctx)
+
+	return uc.GetPlan(ctx, id)
+}
+
+func (uc *UseCase) ListModules(ctx context.Context) ([]subscriptionEntity.Module, error) {
+	return uc.moduleRepo.List(ctx)
+}
+
+// ─── Company Subscriptions ──────────────────────────────────────
+
+// StartTrial puts a newly created company on the configured trial plan.
+func (uc *UseCase) StartTrial(ctx context.Context, companyID int64) error {
+	p, err := uc.planRepo.FindByCode(ctx, uc.trialPlanCode)
+	if err != nil {
+		return fmt.Errorf("failed to load trial plan %s: %w", uc.trialPlanCode, err)
+	}
+
+	id, err := snowflake.Generate()
+	if err != nil {
+		return fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	now := time.Now()
+	trialEnd := now.AddDate(0, 0, uc.trialDays)
+
+	err = uc.subRepo.Create(ctx, &subscriptionEntity.CompanySubscription{
+		ID:                 id,
+		CompanyID:          companyID,
+		PlanID:             p.ID,
+		Status:             subscriptionEntity.StatusTrial,
+		BillingCycle:       subscriptionEntity.BillingCycleMonthly,
+		TrialEndsAt:        &trialEnd,
+		CurrentPeriodStart: now,
+		CurrentPeriodEnd:   trialEnd,
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.entitlements.Invalidate(ctx, companyID)
+	return nil
+}
+
+func (uc *UseCase) GetCurrent(ctx context.Context, companyID int64) (*SubscriptionDetail, error) {
+	sub, err := uc.subRepo.FindCurrentByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.detail(ctx, sub)
+}
+
+// PreviewChange computes the proration for a plan change without applying it.
+func (uc *UseCase) PreviewChange(ctx context.Context, companyID int64, req ChangePlanRequest) (*ChangeResult, error) {
+	sub, from, to, err := uc.loadChange(ctx, companyID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	change := prorate(sub, from, to, req.BillingCycle, time.Now())
+
+	detail, err := uc.detail(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+	return &ChangeResult{SubscriptionDetail: *detail, Change: change}, nil
+}
+
+// ChangePlan upgrades or downgrades the company subscription. Changes that
+// cost more than the unused part of the current period apply immediately and
+// record the prorated amount due; the rest are scheduled for the period end.
+// During a trial the plan switches immediately with nothing due.
+func (uc *UseCase) ChangePlan(ctx context.Context, companyID int64, req ChangePlanRequest) (*ChangeResult, error) {
+	sub, from, to, err := uc.loadChange(ctx, companyID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	change := prorate(sub, from, to, req.BillingCycle, now)
+
+	changeID, err := snowflake.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+	change.ID = changeID
+
+	if change.IsScheduled {
+		sub.PendingPlanID = &to.ID
+		sub.PendingBillingCycle = &req.BillingCycle
+	} else {
+		if sub.Status == subscriptionEntity.StatusExpired || (req.BillingCycle != sub.BillingCycle && sub.Status != subscriptionEntity.StatusTrial) {
+			sub.CurrentPeriodStart = now
+			sub.CurrentPeriodEnd = subscriptionEntity.NextPeriodEnd(now, req.BillingCycle)
+		}
+		if sub.Status == subscriptionEntity.StatusExpired {
+			sub.Status = subscriptionEntity.StatusActive
+			sub.CancelledAt = nil
+		}
+		sub.PlanID = to.ID
+		sub.BillingCycle = req.BillingCycle
+		sub.PendingPlanID = nil
+		sub.PendingBillingCycle = nil
+	}
+
+	var inv *billingEntity.Invoice
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.subRepo.Update(ctx, sub); err != nil {
+			return fmt.Errorf("failed to update subscription: %w", err)
+		}
+		if err := uc.changeRepo.Create(ctx, change); err != nil {
+			return fmt.Errorf("failed to record subscription change: %w", err)
+		}
+		if !change.IsScheduled {
+			if inv, err = uc.invoices.InvoiceChange(ctx, sub, change, to); err != nil {
+				return fmt.Errorf("failed to invoice subscription change: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.invoices.QueueRender(ctx, inv)
+
+	uc.entitlements.Invalidate(ctx, companyID)
+	uc.notify(ctx, sub, subscriptionEntity.NoticePlanChanged, to, change.EffectiveAt)
+
+	detail, err := uc.detail(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+	return &ChangeResult{SubscriptionDetail: *detail, Change: change}, nil
+}
+
+// Cancel stops the subscription from renewing. The company keeps access until
+// the end of the current period.
+func (uc *UseCase) Cancel(ctx context.Context, companyID int64) (*SubscriptionDetail, error) {
+	sub, err := uc.subRepo.FindCurrentByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch sub.Status {
+	case subscriptionEntity.StatusTrial, subscriptionEntity.StatusActive, subscriptionEntity.StatusPastDue:
+	default:
+		return nil, errors.New("subscription cannot be cancelled")
+	}
+
+	now := time.Now()
+	sub.Status = subscriptionEntity.StatusCancelled
+	sub.CancelledAt = &now
+	sub.PendingPlanID = nil
+	sub.PendingBillingCycle = nil
+
+	if err := uc.subRepo.Update(ctx, sub); err != nil {
+		return nil, fmt.Errorf("failed to cancel subscription: %w", err)
+	}
+	uc.entitlements.Invalidate(ctx, companyID)
+
+	if p, err := uc.planRepo.FindByID(ctx, sub.PlanID); err == nil {
+		uc.notify(ctx, sub, subscriptionEntity.NoticeCancelled, p, sub.CurrentPeriodEnd)
+	}
+
+	return uc.detail(ctx, sub)
+}
+
+// Resume reverts a cancellation that has not yet reached the period end.
+func (uc *UseCase) Resume(ctx context.Context, companyID int64) (*SubscriptionDetail, error) {
+	sub, err := uc.subRepo.FindCurrentByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if sub.Status != subscriptionEntity.StatusCancelled || !now.Before(sub.CurrentPeriodEnd) {
+		return nil, errors.New("subscription is not cancelled")
+	}
+
+	sub.Status = subscriptionEntity.StatusActive
+	if sub.TrialEndsAt != nil && sub.TrialEndsAt.Equal(sub.CurrentPeriodEnd) {
+		sub.Status = subscriptionEntity.StatusTrial
+	}
+	sub.CancelledAt = nil
+
+	if err := uc.subRepo.Update(ctx, sub); err != nil {
+		return nil, fmt.Errorf("failed to resume subscription: %w", err)
+	}
+	uc.entitlements.Invalidate(ctx, companyID)
+
+	return uc.detail(ctx, sub)
+}
+
+// ProcessLifecycle is run periodically by the worker. It sends trial ending
+// reminders and moves every subscription whose period has ended to its next
+// state: trials and active subscriptions renew (applying scheduled
+// downgrades), cancelled and past-due ones expire.
+func (uc *UseCase) ProcessLifecycle(ctx context.Context) error {
+	now := time.Now()
+
+	trials, err := uc.subRepo.ListTrialsEndingBefore(ctx, now.Add(subscriptionEntity.TrialReminderBefore))
+	if err != nil {
+		return fmt.Errorf("failed to list ending trials: %w", err)
+	}
+	for i := range trials {
+		sub := &trials[i]
+		if !sub.TrialEndsAt.After(now) {
+			continue
+		}
+		sub.TrialReminderSentAt = &now
+		if err := uc.subRepo.Update(ctx, sub); err != nil {
+			logger.Errorf("Failed to mark trial reminder for subscription %d: %v", sub.ID, err)
+			continue
+		}
+		if p, err := uc.planRepo.FindByID(ctx, sub.PlanID); err == nil {
+			uc.notify(ctx, sub, subscriptionEntity.NoticeTrialEnding, p, *sub.TrialEndsAt)
+		}
+	}
+
+	ended, err := uc.subRepo.ListPeriodEnded(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to list ended periods: %w", err)
+	}
+
+	var errs []error
+	for i := range ended {
+		if err := uc.advance(ctx, &ended[i], now); err != nil {
+			errs = append(errs, fmt.Errorf("subscription %d: %w", ended[i].ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ─── Helpers ────────────────────────────────────────────────────
+
+func (uc *UseCase) advance(ctx context.Context, sub *subscriptionEntity.CompanySubscription, now time.Time) error {
+	switch sub.Status {
+	case subscriptionEntity.StatusCancelled, subscriptionEntity.StatusPastDue:
+		sub.Status = subscriptionEntity.StatusExpired
+		if err := uc.subRepo.Update(ctx, sub); err != nil {
+			return err
+		}
+		uc.entitlements.Invalidate(ctx, sub.CompanyID)
+		if p, err := uc.planRepo.FindByID(ctx, sub.PlanID); err == nil {
+			uc.notify(ctx, sub, subscriptionEntity.NoticeExpired, p, sub.CurrentPeriodEnd)
+		}
+		return nil
+
+	case subscriptionEntity.StatusTrial, subscriptionEntity.StatusActive:
+		event := subscriptionEntity.NoticeRenewed
+		if sub.Status == subscriptionEntity.StatusTrial {
+			event = subscriptionEntity.NoticeTrialEnded
+		}
+
+		if sub.PendingPlanID != nil {
+			sub.PlanID = *sub.PendingPlanID
+			if sub.PendingBillingCycle != nil {
+				sub.BillingCycle = *sub.PendingBillingCycle
+			}
+			sub.PendingPlanID = nil
+			sub.PendingBillingCycle = nil
+		}
+
+		p, err := uc.planRepo.FindByID(ctx, sub.PlanID)
+		if err != nil {
+			return err
+		}
+
+		// When the job missed cycles, every period up to the current one
+		// is invoiced, not only the last.
+		sub.Status = subscriptionEntity.StatusActive
+		var invoices []*billingEntity.Invoice
+		err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			for !sub.CurrentPeriodEnd.After(now) {
+				sub.CurrentPeriodStart = sub.CurrentPeriodEnd
+				sub.CurrentPeriodEnd = subscriptionEntity.NextPeriodEnd(sub.CurrentPeriodStart, sub.BillingCycle)
+				inv, err := uc.invoices.InvoicePeriod(ctx, sub, p)
+				if err != nil {
+					return err
+				}
+				if inv != nil {
+					invoices = append(invoices, inv)
+				}
+			}
+			return uc.subRepo.Update(ctx, sub)
+		})
+		if err != nil {
+			return err
+		}
+
+		uc.entitlements.Invalidate(ctx, sub.CompanyID)
+		for _, inv := range invoices {
+			uc.invoices.QueueRender(ctx, inv)
+		}
+		uc.notify(ctx, sub, event, p, sub.CurrentPeriodEnd)
+		return nil
+	}
+	return nil
+}
+
+func (uc *UseCase) loadChange(ctx context.Context, companyID int64, req ChangePlanRequest) (
+	*subscriptionEntity.CompanySubscription, *subscriptionEntity.Plan, *subscriptionEntity.Plan, error,
+) {
+	planID, err := strconv.ParseInt(req.PlanID, 10, 64)
+	if err != nil {
+		return nil, nil, nil, errors.New("plan not found")
+	}
+
+	sub, err := uc.subRepo.FindCurrentByCompany(ctx, companyID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	switch sub.Status {
+	case subscriptionEntity.StatusTrial, subscriptionEntity.StatusActive, subscriptionEntity.StatusExpired:
+	default:
+		return nil, nil, nil, errors.New("subscription cannot be changed")
+	}
+
+	to, err := uc.planRepo.FindByID(ctx, planID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !to.IsActive {
+		return nil, nil, nil, errors.New("plan not available")
+	}
+
+	if sub.Status != subscriptionEntity.StatusExpired && to.ID == sub.PlanID && req.BillingCycle == sub.BillingCycle {
+		return nil, nil, nil, errors.New("plan unchanged")
+	}
+
+	from, err := uc.planRepo.FindByID(ctx, sub.PlanID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return sub, from, to, nil
+}
+
+func (uc *UseCase) detail(ctx context.Context, sub *subscriptionEntity.CompanySubscription) (*SubscriptionDetail, error) {
+	p, err := uc.planRepo.FindByID(ctx, sub.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &SubscriptionDetail{Subscription: sub, Plan: p}
+	if sub.PendingPlanID != nil {
+		if pending, err := uc.planRepo.FindByID(ctx, *sub.PendingPlanID); err == nil {
+			d.PendingPlan = pending
+		}
+	}
+	return d, nil
+}
+
+func (uc *UseCase) replacePlanModules(ctx context.Context, planID int64, moduleIDs []string) error {
+	pms := make([]subscriptionEntity.PlanModule, 0, len(moduleIDs))
+	seen := make(map[int64]bool, len(moduleIDs))
+	for _, raw := range moduleIDs {
+		moduleID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return errors.New("module not found")
+		}
+		if seen[moduleID] {
+			continue
+		}
+		seen[moduleID] = true
+
+		if _, err := uc.moduleRepo.FindByID(ctx, moduleID); err != nil {
+			return err
+		}
+
+		id, err := snowflake.Generate()
+		if err != nil {
+			return fmt.Errorf("failed to generate ID: %w", err)
+		}
+		pms = append(pms, subscriptionEntity.PlanModule{ID: id, PlanID: planID, ModuleID: moduleID})
+	}
+
+	if err := uc.moduleRepo.ReplacePlanModules(ctx, planID, pms); err != nil {
+		return fmt.Errorf("failed to update plan modules: %w", err)
+	}
+	return nil
+}
+
+// notify emails the company owner about a subscription event. Failures are
+// logged rather than returned so they never roll back a state change.
+func (uc *UseCase) notify(ctx context.Context, sub *subscriptionEntity.CompanySubscription, event string, p *subscriptionEntity.Plan, at time.Time) {
+	c, err := uc.companyRepo.FindByID(ctx, sub.CompanyID)
+	if err != nil {
+		logger.Errorf("Failed to load company %d for subscription notice: %v", sub.CompanyID, err)
+		return
+	}
+
+	owner, err := uc.userRepo.FindByID(ctx, c.OwnerID)
+	if err != nil {
+		logger.Errorf("Failed to load owner of company %d for subscription notice: %v", c.ID, err)
+		return
+	}
+
+	lang := i18n.Detect(c.Locale)
+	content := i18n.SubscriptionNotification(event, c.Name, p.Name, at, lang)
+	uc.notifier.Notify(ctx, notify.Notice{
+		UserID:    owner.ID,
+		CompanyID: c.ID,
+		Type:      notify.TypeSubscription,
+		Title:     content.Title,
+		Body:      content.Body,
+		Data:      map[string]string{"event": event},
+	})
+
+	task, err := tasks.NewSendSubscriptionEmailTask(owner.Email, owner.Name, c.Name, event, p.Name, at, lang)
+	if err != nil {
+		logger.Errorf("Failed to create subscription email task: %v", err)
+		return
+	}
+
+	if err := uc.asynqClient.Enqueue(task, asynq.Queue("default")); err != nil {
+		logger.Errorf("Failed to enqueue subscription email task: %v", err)
+	}
+}
+
+// prorate works out how a change from one plan to another is billed at now.
+// The unused part of the current period is credited at the old price; the
+// new plan is charged for the rest of the period, or for a full new period
+// when the billing cycle changes. When the charge does not exceed the credit
+// the change is a downgrade and is scheduled for the period end instead.
+func prorate(sub *subscriptionEntity.CompanySubscription, from, to *subscriptionEntity.Plan, billingCycle string, now time.Time) *subscriptionEntity.SubscriptionChange {
+	change := &subscriptionEntity.SubscriptionChange{
+		CompanyID:        sub.CompanyID,
+		SubscriptionID:   sub.ID,
+		FromPlanID:       from.ID,
+		ToPlanID:         to.ID,
+		FromBillingCycle: sub.BillingCycle,
+		ToBillingCycle:   billingCycle,
+		EffectiveAt:      now,
+	}
+
+	switch sub.Status {
+	case subscriptionEntity.StatusTrial:
+		return change
+	case subscriptionEntity.StatusExpired:
+		change.ProrationCharge = to.Price(billingCycle)
+		change.AmountDue = change.ProrationCharge
+		return change
+	}
+
+	total := int64(sub.CurrentPeriodEnd.Sub(sub.CurrentPeriodStart) / time.Second)
+	remaining := int64(sub.CurrentPeriodEnd.Sub(now) / time.Second)
+	if remaining < 0 {
+		remaining = 0
+	}
+	if total <= 0 {
+		total = 1
+	}
+
+	credit := from.Price(sub.BillingCycle) * remaining / total
+	charge := to.Price(billingCycle)
+	if billingCycle == sub.BillingCycle {
+		charge = charge * remaining / total
+	}
+
+	if charge <= credit {
+		change.IsScheduled = true
+		change.EffectiveAt = sub.CurrentPeriodEnd
+		return change
+	}
+
+	change.ProrationCredit = credit
+	change.ProrationCharge = charge
+	change.AmountDue = charge - credit
+	return change
+}